@@ -8,9 +8,50 @@ SELECT * FROM clubs WHERE id = ? LIMIT 1;
 SELECT * FROM clubs WHERE name = ? LIMIT 1;
 
 -- name: CreateClub :one
-INSERT INTO clubs (name, strength, background_color, foreground_color)
-VALUES (?, ?, ?, ?)
+INSERT INTO clubs (name, strength, background_color, foreground_color, division_id)
+VALUES (?, ?, ?, ?, ?)
 RETURNING *;
 
+-- name: UpdateClubDivision :exec
+UPDATE clubs SET division_id = ? WHERE id = ?;
+
+-- name: AdjustClubFinances :exec
+-- Pays money in or out of a club's account and moves its transfer budget
+UPDATE clubs
+SET balance = balance + sqlc.arg(balance),
+    transfer_budget = MAX(transfer_budget + sqlc.arg(transfer_budget), 0)
+WHERE id = sqlc.arg(id);
+
+-- name: SetClubTransferBudget :exec
+UPDATE clubs SET transfer_budget = ? WHERE id = ?;
+
 -- name: DeleteClub :exec
 DELETE FROM clubs WHERE id = ?;
+
+-- name: SetClubAcademy :exec
+UPDATE clubs SET academy = ? WHERE id = ?;
+
+-- name: SetClubTraining :exec
+UPDATE clubs SET training_intensity = ?, training_focus = ? WHERE id = ?;
+
+-- name: SetClubFamiliarity :exec
+-- The formation a club last played and how many matches running it has used it
+UPDATE clubs SET formation = ?, familiarity = ? WHERE id = ?;
+
+-- name: SetClubBoard :exec
+-- What the board expects of the manager, how much faith it has in them and
+-- when the job last fell vacant
+UPDATE clubs SET objective = ?, confidence = ?, vacant_since = ? WHERE id = ?;
+
+-- name: SetClubCohesion :exec
+UPDATE clubs SET cohesion = ? WHERE id = ?;
+
+-- name: GetLastStartersByClubID :many
+SELECT player_id FROM last_starters WHERE club_id = ?;
+
+-- name: DeleteLastStartersByClubID :exec
+DELETE FROM last_starters WHERE club_id = ?;
+
+-- name: CreateLastStarter :exec
+INSERT INTO last_starters (club_id, player_id)
+VALUES (?, ?);
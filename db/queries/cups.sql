@@ -0,0 +1,50 @@
+-- name: GetCupByName :one
+SELECT * FROM cups WHERE name = ? LIMIT 1;
+
+-- name: GetCupRoundByID :one
+SELECT cr.id, cr.number, cr.name, cr.gameweek, cr.legs, cr.group_stage, c.name AS cup_name
+FROM cup_rounds cr
+JOIN cups c ON c.id = cr.cup_id
+WHERE cr.id = ?
+LIMIT 1;
+
+-- name: GetCurrentCupRounds :many
+SELECT * FROM cup_rounds
+WHERE cup_id = ?
+  AND season_id = (SELECT id FROM seasons WHERE is_current = 1)
+ORDER BY number;
+
+-- name: CreateCupRound :one
+INSERT INTO cup_rounds (cup_id, season_id, number, name, gameweek, legs, group_stage)
+VALUES (?, (SELECT id FROM seasons WHERE is_current = 1), ?, ?, ?, ?, ?)
+RETURNING *;
+
+-- name: GetCupTiesByRoundID :many
+-- Each tie with its result, once played
+SELECT
+    f.id,
+    f.gameweek,
+    f.leg,
+    f.match_date,
+    f.home_team_id,
+    f.away_team_id,
+    m.home_score,
+    m.away_score,
+    m.home_penalties,
+    m.away_penalties
+FROM fixtures f
+LEFT JOIN matches m ON m.fixture_id = f.id AND m.is_completed = 1
+WHERE f.cup_round_id = ?
+ORDER BY f.id;
+
+-- name: GetCurrentCupEntries :many
+SELECT * FROM cup_entries
+WHERE cup_id = ?
+  AND season_id = (SELECT id FROM seasons WHERE is_current = 1)
+ORDER BY group_name, id;
+
+-- name: UpsertCupEntry :exec
+-- Enters a club into this season's cup, or moves it into its drawn group
+INSERT INTO cup_entries (cup_id, season_id, club_id, group_name)
+VALUES (?, (SELECT id FROM seasons WHERE is_current = 1), ?, ?)
+ON CONFLICT (cup_id, season_id, club_id) DO UPDATE SET group_name = excluded.group_name;
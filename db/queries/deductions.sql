@@ -0,0 +1,11 @@
+-- name: CreatePointsDeduction :exec
+-- Docks a club points in the current season
+INSERT INTO points_deductions (season_id, club_id, points, reason)
+VALUES ((SELECT id FROM seasons WHERE is_current = 1), ?, ?, ?);
+
+-- name: GetCurrentSeasonDeductions :many
+-- The points each club has been docked this season
+SELECT club_id, CAST(SUM(points) AS INTEGER) AS points
+FROM points_deductions
+WHERE season_id = (SELECT id FROM seasons WHERE is_current = 1)
+GROUP BY club_id;
@@ -0,0 +1,35 @@
+-- name: CreatePlayerCard :one
+INSERT INTO player_cards (player_id, fixture_id, event_type, minute)
+VALUES (?, ?, ?, ?)
+RETURNING *;
+
+-- name: CountCardsByPlayerID :one
+-- Card totals reset every season
+SELECT COUNT(*) FROM player_cards pc
+JOIN fixtures f ON f.id = pc.fixture_id
+WHERE pc.player_id = ? AND pc.event_type = ?
+  AND f.season_id = (SELECT id FROM seasons WHERE is_current = 1);
+
+-- name: CreateSuspension :one
+INSERT INTO suspensions (player_id, reason, matches_banned, matches_remaining)
+VALUES (?, ?, ?, ?)
+RETURNING *;
+
+-- name: GetActiveSuspensionsByClubID :many
+SELECT s.id, s.player_id, p.name AS player_name, s.reason, s.matches_banned, s.matches_remaining
+FROM suspensions s
+JOIN players p ON p.id = s.player_id
+WHERE p.club_id = ? AND s.matches_remaining > 0
+ORDER BY s.matches_remaining DESC, p.name;
+
+-- name: ServeSuspensionsByClubID :exec
+-- Bans run consecutively, so only the oldest active ban per player is served
+UPDATE suspensions
+SET matches_remaining = matches_remaining - 1
+WHERE id IN (
+    SELECT MIN(s.id)
+    FROM suspensions s
+    JOIN players p ON p.id = s.player_id
+    WHERE p.club_id = ? AND s.matches_remaining > 0
+    GROUP BY s.player_id
+);
@@ -0,0 +1,10 @@
+-- name: GetAllDivisions :many
+SELECT * FROM divisions ORDER BY tier;
+
+-- name: GetDivisionByName :one
+SELECT * FROM divisions WHERE name = ? LIMIT 1;
+
+-- name: CreateDivision :one
+INSERT INTO divisions (name, tier)
+VALUES (?, ?)
+RETURNING *;
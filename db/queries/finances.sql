@@ -0,0 +1,20 @@
+-- name: CreateFinanceTransaction :exec
+INSERT INTO finance_transactions (season_id, club_id, category, amount, description, transaction_date)
+VALUES ((SELECT id FROM seasons WHERE is_current = 1), ?, ?, ?, ?, ?);
+
+-- name: CountFinanceTransactionsByClubID :one
+SELECT COUNT(*) FROM finance_transactions WHERE club_id = ?;
+
+-- name: GetSeasonFinanceTotals :many
+-- What a club has taken in or paid out under each heading this season
+SELECT category, CAST(SUM(amount) AS INTEGER) AS total
+FROM finance_transactions
+WHERE club_id = ? AND season_id = (SELECT id FROM seasons WHERE is_current = 1)
+GROUP BY category
+ORDER BY category;
+
+-- name: GetRecentFinanceTransactions :many
+SELECT * FROM finance_transactions
+WHERE club_id = ?
+ORDER BY transaction_date DESC, id DESC
+LIMIT ?;
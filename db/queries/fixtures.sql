@@ -1,26 +1,70 @@
 -- name: GetAllFixtures :many
-SELECT * FROM fixtures ORDER BY id;
+SELECT * FROM fixtures
+WHERE season_id = (SELECT id FROM seasons WHERE is_current = 1)
+ORDER BY id;
 
 -- name: GetFixtureByID :one
 SELECT * FROM fixtures WHERE id = ? LIMIT 1;
 
 -- name: GetFixturesByClubID :many
 SELECT * FROM fixtures
-WHERE home_team_id = ? OR away_team_id = ?
+WHERE (home_team_id = ? OR away_team_id = ?)
+  AND season_id = (SELECT id FROM seasons WHERE is_current = 1)
 ORDER BY id;
 
 -- name: GetUnplayedByClubID :many
-SELECT f.id, f.gameweek, f.home_team_id, f.away_team_id, f.created_at
+SELECT f.id, f.gameweek, f.home_team_id, f.away_team_id, f.created_at, f.season_id, f.division_id, f.cup_round_id, f.leg, f.match_date
 FROM fixtures f
 LEFT JOIN matches m ON m.fixture_id = f.id AND m.is_completed = 1
 WHERE (f.home_team_id = ?1 OR f.away_team_id = ?1)
+  AND f.season_id = (SELECT id FROM seasons WHERE is_current = 1)
+  AND m.id IS NULL
+ORDER BY f.match_date, f.id;
+
+-- name: GetUnplayedFixtures :many
+SELECT f.id, f.gameweek, f.home_team_id, f.away_team_id, f.created_at, f.season_id, f.division_id, f.cup_round_id, f.leg, f.match_date
+FROM fixtures f
+LEFT JOIN matches m ON m.fixture_id = f.id AND m.is_completed = 1
+WHERE f.season_id = (SELECT id FROM seasons WHERE is_current = 1)
+  AND m.id IS NULL
+ORDER BY f.match_date, f.id;
+
+-- name: GetUnplayedFixturesThrough :many
+-- Every unplayed fixture in the current season dated on or before the given day
+SELECT f.id, f.gameweek, f.home_team_id, f.away_team_id, f.created_at, f.season_id, f.division_id, f.cup_round_id, f.leg, f.match_date
+FROM fixtures f
+LEFT JOIN matches m ON m.fixture_id = f.id AND m.is_completed = 1
+WHERE f.season_id = (SELECT id FROM seasons WHERE is_current = 1)
+  AND f.match_date <= ?
   AND m.id IS NULL
-ORDER BY f.gameweek, f.id;
+ORDER BY f.match_date, f.id;
 
 -- name: CreateFixture :one
-INSERT INTO fixtures (gameweek, home_team_id, away_team_id)
-VALUES (?, ?, ?)
+INSERT INTO fixtures (gameweek, home_team_id, away_team_id, season_id, division_id, cup_round_id, leg, match_date)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?)
 RETURNING *;
 
+-- name: GetFirstLegResult :one
+-- The first leg of a two-legged cup tie, played with home and away reversed
+SELECT m.home_score, m.away_score
+FROM fixtures f
+JOIN matches m ON m.fixture_id = f.id AND m.is_completed = 1
+WHERE f.cup_round_id = ?
+  AND f.home_team_id = ?
+  AND f.away_team_id = ?
+  AND f.leg = 1
+LIMIT 1;
+
+-- name: CountPlayedFixtures :one
+SELECT COUNT(*) FROM fixtures f
+JOIN matches m ON m.fixture_id = f.id AND m.is_completed = 1
+WHERE f.season_id = (SELECT id FROM seasons WHERE is_current = 1)
+  AND f.division_id = ?;
+
+-- name: DeleteCurrentSeasonFixtures :exec
+DELETE FROM fixtures
+WHERE season_id = (SELECT id FROM seasons WHERE is_current = 1)
+  AND division_id = ?;
+
 -- name: DeleteFixture :exec
 DELETE FROM fixtures WHERE id = ?;
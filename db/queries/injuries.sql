@@ -0,0 +1,16 @@
+-- name: CreateInjury :one
+INSERT INTO injuries (player_id, fixture_id, injury_type, days_out, days_remaining)
+VALUES (?, ?, ?, ?, ?)
+RETURNING *;
+
+-- name: GetActiveInjuriesByClubID :many
+SELECT i.id, i.player_id, p.name AS player_name, i.injury_type, i.days_out, i.days_remaining
+FROM injuries i
+JOIN players p ON p.id = i.player_id
+WHERE p.club_id = ? AND i.days_remaining > 0
+ORDER BY i.days_remaining DESC, p.name;
+
+-- name: RecoverInjuries :exec
+UPDATE injuries
+SET days_remaining = MAX(days_remaining - sqlc.arg(days), 0)
+WHERE days_remaining > 0;
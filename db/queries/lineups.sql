@@ -0,0 +1,21 @@
+-- name: GetLineupByClubID :one
+SELECT * FROM lineups WHERE club_id = ? LIMIT 1;
+
+-- name: GetLineupPlayersByClubID :many
+SELECT * FROM lineup_players WHERE club_id = ? ORDER BY slot;
+
+-- name: UpsertLineup :exec
+INSERT INTO lineups (club_id, formation, captain_id, set_piece_taker_id)
+VALUES (?, ?, ?, ?)
+ON CONFLICT (club_id) DO UPDATE
+SET formation = excluded.formation,
+    captain_id = excluded.captain_id,
+    set_piece_taker_id = excluded.set_piece_taker_id,
+    updated_at = CURRENT_TIMESTAMP;
+
+-- name: CreateLineupPlayer :exec
+INSERT INTO lineup_players (club_id, player_id, slot)
+VALUES (?, ?, ?);
+
+-- name: DeleteLineupPlayersByClubID :exec
+DELETE FROM lineup_players WHERE club_id = ?;
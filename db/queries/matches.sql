@@ -43,5 +43,12 @@ SET home_score = ?,
     updated_at = CURRENT_TIMESTAMP
 WHERE fixture_id = ?;
 
+-- name: RecordShootout :exec
+UPDATE matches
+SET home_penalties = ?,
+    away_penalties = ?,
+    updated_at = CURRENT_TIMESTAMP
+WHERE fixture_id = ?;
+
 -- name: GetCompletedMatches :many
 SELECT * FROM matches WHERE is_completed = 1 ORDER BY completed_at DESC;
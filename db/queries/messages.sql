@@ -0,0 +1,16 @@
+-- name: CountMessagesByStatus :one
+SELECT COUNT(*) FROM messages WHERE status = ?;
+
+-- name: CreateMessage :exec
+INSERT INTO messages (message_date, kind, subject, body, status, player_id, club_id, fee)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetMessages :many
+-- Every message in the manager's inbox, latest first
+SELECT * FROM messages ORDER BY message_date DESC, id DESC;
+
+-- name: MarkMessageRead :exec
+UPDATE messages SET is_read = 1 WHERE id = ?;
+
+-- name: SetMessageStatus :exec
+UPDATE messages SET status = ? WHERE id = ?;
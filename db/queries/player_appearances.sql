@@ -0,0 +1,28 @@
+-- name: CreatePlayerAppearance :exec
+INSERT INTO player_appearances (player_id, fixture_id, started, goals, yellow_cards, red_cards, rating)
+VALUES (?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT (player_id, fixture_id) DO UPDATE SET
+    started = excluded.started,
+    goals = excluded.goals,
+    yellow_cards = excluded.yellow_cards,
+    red_cards = excluded.red_cards,
+    rating = excluded.rating;
+
+-- name: GetAppearancesByClubID :many
+-- Most recent first, so the head of each player's list is their current form
+SELECT a.player_id, a.fixture_id, a.started, a.goals, a.yellow_cards, a.red_cards, a.rating
+FROM player_appearances a
+JOIN players p ON p.id = a.player_id
+JOIN fixtures f ON f.id = a.fixture_id
+WHERE p.club_id = ?
+  AND f.season_id = (SELECT id FROM seasons WHERE is_current = 1)
+ORDER BY f.gameweek DESC, a.id DESC;
+
+-- name: GetMinutesBetween :many
+-- Roughly how long each player has played in matches from the first date up
+-- to the second: 90 minutes for a start and 30 off the bench
+SELECT a.player_id, CAST(SUM(CASE WHEN a.started = 1 THEN 90 ELSE 30 END) AS INTEGER) AS minutes
+FROM player_appearances a
+JOIN fixtures f ON f.id = a.fixture_id
+WHERE f.match_date >= sqlc.arg(from_date) AND f.match_date < sqlc.arg(to_date)
+GROUP BY a.player_id;
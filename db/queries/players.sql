@@ -5,9 +5,63 @@ SELECT * FROM players WHERE club_id = ? ORDER BY id;
 SELECT * FROM players WHERE id = ? LIMIT 1;
 
 -- name: CreatePlayer :one
-INSERT INTO players (club_id, name, quality)
-VALUES (?, ?, ?)
+INSERT INTO players (club_id, name, quality, position, age)
+VALUES (?, ?, ?, ?, ?)
 RETURNING *;
 
+-- name: BackfillPlayerPosition :exec
+UPDATE players SET position = ?
+WHERE club_id = ? AND name = ? AND position = '';
+
+-- name: BackfillPlayerAge :exec
+UPDATE players SET age = ?
+WHERE club_id = ? AND name = ? AND age = 0;
+
 -- name: DeletePlayer :exec
 DELETE FROM players WHERE id = ?;
+
+-- name: RecordPlayerFatigue :exec
+-- How tired a player finished a match
+UPDATE players SET fatigue = ? WHERE id = ?;
+
+-- name: RecoverPlayerFatigue :exec
+-- Every player shakes off some tiredness with rest
+UPDATE players SET fatigue = MAX(fatigue - sqlc.arg(amount), 0) WHERE fatigue > 0;
+
+-- name: UpdatePlayerClub :exec
+-- Moves a player to their new club
+UPDATE players SET club_id = ? WHERE id = ?;
+
+-- name: LoanPlayer :exec
+-- Sends a player out on loan, remembering the club they'll return to
+UPDATE players SET loaned_from_club_id = club_id, club_id = ? WHERE id = ?;
+
+-- name: ReturnLoanedPlayers :exec
+-- Every player out on loan goes back to their own club
+UPDATE players SET club_id = loaned_from_club_id, loaned_from_club_id = NULL
+WHERE loaned_from_club_id IS NOT NULL;
+
+-- name: SetPlayerContract :exec
+-- Registers a player on new terms with their club
+UPDATE players SET wage = ?, contract_ends = ?, release_clause = ?, squad_role = ?
+WHERE id = ?;
+
+-- name: SetPlayerBirthAndPotential :exec
+UPDATE players SET date_of_birth = ?, potential = ? WHERE id = ?;
+
+-- name: SetPlayerDevelopment :exec
+-- A player's quality and progress after a month's development
+UPDATE players SET quality = ?, progress = ? WHERE id = ?;
+
+-- name: SetPlayerNationality :exec
+UPDATE players SET nationality = ? WHERE id = ?;
+
+-- name: SetPlayerTrainingFocus :exec
+UPDATE players SET training_focus = ? WHERE id = ?;
+
+-- name: SetPlayerTraining :exec
+-- A player's development and condition after a week's training
+UPDATE players SET quality = ?, progress = ?, fatigue = ?, sharpness = ? WHERE id = ?;
+
+-- name: SetPlayerMorale :exec
+UPDATE players SET morale = ? WHERE id = ?;
@@ -0,0 +1,27 @@
+-- name: GetCurrentSeason :one
+SELECT * FROM seasons WHERE is_current = 1 LIMIT 1;
+
+-- name: CreateSeason :one
+INSERT INTO seasons (start_year, today, is_current)
+VALUES (?, ?, 1)
+RETURNING *;
+
+-- name: CompleteSeason :exec
+UPDATE seasons
+SET is_current = 0, completed_at = CURRENT_TIMESTAMP
+WHERE id = ?;
+
+-- name: SetSeasonToday :exec
+UPDATE seasons SET today = ? WHERE id = ?;
+
+-- name: CreateSeasonStanding :exec
+INSERT INTO season_standings (season_id, club_id, division_id, position, played, won, drawn, lost, goals_for, goals_against, points)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetSeasonHistoryByClubID :many
+SELECT s.start_year, d.name AS division_name, ss.position, ss.played, ss.won, ss.drawn, ss.lost, ss.goals_for, ss.goals_against, ss.points
+FROM season_standings ss
+JOIN seasons s ON s.id = ss.season_id
+JOIN divisions d ON d.id = ss.division_id
+WHERE ss.club_id = ?
+ORDER BY s.start_year DESC;
@@ -0,0 +1,15 @@
+-- name: CreateTransfer :exec
+INSERT INTO transfers (season_id, player_id, from_club_id, to_club_id, fee, transfer_date, is_loan)
+VALUES ((SELECT id FROM seasons WHERE is_current = 1), ?, ?, ?, ?, ?, ?);
+
+-- name: GetCurrentSeasonTransfers :many
+-- Every move this season, latest first
+SELECT t.id, t.player_id, p.name AS player_name, p.position, p.quality, p.age,
+       t.from_club_id, fc.name AS from_club_name, t.to_club_id, tc.name AS to_club_name,
+       t.fee, t.transfer_date, t.is_loan
+FROM transfers t
+JOIN players p ON p.id = t.player_id
+JOIN clubs fc ON fc.id = t.from_club_id
+JOIN clubs tc ON tc.id = t.to_club_id
+WHERE t.season_id = (SELECT id FROM seasons WHERE is_current = 1)
+ORDER BY t.transfer_date DESC, t.id DESC;
@@ -0,0 +1,25 @@
+-- Player cards table (discipline ledger, one row per card shown)
+CREATE TABLE IF NOT EXISTS player_cards (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    player_id INTEGER NOT NULL,
+    fixture_id INTEGER NOT NULL,
+    event_type INTEGER NOT NULL,
+    minute INTEGER NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (player_id) REFERENCES players(id) ON DELETE CASCADE,
+    FOREIGN KEY (fixture_id) REFERENCES fixtures(id) ON DELETE CASCADE
+);
+
+-- Suspensions table (bans triggered by the discipline ledger)
+CREATE TABLE IF NOT EXISTS suspensions (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    player_id INTEGER NOT NULL,
+    reason TEXT NOT NULL,
+    matches_banned INTEGER NOT NULL CHECK(matches_banned > 0),
+    matches_remaining INTEGER NOT NULL CHECK(matches_remaining >= 0),
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (player_id) REFERENCES players(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_player_cards_player_id ON player_cards(player_id);
+CREATE INDEX IF NOT EXISTS idx_suspensions_player_id ON suspensions(player_id);
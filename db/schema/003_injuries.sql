@@ -0,0 +1,14 @@
+-- Injuries table (one row per injury, active while days_remaining > 0)
+CREATE TABLE IF NOT EXISTS injuries (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    player_id INTEGER NOT NULL,
+    fixture_id INTEGER,
+    injury_type INTEGER NOT NULL,
+    days_out INTEGER NOT NULL CHECK(days_out > 0),
+    days_remaining INTEGER NOT NULL CHECK(days_remaining >= 0),
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (player_id) REFERENCES players(id) ON DELETE CASCADE,
+    FOREIGN KEY (fixture_id) REFERENCES fixtures(id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_injuries_player_id ON injuries(player_id);
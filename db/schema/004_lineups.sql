@@ -0,0 +1,24 @@
+-- Natural position for each player (e.g. GK, CB, ST)
+ALTER TABLE players ADD COLUMN position TEXT NOT NULL DEFAULT '';
+
+-- Saved lineups table (a club's default team selection)
+CREATE TABLE IF NOT EXISTS lineups (
+    club_id INTEGER PRIMARY KEY,
+    formation INTEGER NOT NULL,
+    captain_id INTEGER,
+    set_piece_taker_id INTEGER,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (club_id) REFERENCES clubs(id) ON DELETE CASCADE,
+    FOREIGN KEY (captain_id) REFERENCES players(id) ON DELETE SET NULL,
+    FOREIGN KEY (set_piece_taker_id) REFERENCES players(id) ON DELETE SET NULL
+);
+
+-- Lineup players table (slots 0-10 are the starting XI, 11+ the bench)
+CREATE TABLE IF NOT EXISTS lineup_players (
+    club_id INTEGER NOT NULL,
+    player_id INTEGER NOT NULL,
+    slot INTEGER NOT NULL,
+    PRIMARY KEY (club_id, slot),
+    FOREIGN KEY (club_id) REFERENCES lineups(club_id) ON DELETE CASCADE,
+    FOREIGN KEY (player_id) REFERENCES players(id) ON DELETE CASCADE
+);
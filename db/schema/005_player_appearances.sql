@@ -0,0 +1,17 @@
+-- Player appearances table (one row per player per match played)
+CREATE TABLE IF NOT EXISTS player_appearances (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    player_id INTEGER NOT NULL,
+    fixture_id INTEGER NOT NULL,
+    started INTEGER NOT NULL CHECK(started IN (0, 1)),
+    goals INTEGER NOT NULL DEFAULT 0,
+    yellow_cards INTEGER NOT NULL DEFAULT 0,
+    red_cards INTEGER NOT NULL DEFAULT 0,
+    rating REAL NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (player_id, fixture_id),
+    FOREIGN KEY (player_id) REFERENCES players(id) ON DELETE CASCADE,
+    FOREIGN KEY (fixture_id) REFERENCES fixtures(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_player_appearances_player_id ON player_appearances(player_id);
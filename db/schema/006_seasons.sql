@@ -0,0 +1,37 @@
+-- Seasons table (exactly one season is current at a time)
+CREATE TABLE IF NOT EXISTS seasons (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    start_year INTEGER NOT NULL UNIQUE,
+    is_current INTEGER NOT NULL DEFAULT 0 CHECK(is_current IN (0, 1)),
+    completed_at DATETIME,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Every career starts in 2025/26, the season fixtures.json describes
+INSERT INTO seasons (start_year, is_current) VALUES (2025, 1);
+
+-- Fixtures belong to a season; existing fixtures join the first one
+ALTER TABLE fixtures ADD COLUMN season_id INTEGER REFERENCES seasons(id) ON DELETE CASCADE;
+UPDATE fixtures SET season_id = (SELECT id FROM seasons WHERE is_current = 1);
+
+-- Players age a year at each season rollover
+ALTER TABLE players ADD COLUMN age INTEGER NOT NULL DEFAULT 0;
+
+-- Final league standings archived when a season ends
+CREATE TABLE IF NOT EXISTS season_standings (
+    season_id INTEGER NOT NULL,
+    club_id INTEGER NOT NULL,
+    position INTEGER NOT NULL,
+    played INTEGER NOT NULL,
+    won INTEGER NOT NULL,
+    drawn INTEGER NOT NULL,
+    lost INTEGER NOT NULL,
+    goals_for INTEGER NOT NULL,
+    goals_against INTEGER NOT NULL,
+    points INTEGER NOT NULL,
+    PRIMARY KEY (season_id, club_id),
+    FOREIGN KEY (season_id) REFERENCES seasons(id) ON DELETE CASCADE,
+    FOREIGN KEY (club_id) REFERENCES clubs(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_fixtures_season_id ON fixtures(season_id);
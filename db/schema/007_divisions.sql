@@ -0,0 +1,25 @@
+-- Divisions form the league pyramid, tier 1 at the top
+CREATE TABLE IF NOT EXISTS divisions (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL UNIQUE,
+    tier INTEGER NOT NULL UNIQUE CHECK(tier > 0),
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Every existing career was played in the Premier League
+INSERT INTO divisions (name, tier) VALUES ('Premier League', 1);
+
+-- Clubs move between divisions through promotion and relegation
+ALTER TABLE clubs ADD COLUMN division_id INTEGER REFERENCES divisions(id);
+UPDATE clubs SET division_id = (SELECT id FROM divisions WHERE tier = 1);
+
+-- Each division plays its own fixtures within a season
+ALTER TABLE fixtures ADD COLUMN division_id INTEGER REFERENCES divisions(id);
+UPDATE fixtures SET division_id = (SELECT id FROM divisions WHERE tier = 1);
+
+-- Archived standings record which division the club finished in
+ALTER TABLE season_standings ADD COLUMN division_id INTEGER REFERENCES divisions(id);
+UPDATE season_standings SET division_id = (SELECT id FROM divisions WHERE tier = 1);
+
+CREATE INDEX IF NOT EXISTS idx_clubs_division_id ON clubs(division_id);
+CREATE INDEX IF NOT EXISTS idx_fixtures_division_id ON fixtures(division_id);
@@ -0,0 +1,31 @@
+-- Knockout cups played alongside the league
+CREATE TABLE IF NOT EXISTS cups (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL UNIQUE,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+INSERT INTO cups (name) VALUES ('FA Cup');
+
+-- Each round is drawn once the round before it is complete
+CREATE TABLE IF NOT EXISTS cup_rounds (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    cup_id INTEGER NOT NULL,
+    season_id INTEGER NOT NULL,
+    number INTEGER NOT NULL,
+    name TEXT NOT NULL,
+    gameweek INTEGER NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (cup_id, season_id, number),
+    FOREIGN KEY (cup_id) REFERENCES cups(id) ON DELETE CASCADE,
+    FOREIGN KEY (season_id) REFERENCES seasons(id) ON DELETE CASCADE
+);
+
+-- Cup ties are fixtures outside any division
+ALTER TABLE fixtures ADD COLUMN cup_round_id INTEGER REFERENCES cup_rounds(id) ON DELETE CASCADE;
+
+-- Knockout matches still level after extra time are settled on penalties
+ALTER TABLE matches ADD COLUMN home_penalties INTEGER;
+ALTER TABLE matches ADD COLUMN away_penalties INTEGER;
+
+CREATE INDEX IF NOT EXISTS idx_fixtures_cup_round_id ON fixtures(cup_round_id);
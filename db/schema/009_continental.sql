@@ -0,0 +1,29 @@
+-- Continental cup for the clubs finishing highest in the top flight, played
+-- against clubs from the rest of the continent
+INSERT INTO cups (name) VALUES ('Champions Cup');
+
+-- Group stage rounds span several matchdays; knockout rounds may be two-legged
+ALTER TABLE cup_rounds ADD COLUMN legs INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE cup_rounds ADD COLUMN group_stage INTEGER NOT NULL DEFAULT 0;
+
+-- Which leg of a two-legged tie a cup fixture is
+ALTER TABLE fixtures ADD COLUMN leg INTEGER NOT NULL DEFAULT 1;
+
+-- Clubs entered into a cup for a season, and their group once drawn
+CREATE TABLE IF NOT EXISTS cup_entries (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    cup_id INTEGER NOT NULL,
+    season_id INTEGER NOT NULL,
+    club_id INTEGER NOT NULL,
+    group_name TEXT NOT NULL DEFAULT '',
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (cup_id, season_id, club_id),
+    FOREIGN KEY (cup_id) REFERENCES cups(id) ON DELETE CASCADE,
+    FOREIGN KEY (season_id) REFERENCES seasons(id) ON DELETE CASCADE,
+    FOREIGN KEY (club_id) REFERENCES clubs(id) ON DELETE CASCADE
+);
+
+-- Tiredness carried from one match to the next, 0 (fresh) to 100
+ALTER TABLE players ADD COLUMN fatigue INTEGER NOT NULL DEFAULT 0;
+
+CREATE INDEX IF NOT EXISTS idx_cup_entries_cup_season ON cup_entries(cup_id, season_id);
@@ -0,0 +1,26 @@
+-- Fixtures are played on calendar dates. Existing fixtures take their
+-- gameweek's Saturday, counting from the first Saturday on or after 9 August,
+-- with domestic cup ties the Tuesday after and continental ties the Wednesday.
+ALTER TABLE fixtures ADD COLUMN match_date TEXT NOT NULL DEFAULT '';
+UPDATE fixtures SET match_date = COALESCE(date(
+    (SELECT start_year FROM seasons WHERE seasons.id = fixtures.season_id) || '-08-09',
+    'weekday 6',
+    '+' || ((gameweek - 1) * 7 + COALESCE((
+        SELECT CASE c.name WHEN 'FA Cup' THEN 3 ELSE 4 END
+        FROM cup_rounds r
+        JOIN cups c ON c.id = r.cup_id
+        WHERE r.id = fixtures.cup_round_id
+    ), 0)) || ' days'
+), '');
+
+-- The day the game has reached in each season: the last matchday played so
+-- far, or 1 July for a season still in pre-season
+ALTER TABLE seasons ADD COLUMN today TEXT NOT NULL DEFAULT '';
+UPDATE seasons SET today = COALESCE((
+    SELECT MAX(f.match_date)
+    FROM fixtures f
+    JOIN matches m ON m.fixture_id = f.id AND m.is_completed = 1
+    WHERE f.season_id = seasons.id
+), start_year || '-07-01');
+
+CREATE INDEX IF NOT EXISTS idx_fixtures_match_date ON fixtures(match_date);
@@ -0,0 +1,17 @@
+-- Players who have moved between clubs, and what was paid for them
+CREATE TABLE IF NOT EXISTS transfers (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    season_id INTEGER NOT NULL,
+    player_id INTEGER NOT NULL,
+    from_club_id INTEGER NOT NULL,
+    to_club_id INTEGER NOT NULL,
+    fee INTEGER NOT NULL,
+    transfer_date TEXT NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (season_id) REFERENCES seasons(id) ON DELETE CASCADE,
+    FOREIGN KEY (player_id) REFERENCES players(id) ON DELETE CASCADE,
+    FOREIGN KEY (from_club_id) REFERENCES clubs(id) ON DELETE CASCADE,
+    FOREIGN KEY (to_club_id) REFERENCES clubs(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_transfers_season_id ON transfers(season_id);
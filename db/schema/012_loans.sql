@@ -0,0 +1,5 @@
+-- Players out on loan play for another club for the rest of the season,
+-- then return to the club they were loaned from
+ALTER TABLE players ADD COLUMN loaned_from_club_id INTEGER REFERENCES clubs(id);
+
+ALTER TABLE transfers ADD COLUMN is_loan INTEGER NOT NULL DEFAULT 0 CHECK(is_loan IN (0, 1));
@@ -0,0 +1,23 @@
+-- What each club has in the bank, and how much of it the board will let the
+-- manager spend on transfer fees
+ALTER TABLE clubs ADD COLUMN balance INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE clubs ADD COLUMN transfer_budget INTEGER NOT NULL DEFAULT 0;
+
+-- Weekly wage
+ALTER TABLE players ADD COLUMN wage INTEGER NOT NULL DEFAULT 0;
+
+-- Every payment in or out of a club's accounts
+CREATE TABLE IF NOT EXISTS finance_transactions (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    season_id INTEGER NOT NULL,
+    club_id INTEGER NOT NULL,
+    category TEXT NOT NULL,
+    amount INTEGER NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    transaction_date TEXT NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (season_id) REFERENCES seasons(id) ON DELETE CASCADE,
+    FOREIGN KEY (club_id) REFERENCES clubs(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_finance_transactions_club_season ON finance_transactions(club_id, season_id);
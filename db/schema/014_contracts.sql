@@ -0,0 +1,6 @@
+-- The rest of each player's contract: the year it runs out at the end of
+-- June, the fee that lets any club buy them (0 for none), and the squad role
+-- they were promised
+ALTER TABLE players ADD COLUMN contract_ends INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE players ADD COLUMN release_clause INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE players ADD COLUMN squad_role INTEGER NOT NULL DEFAULT 0;
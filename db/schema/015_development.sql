@@ -0,0 +1,5 @@
+-- Players are born on a date and grow towards a potential, gaining or losing
+-- development points each month until they make a point of quality
+ALTER TABLE players ADD COLUMN date_of_birth TEXT NOT NULL DEFAULT '';
+ALTER TABLE players ADD COLUMN potential INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE players ADD COLUMN progress INTEGER NOT NULL DEFAULT 0;
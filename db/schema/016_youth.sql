@@ -0,0 +1,5 @@
+-- How good each club's academy is at producing players, out of 20
+ALTER TABLE clubs ADD COLUMN academy INTEGER NOT NULL DEFAULT 0;
+
+-- Where each player comes from, blank for players imported without one
+ALTER TABLE players ADD COLUMN nationality TEXT NOT NULL DEFAULT '';
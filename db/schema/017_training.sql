@@ -0,0 +1,11 @@
+-- How hard each club trains and what it works on, and the formation it last
+-- played with how many matches running it has used it
+ALTER TABLE clubs ADD COLUMN training_intensity INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE clubs ADD COLUMN training_focus INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE clubs ADD COLUMN formation TEXT NOT NULL DEFAULT '';
+ALTER TABLE clubs ADD COLUMN familiarity INTEGER NOT NULL DEFAULT 0;
+
+-- How match-sharp each player is, out of 100, and what they work on in
+-- training when it isn't what the rest of the squad does
+ALTER TABLE players ADD COLUMN sharpness INTEGER NOT NULL DEFAULT 100;
+ALTER TABLE players ADD COLUMN training_focus INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,15 @@
+-- How happy each player is, out of 100 with 50 content
+ALTER TABLE players ADD COLUMN morale INTEGER NOT NULL DEFAULT 50;
+
+-- How well each club's players know each other, out of 100
+ALTER TABLE clubs ADD COLUMN cohesion INTEGER NOT NULL DEFAULT 50;
+
+-- The players who started each club's last match, to see how much of the
+-- side is kept together from one match to the next
+CREATE TABLE IF NOT EXISTS last_starters (
+    club_id INTEGER NOT NULL,
+    player_id INTEGER NOT NULL,
+    PRIMARY KEY (club_id, player_id),
+    FOREIGN KEY (club_id) REFERENCES clubs(id) ON DELETE CASCADE,
+    FOREIGN KEY (player_id) REFERENCES players(id) ON DELETE CASCADE
+);
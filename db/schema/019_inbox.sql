@@ -0,0 +1,18 @@
+-- The manager's inbox. Messages about a player or another club refer to
+-- them, and transfer offers carry the fee. Status tracks whether a message
+-- is waiting on an answer and what the answer was.
+CREATE TABLE IF NOT EXISTS messages (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    message_date TEXT NOT NULL,
+    kind INTEGER NOT NULL,
+    subject TEXT NOT NULL,
+    body TEXT NOT NULL,
+    is_read INTEGER NOT NULL DEFAULT 0,
+    status INTEGER NOT NULL DEFAULT 0,
+    player_id INTEGER,
+    club_id INTEGER,
+    fee INTEGER NOT NULL DEFAULT 0,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (player_id) REFERENCES players(id) ON DELETE SET NULL,
+    FOREIGN KEY (club_id) REFERENCES clubs(id) ON DELETE SET NULL
+);
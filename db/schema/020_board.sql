@@ -0,0 +1,5 @@
+-- What each club's board expects of its manager this season, how much faith
+-- it has in them, out of 100, and the day it last sacked one
+ALTER TABLE clubs ADD COLUMN objective INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE clubs ADD COLUMN confidence INTEGER NOT NULL DEFAULT 60;
+ALTER TABLE clubs ADD COLUMN vacant_since TEXT NOT NULL DEFAULT '';
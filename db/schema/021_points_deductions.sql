@@ -0,0 +1,12 @@
+-- Points a club has been docked in a season, and why. A club can be docked
+-- more than once; its deductions add up.
+CREATE TABLE IF NOT EXISTS points_deductions (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    season_id INTEGER NOT NULL,
+    club_id INTEGER NOT NULL,
+    points INTEGER NOT NULL CHECK(points > 0),
+    reason TEXT NOT NULL DEFAULT '',
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (season_id) REFERENCES seasons(id) ON DELETE CASCADE,
+    FOREIGN KEY (club_id) REFERENCES clubs(id) ON DELETE CASCADE
+);
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+
+	"github.com/cameronjpr/gaffer/internal/db"
+	"github.com/cameronjpr/gaffer/internal/repository"
+)
+
+// runDeduct handles `gaffer deduct [-reason text] <club> <points>`, which
+// docks a club points in the current season's league table
+func runDeduct(queries *db.Queries, args []string) error {
+	flags := flag.NewFlagSet("deduct", flag.ContinueOnError)
+	reason := flags.String("reason", "", "why the club is being docked points")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: gaffer deduct [-reason text] <club> <points>")
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 2 {
+		flags.Usage()
+		return fmt.Errorf("expected a club and a number of points")
+	}
+
+	points, err := strconv.Atoi(flags.Arg(1))
+	if err != nil || points <= 0 {
+		return fmt.Errorf("expected a positive number of points, got %q", flags.Arg(1))
+	}
+
+	club, err := repository.NewClubRepository(queries).GetByName(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+	if err := repository.NewDivisionRepository(queries).Deduct(club, points, *reason); err != nil {
+		return err
+	}
+
+	fmt.Printf("%s docked %d points this season\n", club.Name, points)
+	return nil
+}
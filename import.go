@@ -0,0 +1,174 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"math/rand/v2"
+
+	"github.com/cameronjpr/gaffer/internal/db"
+	"github.com/cameronjpr/gaffer/internal/domain"
+	"github.com/cameronjpr/gaffer/internal/importer"
+	"github.com/cameronjpr/gaffer/internal/repository"
+)
+
+// runImport handles `gaffer import [-format name] [-dry-run] <file>`, which
+// replaces the current season's fixtures with an external list
+func runImport(queries *db.Queries, args []string) error {
+	flags := flag.NewFlagSet("import", flag.ContinueOnError)
+	formatName := flags.String("format", "", "fixture list format: json, csv or openfootball (default: from the file extension)")
+	dryRun := flags.Bool("dry-run", false, "report how the clubs match without changing any fixtures")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: gaffer import [-format name] [-dry-run] <file>")
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return fmt.Errorf("expected one fixture file")
+	}
+
+	var format importer.Format
+	if *formatName != "" {
+		var err error
+		format, err = importer.FormatByName(*formatName)
+		if err != nil {
+			return err
+		}
+	}
+
+	report, err := importFixtures(queries, flags.Arg(0), format, *dryRun)
+	if report != nil {
+		fmt.Print(report)
+	}
+	if err != nil {
+		return err
+	}
+
+	if *dryRun {
+		fmt.Println("Dry run: no fixtures changed")
+	} else {
+		fmt.Println("Fixtures imported into the current season")
+	}
+	return nil
+}
+
+// importDefaultFixtures schedules the first season for every division without
+// any fixtures yet. A division seeded with a fixtures file plays that list,
+// and the rest have theirs generated.
+func importDefaultFixtures(queries *db.Queries) error {
+	clubRepo := repository.NewClubRepository(queries)
+	fixtureRepo := repository.NewFixtureRepository(queries, clubRepo)
+	divisionRepo := repository.NewDivisionRepository(queries)
+
+	fixtureFiles := make(map[string]string)
+	for _, seed := range divisions {
+		fixtureFiles[seed.Name] = seed.FixturesJSONPath
+	}
+
+	fixtures, err := fixtureRepo.GetAll()
+	if err != nil {
+		return err
+	}
+	scheduled := make(map[int64]bool)
+	for _, fixture := range fixtures {
+		scheduled[fixture.DivisionID] = true
+	}
+
+	divisions, err := divisionRepo.GetAll()
+	if err != nil {
+		return err
+	}
+	clubs, err := clubRepo.GetAll()
+	if err != nil {
+		return err
+	}
+
+	rng := rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))
+	for _, division := range divisions {
+		if scheduled[division.ID] {
+			continue
+		}
+
+		divisionClubs := domain.ClubsInDivision(clubs, division.ID)
+		season := domain.GenerateFixtures(divisionClubs, division.Rules.Derbies, rng)
+		if path := fixtureFiles[division.Name]; path != "" {
+			season, err = seededFixtures(path, divisionClubs)
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := fixtureRepo.ReplaceCurrentSeason(division.ID, season); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// seededFixtures reads a division's fixtures from a seed file, matching its
+// clubs by name. They are dated by gameweek when saved.
+func seededFixtures(path string, clubs []*domain.ClubWithPlayers) ([]*domain.Fixture, error) {
+	seeds, err := db.ReadFixtureSeeds(path)
+	if err != nil {
+		return nil, err
+	}
+
+	byName := make(map[string]*domain.ClubWithPlayers, len(clubs))
+	for _, club := range clubs {
+		byName[club.Club.Name] = club
+	}
+
+	fixtures := make([]*domain.Fixture, 0, len(seeds))
+	for _, seed := range seeds {
+		home, away := byName[seed.HomeClubName], byName[seed.AwayClubName]
+		if home == nil || away == nil {
+			return nil, fmt.Errorf("no club in the division for fixture %s vs %s in %s", seed.HomeClubName, seed.AwayClubName, path)
+		}
+		fixtures = append(fixtures, &domain.Fixture{
+			Gameweek: int(seed.Gameweek),
+			HomeTeam: home,
+			AwayTeam: away,
+		})
+	}
+	return fixtures, nil
+}
+
+// importFixtures reads a fixture list, matches its clubs and, unless this is
+// a dry run, writes it as the current season's fixtures for the division
+// those clubs play in. Nothing is written while any club is unmatched.
+func importFixtures(queries *db.Queries, path string, format importer.Format, dryRun bool) (*importer.Report, error) {
+	clubRepo := repository.NewClubRepository(queries)
+	fixtureRepo := repository.NewFixtureRepository(queries, clubRepo)
+
+	list, err := importer.ParseFile(path, format)
+	if err != nil {
+		return nil, err
+	}
+
+	clubs, err := clubRepo.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	fixtures, report := importer.Resolve(list, domain.ClubsInPyramid(clubs))
+	if !report.OK() {
+		return report, fmt.Errorf("%d club names in %s could not be matched", len(report.Unmatched), path)
+	}
+
+	divisionID, err := importer.DivisionOf(fixtures)
+	if err != nil {
+		return report, err
+	}
+	if dryRun {
+		return report, nil
+	}
+
+	if err := fixtureRepo.ReplaceCurrentSeason(divisionID, fixtures); err != nil {
+		return report, err
+	}
+	return report, nil
+}
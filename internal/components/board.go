@@ -0,0 +1,60 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cameronjpr/gaffer/internal/domain"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Confidence shows how much faith the board has in the manager, coloured by
+// how safe their job is
+func Confidence(club *domain.Club) string {
+	colour := "42"
+	switch club.JobSecurity() {
+	case domain.JobUnderPressure:
+		colour = "214"
+	case domain.JobFinalWarning, domain.JobLost:
+		colour = "196"
+	}
+	return lipgloss.NewStyle().Foreground(lipgloss.Color(colour)).Render(
+		fmt.Sprintf("%d%% · %s", club.Confidence, club.JobSecurity()))
+}
+
+// Vacancies renders the jobs open to a sacked manager, with the cursor row
+// highlighted
+func Vacancies(clubs []*domain.ClubWithPlayers, divisions []*domain.Division, cursor int) string {
+	headerStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("252"))
+	cursorStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("170"))
+	mutedStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("241"))
+
+	if len(clubs) == 0 {
+		return mutedStyle.Render("No vacancies")
+	}
+
+	divisionNames := make(map[int64]string, len(divisions))
+	for _, division := range divisions {
+		divisionNames[division.ID] = division.Name
+	}
+
+	lines := []string{
+		headerStyle.Render(fmt.Sprintf("  %-22s %-16s %3s  %s", "Club", "Division", "Str", "Objective")),
+	}
+	for i, club := range clubs {
+		line := fmt.Sprintf("%-22s %-16s %3d  %s",
+			clip(club.Club.Name, 22),
+			clip(divisionNames[club.Club.DivisionID], 16),
+			club.Club.Strength,
+			club.Club.Objective)
+
+		if i == cursor {
+			line = cursorStyle.Render("> " + line)
+		} else {
+			line = "  " + line
+		}
+		lines = append(lines, line)
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -11,6 +11,10 @@ func Clock(match *domain.Match) string {
 	timeStr := fmt.Sprintf("(%v:00)", match.CurrentMinute)
 	if match.IsHalfTime() {
 		timeStr = "HT"
+	} else if match.Shootout != nil {
+		timeStr = "PENS"
+	} else if match.IsFullTime() && match.IsExtraTime() {
+		timeStr = "AET"
 	} else if match.IsFullTime() {
 		timeStr = "FT"
 	} else if match.IsFirstHalf() && match.IsInAddedTime() {
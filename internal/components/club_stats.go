@@ -0,0 +1,51 @@
+package components
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cameronjpr/gaffer/internal/domain"
+)
+
+// clubStatLeaders is how many players each leaderboard shows
+const clubStatLeaders = 5
+
+// ClubStats renders the club's season leaderboards for goals, appearances,
+// average rating and cards
+func ClubStats(players []domain.Player, stats map[int64]domain.SeasonStats) string {
+	boards := []struct {
+		title  string
+		value  func(domain.SeasonStats) float64
+		format string
+	}{
+		{"Top scorers", func(s domain.SeasonStats) float64 { return float64(s.Goals) }, "%.0f"},
+		{"Most appearances", func(s domain.SeasonStats) float64 { return float64(s.Appearances) }, "%.0f"},
+		{"Best average rating", func(s domain.SeasonStats) float64 { return s.AverageRating }, "%.2f"},
+		{"Most cards", func(s domain.SeasonStats) float64 { return float64(s.YellowCards + s.RedCards) }, "%.0f"},
+	}
+
+	sections := make([]string, 0, len(boards))
+	for _, board := range boards {
+		leaders := make([]domain.Player, 0, len(players))
+		for _, player := range players {
+			if board.value(stats[player.ID]) > 0 {
+				leaders = append(leaders, player)
+			}
+		}
+		sort.SliceStable(leaders, func(i, j int) bool {
+			return board.value(stats[leaders[i].ID]) > board.value(stats[leaders[j].ID])
+		})
+
+		section := board.title + ":\n"
+		if len(leaders) == 0 {
+			section += "None yet\n"
+		}
+		for _, player := range leaders[:min(len(leaders), clubStatLeaders)] {
+			section += fmt.Sprintf("%-22s "+board.format+"\n", player.Name, board.value(stats[player.ID]))
+		}
+		sections = append(sections, section)
+	}
+
+	return strings.Join(sections, "\n")
+}
@@ -26,7 +26,8 @@ func CommentaryBar(match *domain.Match, width int) string {
 		style := lipgloss.NewStyle().Align(lipgloss.Center).Width(width)
 
 		// Use the commentary's For field to determine styling
-		if commentary.EventType == domain.GoalEvent && commentary.For != nil {
+		isGoal := commentary.EventType == domain.GoalEvent || commentary.EventType == domain.PenaltyScoredEvent
+		if isGoal && commentary.For != nil {
 			style = style.Bold(true).
 				Background(lipgloss.Color(commentary.For.Club.Background)).
 				Foreground(lipgloss.Color(commentary.For.Club.Foreground))
@@ -65,6 +66,12 @@ func generateCommentary(event domain.Event, match *domain.Match) CommentaryLine
 			line.Message = "First half starts!"
 		case 2:
 			line.Message = "Second half starts!"
+		case 3:
+			line.Message = fmt.Sprintf("Level at %d-%d, so we go to extra time!", match.Home.Score, match.Away.Score)
+		case 4:
+			line.Message = "Second half of extra time starts!"
+		case 5:
+			line.Message = "Still level after extra time, so it's penalties!"
 		}
 	case domain.HalfEndsEvent:
 		switch match.CurrentHalf {
@@ -95,6 +102,18 @@ func generateCommentary(event domain.Event, match *domain.Match) CommentaryLine
 		} else {
 			line.Message = "Missed shot!"
 		}
+	case domain.FoulEvent:
+		if event.Player != nil && event.Player.Player != nil {
+			line.Message = fmt.Sprintf("Foul by %s", event.Player.Player.Name)
+		} else {
+			line.Message = fmt.Sprintf("Foul by %s", event.For.Club.Name)
+		}
+	case domain.InjuryEvent:
+		if event.Player != nil && event.Player.Player != nil && event.Player.Injury != nil {
+			line.Message = fmt.Sprintf("%s goes down injured (%s)", event.Player.Player.Name, event.Player.Injury.Type)
+		} else {
+			line.Message = fmt.Sprintf("Injury for %s", event.For.Club.Name)
+		}
 	case domain.YellowCardEvent:
 		if event.Player != nil && event.Player.Player != nil {
 			line.Message = fmt.Sprintf("Yellow card for %s", event.Player.Player.Name)
@@ -107,9 +126,26 @@ func generateCommentary(event domain.Event, match *domain.Match) CommentaryLine
 		} else {
 			line.Message = "RED CARD!"
 		}
+	case domain.PenaltyScoredEvent:
+		line.Message = fmt.Sprintf("SCORED: %s converts for %s", event.Player.Player.Name, event.For.Club.Name)
+	case domain.PenaltySavedEvent:
+		line.Message = fmt.Sprintf("SAVED! %s is denied", event.Player.Player.Name)
+		if keeper := lastPenaltyKeeper(match); keeper != nil {
+			line.Message = fmt.Sprintf("SAVED! %s keeps out %s's penalty", keeper.Player.Name, event.Player.Player.Name)
+		}
+	case domain.PenaltyMissedEvent:
+		line.Message = fmt.Sprintf("MISSED! %s puts it wide", event.Player.Player.Name)
 	default:
 		line.Message = fmt.Sprintf("Event: %d", event.Type)
 	}
 
 	return line
 }
+
+// lastPenaltyKeeper returns the goalkeeper who faced the latest shootout kick
+func lastPenaltyKeeper(match *domain.Match) *domain.MatchPlayerParticipant {
+	if match.Shootout == nil || len(match.Shootout.Kicks) == 0 {
+		return nil
+	}
+	return match.Shootout.Kicks[len(match.Shootout.Kicks)-1].Keeper
+}
@@ -0,0 +1,136 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cameronjpr/gaffer/internal/domain"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// ContinentalCup renders a cup with a group stage: its knockout rounds so
+// far, latest first, above the group tables, with the club's own ties and
+// group highlighted
+func ContinentalCup(cup *domain.Cup, clubID int64) string {
+	headingStyle := lipgloss.NewStyle().Bold(true)
+	userStyle := lipgloss.NewStyle().Bold(true).Background(lipgloss.Color("236"))
+	mutedStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("241"))
+
+	lines := []string{headingStyle.Render(cup.Name), ""}
+
+	entered := false
+	for _, club := range cup.Entrants {
+		entered = entered || club.ID == clubID
+	}
+
+	switch champion := cup.Champion(); {
+	case champion != nil:
+		lines = append(lines, fmt.Sprintf("Winners: %s", champion.Name))
+	case !entered:
+		lines = append(lines, mutedStyle.Render("You did not qualify this season"))
+	case len(cup.Rounds) == 0:
+		lines = append(lines, mutedStyle.Render("The group stage has not been drawn yet"))
+	case cup.KnockedOutIn(clubID) != nil:
+		lines = append(lines, fmt.Sprintf("You went out in the %s", cup.KnockedOutIn(clubID).Name))
+	default:
+		lines = append(lines, "You are still in the cup")
+	}
+
+	for i := len(cup.Rounds) - 1; i >= 0; i-- {
+		round := cup.Rounds[i]
+		if round.GroupStage {
+			continue
+		}
+
+		lines = append(lines, "", headingStyle.Render(roundHeading(round)))
+		var ties []string
+		var involved []bool
+		if round.Legs == 2 {
+			for _, pairing := range round.Pairings() {
+				ties = append(ties, TwoLeggedTie(pairing))
+				involved = append(involved, pairing.First.Home.ID == clubID || pairing.First.Away.ID == clubID)
+			}
+		} else {
+			for _, tie := range round.Ties {
+				ties = append(ties, CupTie(tie))
+				involved = append(involved, tie.Home.ID == clubID || tie.Away.ID == clubID)
+			}
+		}
+		for j, tie := range ties {
+			if involved[j] {
+				tie = userStyle.Render(tie)
+			}
+			lines = append(lines, tie)
+		}
+	}
+
+	if len(cup.Groups) > 0 {
+		groups := make([]string, len(cup.Groups))
+		for i, group := range cup.Groups {
+			groups[i] = CupGroup(group, clubID)
+		}
+
+		// Two groups to a row
+		lines = append(lines, "")
+		for i := 0; i < len(groups); i += 2 {
+			row := groups[i]
+			if i+1 < len(groups) {
+				row = lipgloss.JoinHorizontal(lipgloss.Top, row, "    ", groups[i+1])
+			}
+			lines = append(lines, row, "")
+		}
+		lines = append(lines, lipgloss.NewStyle().Foreground(zoneColours[domain.KnockoutStageZone]).Render("▌ ")+mutedStyle.Render("Knockout stage"))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// CupGroup renders a group's mini-table
+func CupGroup(group *domain.CupGroup, clubID int64) string {
+	headerStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("252"))
+	userStyle := lipgloss.NewStyle().Bold(true).Background(lipgloss.Color("236"))
+
+	lines := []string{
+		headerStyle.Render(fmt.Sprintf("  Group %-18s %2s %3s %3s", group.Name, "P", "GD", "Pts")),
+	}
+	if group.Table == nil {
+		return lines[0]
+	}
+
+	for i, position := range group.Table.Positions {
+		marker := "  "
+		if colour, ok := zoneColours[group.Table.Zone(i)]; ok {
+			marker = lipgloss.NewStyle().Foreground(colour).Render("▌ ")
+		}
+
+		line := fmt.Sprintf("%d %-22s %2d %+3d %3d",
+			i+1,
+			position.Club.Name,
+			position.Played,
+			position.GoalDifference,
+			position.Points)
+		if position.Club.ID == clubID {
+			line = userStyle.Render(line)
+		}
+		lines = append(lines, marker+line)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// TwoLeggedTie formats a tie played over two legs as each leg so far and,
+// once both are played, the aggregate score
+func TwoLeggedTie(tie domain.TwoLeggedTie) string {
+	second := tie.Second
+	if !second.Played {
+		return CupTie(tie.First) + " · " + CupTie(second)
+	}
+
+	home, away := tie.Aggregate()
+	aggregate := fmt.Sprintf("agg %d-%d", home, away)
+	if second.WentToPenalties() {
+		aggregate += fmt.Sprintf(", %d-%d pens", second.HomePenalties, second.AwayPenalties)
+	}
+	return fmt.Sprintf("%s · %s %d-%d %s (%s)",
+		CupTie(tie.First), second.Home.Name, second.HomeGoals, second.AwayGoals, second.Away.Name, aggregate)
+}
@@ -0,0 +1,81 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cameronjpr/gaffer/internal/domain"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Wage writes a weekly wage in full, e.g. "£12,500 a week"
+func Wage(amount int64) string {
+	digits := fmt.Sprintf("%d", amount)
+	var grouped []string
+	for len(digits) > 3 {
+		grouped = append([]string{digits[len(digits)-3:]}, grouped...)
+		digits = digits[:len(digits)-3]
+	}
+	grouped = append([]string{digits}, grouped...)
+	return "£" + strings.Join(grouped, ",") + " a week"
+}
+
+// ContractEnds says when a contract runs out, flagging one in its final year
+func ContractEnds(contract domain.Contract, startYear int) string {
+	ends := fmt.Sprintf("June %d", contract.Ends)
+	if contract.InFinalYear(startYear) {
+		ends += " (final year)"
+	}
+	return ends
+}
+
+// ReleaseClause shows the fee in a contract's release clause, if it has one
+func ReleaseClause(contract domain.Contract) string {
+	if contract.ReleaseClause == 0 {
+		return "None"
+	}
+	return Money(contract.ReleaseClause)
+}
+
+// ContractTalks shows how talks over a new contract stand and the terms about
+// to be offered
+func ContractTalks(talks *domain.ContractTalks, offer domain.Contract) string {
+	headingStyle := lipgloss.NewStyle().Bold(true)
+	labelStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("241")).Width(16)
+	row := func(label, value string) string {
+		return labelStyle.Render(label) + value
+	}
+
+	lines := []string{
+		headingStyle.Render("New contract for " + talks.Player.Name),
+		"",
+		row("Current wage", Wage(talks.Player.Contract.Wage)),
+		row("Market rate", Wage(talks.Player.MarketWage())),
+		row("Expects", talks.Expected.String()),
+	}
+	if talks.Demand > 0 {
+		lines = append(lines, row("Demands", Wage(talks.Demand)))
+	}
+	if talks.Reply != "" {
+		lines = append(lines, "", talks.Reply)
+	}
+
+	lines = append(lines, "")
+	switch talks.Status {
+	case domain.NegotiationOpen:
+		lines = append(lines,
+			row("Wage", headingStyle.Render(Wage(offer.Wage))),
+			row("Length", fmt.Sprintf("%d years, until June %d", talks.Years(offer), offer.Ends)),
+			row("Role", offer.Role.String()),
+			row("Release clause", ReleaseClause(offer)),
+			row("Offers left", fmt.Sprintf("%d", domain.MaxBids-talks.Offers)))
+	case domain.NegotiationAgreed:
+		lines = append(lines,
+			row("Wage", Wage(talks.Agreed.Wage)),
+			row("Until", fmt.Sprintf("June %d", talks.Agreed.Ends)),
+			row("Role", talks.Agreed.Role.String()),
+			row("Release clause", ReleaseClause(*talks.Agreed)))
+	}
+
+	return strings.Join(lines, "\n")
+}
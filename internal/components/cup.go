@@ -0,0 +1,67 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cameronjpr/gaffer/internal/domain"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// CupBracket renders a cup's rounds so far, latest first, with the club's
+// own ties highlighted and a line on how its run is going
+func CupBracket(cup *domain.Cup, clubID int64) string {
+	headingStyle := lipgloss.NewStyle().Bold(true)
+	userStyle := lipgloss.NewStyle().Bold(true).Background(lipgloss.Color("236"))
+	mutedStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("241"))
+
+	lines := []string{headingStyle.Render(cup.Name), ""}
+
+	switch champion := cup.Champion(); {
+	case len(cup.Rounds) == 0:
+		lines = append(lines, mutedStyle.Render("The first round has not been drawn yet"))
+	case champion != nil:
+		lines = append(lines, fmt.Sprintf("Winners: %s", champion.Name))
+	case cup.KnockedOutIn(clubID) != nil:
+		lines = append(lines, fmt.Sprintf("You went out in the %s", cup.KnockedOutIn(clubID).Name))
+	default:
+		lines = append(lines, "You are still in the cup")
+	}
+
+	for i := len(cup.Rounds) - 1; i >= 0; i-- {
+		round := cup.Rounds[i]
+		lines = append(lines, "", headingStyle.Render(roundHeading(round)))
+		for _, tie := range round.Ties {
+			line := CupTie(tie)
+			if tie.Home.ID == clubID || tie.Away.ID == clubID {
+				line = userStyle.Render(line)
+			} else if !tie.Played {
+				line = mutedStyle.Render(line)
+			}
+			lines = append(lines, line)
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// roundHeading names a cup round and when it's played, going by its first tie
+func roundHeading(round *domain.CupRound) string {
+	if len(round.Ties) > 0 && !round.Ties[0].Date.IsZero() {
+		return fmt.Sprintf("%s · %s", round.Name, Date(round.Ties[0].Date))
+	}
+	return fmt.Sprintf("%s · GW%d", round.Name, round.Gameweek)
+}
+
+// CupTie formats a tie as its fixture or, once played, its result
+func CupTie(tie *domain.CupTie) string {
+	if !tie.Played {
+		return fmt.Sprintf("%s vs %s", tie.Home.Name, tie.Away.Name)
+	}
+
+	result := fmt.Sprintf("%s %d-%d %s", tie.Home.Name, tie.HomeGoals, tie.AwayGoals, tie.Away.Name)
+	if tie.WentToPenalties() {
+		result += fmt.Sprintf(" (%d-%d pens)", tie.HomePenalties, tie.AwayPenalties)
+	}
+	return result
+}
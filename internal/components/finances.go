@@ -0,0 +1,72 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cameronjpr/gaffer/internal/domain"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Finances shows the club's balance, budget and wage bill, what the board
+// makes of them and of the manager, and the season's accounts so far
+func Finances(club *domain.ClubWithPlayers, summary *domain.FinanceSummary) string {
+	headingStyle := lipgloss.NewStyle().Bold(true)
+	labelStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("241")).Width(24)
+	mutedStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("241"))
+	inStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("42"))
+	outStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("196"))
+	dateStyle := lipgloss.NewStyle().Width(11)
+	amountStyle := lipgloss.NewStyle().Width(9)
+	row := func(label, value string) string {
+		return labelStyle.Render(label) + value
+	}
+	amount := func(value int64) string {
+		if value < 0 {
+			return outStyle.Render(Money(value))
+		}
+		return inStyle.Render(Money(value))
+	}
+
+	overview := []string{
+		headingStyle.Render("Club finances"),
+		row("Balance", amount(club.Club.Balance)),
+		row("Transfer budget", Money(club.Club.TransferBudget)),
+		row("Wage bill", Money(club.WageBill())+" a week"),
+		"",
+		headingStyle.Render("The board"),
+		row("Finances", club.BoardStance().String()),
+		row("Objective", club.Club.Objective.String()),
+		row("Confidence", Confidence(club.Club)),
+	}
+
+	accounts := []string{headingStyle.Render("This season")}
+	var net int64
+	if summary == nil || len(summary.Totals) == 0 {
+		accounts = append(accounts, mutedStyle.Render("Nothing in the accounts yet"))
+	} else {
+		for _, total := range summary.Totals {
+			accounts = append(accounts, row(total.Category, amount(total.Total)))
+			net += total.Total
+		}
+		accounts = append(accounts, row("Net", amount(net)))
+	}
+
+	recent := []string{headingStyle.Render("Recent transactions")}
+	if summary == nil || len(summary.Recent) == 0 {
+		recent = append(recent, mutedStyle.Render("No transactions yet"))
+	} else {
+		for _, transaction := range summary.Recent {
+			recent = append(recent, fmt.Sprintf("%s%s %s",
+				dateStyle.Render(Date(transaction.Date)),
+				amountStyle.Render(amount(transaction.Amount)),
+				clip(transaction.Description, 40)))
+		}
+	}
+
+	left := Stack([]string{strings.Join(overview, "\n"), strings.Join(accounts, "\n")}, 1)
+	return lipgloss.JoinHorizontal(lipgloss.Top,
+		lipgloss.NewStyle().PaddingRight(6).Render(left),
+		strings.Join(recent, "\n"),
+	)
+}
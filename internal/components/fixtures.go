@@ -2,10 +2,16 @@ package components
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/cameronjpr/gaffer/internal/domain"
 )
 
+// Date writes a calendar date the short way, e.g. "Sat 16 Aug"
+func Date(date time.Time) string {
+	return date.Format("Mon 2 Jan")
+}
+
 func Fixtures(fixtures []*domain.Fixture) string {
 	fixturesStr := "Fixtures:\n"
 
@@ -15,7 +21,19 @@ func Fixtures(fixtures []*domain.Fixture) string {
 		fixturesStr += "No fixtures scheduled\n"
 	} else {
 		for _, fixture := range fixtures[:numToShow] {
-			fixturesStr += fmt.Sprintf("GW%d: %s vs %s\n", fixture.Gameweek, fixture.HomeTeam.Club.Name, fixture.AwayTeam.Club.Name)
+			when := fmt.Sprintf("GW%d", fixture.Gameweek)
+			if !fixture.Date.IsZero() {
+				when = Date(fixture.Date)
+			}
+			fixturesStr += fmt.Sprintf("%s: %s vs %s", when, fixture.HomeTeam.Club.Name, fixture.AwayTeam.Club.Name)
+			if fixture.CupRound != nil {
+				fixturesStr += fmt.Sprintf(" (%s %s", fixture.CupRound.CupName, fixture.CupRound.Name)
+				if fixture.CupRound.Legs == 2 {
+					fixturesStr += fmt.Sprintf(", leg %d", fixture.Leg)
+				}
+				fixturesStr += ")"
+			}
+			fixturesStr += "\n"
 		}
 	}
 
@@ -0,0 +1,80 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cameronjpr/gaffer/internal/domain"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// answers says what accepting or rejecting each kind of message that waits on
+// an answer does
+var answers = map[domain.MessageKind]string{
+	domain.TransferOfferMessage: "A to accept the offer and sell, X to turn it down",
+	domain.ComplaintMessage:     "A to promise a bigger role, X to tell them to earn it",
+}
+
+// Inbox renders a page of the manager's messages, with the cursor row
+// highlighted and unread messages marked
+func Inbox(messages []domain.Message, cursor int) string {
+	headerStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("252"))
+	cursorStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("170"))
+	unreadStyle := lipgloss.NewStyle().Bold(true)
+	mutedStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("241"))
+
+	if len(messages) == 0 {
+		return mutedStyle.Render("No messages")
+	}
+
+	lines := []string{
+		headerStyle.Render(fmt.Sprintf("    %-10s %-14s %s", "Date", "From", "Subject")),
+	}
+	for i, message := range messages {
+		marker := " "
+		if !message.Read {
+			marker = "●"
+		}
+		if message.Pending() {
+			marker = "!"
+		}
+		line := fmt.Sprintf("%s %-10s %-14s %s", marker, Date(message.Date), message.Kind, message.Subject)
+
+		switch {
+		case i == cursor:
+			line = cursorStyle.Render("> " + line)
+		case !message.Read:
+			line = unreadStyle.Render("  " + line)
+		default:
+			line = "  " + line
+		}
+		lines = append(lines, line)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// MessageDetail renders a message in full, with the fee for a transfer offer
+// and, while it waits on an answer, how to give one
+func MessageDetail(message domain.Message) string {
+	mutedStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("241"))
+	labelStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("241")).Width(8)
+
+	lines := []string{
+		lipgloss.NewStyle().Bold(true).Render(message.Subject),
+		mutedStyle.Render(message.Kind.String() + " · " + message.Date.Format("2 Jan 2006")),
+		"",
+		message.Body,
+	}
+	if message.Kind == domain.TransferOfferMessage {
+		lines = append(lines, "", labelStyle.Render("Fee")+Money(message.Fee))
+	}
+	if message.Status != domain.MessageInfo {
+		lines = append(lines, labelStyle.Render("Status")+message.Status.String())
+	}
+	if message.Pending() {
+		lines = append(lines, "", mutedStyle.Render(answers[message.Kind]))
+	}
+
+	return strings.Join(lines, "\n")
+}
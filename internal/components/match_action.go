@@ -10,6 +10,25 @@ import (
 func MatchActionView(width int, speed string, match *domain.Match) string {
 	gap := " "
 
+	// The shootout takes the pitch's place once penalties begin
+	if match.Shootout != nil {
+		return lipgloss.JoinVertical(
+			lipgloss.Center,
+			fmt.Sprintf("Speed: %s", speed),
+			Scoreboard(
+				match,
+				width,
+			),
+			Clock(match),
+			gap,
+			EventsTimeline(match, width),
+			gap,
+			ShootoutView(match, width),
+			gap,
+			CommentaryBar(match, width),
+		)
+	}
+
 	return lipgloss.JoinVertical(
 		lipgloss.Center,
 		fmt.Sprintf("Speed: %s", speed),
@@ -28,6 +28,13 @@ func MatchHeader(width int, match *domain.Match, userTeam *domain.MatchParticipa
 
 	// Center section: Score
 	scoreText := fmt.Sprintf("%s %d - %d %s", homeClub, homeScore, awayScore, awayClub)
+	if match.FirstLeg != nil {
+		homeAggregate, awayAggregate := match.Aggregate()
+		scoreText += fmt.Sprintf(" (agg %d-%d)", homeAggregate, awayAggregate)
+	}
+	if match.Shootout != nil {
+		scoreText += fmt.Sprintf(" (%d-%d pens)", match.Shootout.HomeScored, match.Shootout.AwayScored)
+	}
 	centerSection := lipgloss.NewStyle().
 		Bold(true).
 		Align(lipgloss.Center).
@@ -36,8 +43,15 @@ func MatchHeader(width int, match *domain.Match, userTeam *domain.MatchParticipa
 	// Right section: Match time
 	minutes := match.CurrentMinute
 	half := "1st Half"
-	if match.CurrentHalf == domain.SecondHalf {
+	switch match.CurrentHalf {
+	case domain.SecondHalf:
 		half = "2nd Half"
+	case domain.ExtraTimeFirstHalf:
+		half = "ET 1st Half"
+	case domain.ExtraTimeSecondHalf:
+		half = "ET 2nd Half"
+	case domain.PenaltyShootout:
+		half = "Penalties"
 	}
 	timeText := fmt.Sprintf("%s %d' ", half, minutes)
 	rightSection := lipgloss.NewStyle().
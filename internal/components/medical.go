@@ -0,0 +1,28 @@
+package components
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cameronjpr/gaffer/internal/domain"
+)
+
+// Medical lists injured players with the day they're expected back, counting
+// from today
+func Medical(injuries []domain.Injury, today time.Time) string {
+	medicalStr := "Medical:\n"
+
+	if len(injuries) == 0 {
+		medicalStr += "No injuries\n"
+	} else {
+		for _, injury := range injuries {
+			medicalStr += fmt.Sprintf("%s – %s, back %s (%dd)\n",
+				injury.PlayerName,
+				injury.Type,
+				Date(today.AddDate(0, 0, injury.DaysRemaining)),
+				injury.DaysRemaining)
+		}
+	}
+
+	return medicalStr
+}
@@ -0,0 +1,122 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cameronjpr/gaffer/internal/domain"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// SeasonReview renders the headlines of a finished season: the champion,
+// where the manager's club finished, who went up and who went down
+func SeasonReview(summary *domain.SeasonSummary, club *domain.Club) string {
+	headingStyle := lipgloss.NewStyle().Bold(true)
+
+	heading := summary.Season.Name() + " season review"
+	if summary.Division != nil {
+		heading = summary.Season.Name() + " " + summary.Division.Name + " review"
+	}
+
+	lines := []string{
+		headingStyle.Render(heading),
+		"",
+		fmt.Sprintf("Champions: %s", summary.Champion.Name),
+		fmt.Sprintf("You finished: %s", ordinal(summary.Position)),
+	}
+	if movement, ok := summary.MovementFor(club); ok {
+		direction := "relegated to"
+		if movement.IsPromotion() {
+			direction = "promoted to"
+		}
+		lines = append(lines, fmt.Sprintf("You were %s the %s", direction, movement.To.Name))
+	}
+
+	for _, cup := range summary.Cups {
+		if champion := cup.Champion(); champion != nil {
+			lines = append(lines, fmt.Sprintf("%s winners: %s", cup.Name, champion.Name))
+		}
+	}
+
+	if summary.Table != nil {
+		for _, playOff := range summary.Table.PlayOffs {
+			lines = append(lines, "", headingStyle.Render(playOff.Reason+" play-off"))
+			for _, match := range playOff.Matches {
+				lines = append(lines, playOffMatch(match))
+			}
+			lines = append(lines, "")
+		}
+	}
+
+	if len(summary.Promoted) > 0 {
+		lines = append(lines, fmt.Sprintf("Promoted: %s", movementClubs(summary.Promoted)))
+	}
+	if summary.PlayOff != nil {
+		lines = append(lines, "", headingStyle.Render("Play-offs"))
+		for _, match := range summary.PlayOff.Matches {
+			lines = append(lines, playOffMatch(match))
+		}
+		lines = append(lines, "")
+	}
+	if len(summary.Relegated) > 0 {
+		lines = append(lines, fmt.Sprintf("Relegated: %s", movementClubs(summary.Relegated)))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// playOffMatch renders one play-off result, saying who went through on
+// penalties when it ended level
+func playOffMatch(match domain.PlayOffMatch) string {
+	line := fmt.Sprintf("%-10s %s %d-%d %s", match.Round, match.Home.Name, match.HomeGoals, match.AwayGoals, match.Away.Name)
+	if match.Winner != nil && match.HomeGoals == match.AwayGoals {
+		line += fmt.Sprintf(" (%s on penalties)", match.Winner.Name)
+	}
+	return line
+}
+
+// movementClubs lists the clubs making a set of movements
+func movementClubs(movements []domain.Movement) string {
+	names := make([]string, len(movements))
+	for i, movement := range movements {
+		names[i] = movement.Club.Name
+	}
+	return strings.Join(names, ", ")
+}
+
+// SeasonHistory renders a club's final standing in each completed season
+func SeasonHistory(history []domain.SeasonRecord) string {
+	historyStr := "Club history:\n"
+
+	if len(history) == 0 {
+		historyStr += "No completed seasons\n"
+	}
+	for _, record := range history {
+		historyStr += fmt.Sprintf("%-8s %-15s %-5s %2dW %2dD %2dL %3d pts\n",
+			record.Season.Name(),
+			record.Division,
+			ordinal(record.Position),
+			record.Won,
+			record.Drawn,
+			record.Lost,
+			record.Points,
+		)
+	}
+
+	return historyStr
+}
+
+// ordinal formats a league position as 1st, 2nd, 3rd, 4th...
+func ordinal(n int) string {
+	suffix := "th"
+	switch {
+	case n%100 >= 11 && n%100 <= 13:
+	case n%10 == 1:
+		suffix = "st"
+	case n%10 == 2:
+		suffix = "nd"
+	case n%10 == 3:
+		suffix = "rd"
+	}
+	return fmt.Sprintf("%d%s", n, suffix)
+}
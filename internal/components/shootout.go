@@ -0,0 +1,68 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cameronjpr/gaffer/internal/domain"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// ShootoutView renders a penalty shootout kick by kick: a row of marks per
+// side, the running score and who is stepping up next
+func ShootoutView(match *domain.Match, width int) string {
+	shootout := match.Shootout
+	if shootout == nil {
+		return ""
+	}
+
+	nameWidth := max(len(match.Home.Club.Name), len(match.Away.Club.Name))
+	row := func(participant *domain.MatchParticipant, scored int) string {
+		return fmt.Sprintf("%-*s  %s  %d", nameWidth, participant.Club.Name, penaltyMarks(shootout.KicksFor(participant)), scored)
+	}
+
+	title := "Penalties"
+	if shootout.IsSuddenDeath() && !shootout.IsOver() {
+		title = "Penalties · sudden death"
+	}
+
+	lines := []string{
+		lipgloss.NewStyle().Bold(true).Render(title),
+		row(match.Home, shootout.HomeScored),
+		row(match.Away, shootout.AwayScored),
+	}
+	if !shootout.IsOver() {
+		if taker := shootout.NextTaker(); taker != nil {
+			lines = append(lines, lipgloss.NewStyle().Foreground(lipgloss.Color("241")).Render("Up next: "+taker.Player.Name))
+		}
+	}
+
+	return lipgloss.NewStyle().
+		Width(width).
+		Align(lipgloss.Center).
+		Render(strings.Join(lines, "\n"))
+}
+
+// penaltyMarks draws a side's kicks, padded out to the first five with the
+// ones still to come
+func penaltyMarks(kicks []domain.PenaltyKick) string {
+	scoredStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("42"))
+	failedStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("196"))
+	pendingStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("241"))
+
+	marks := make([]string, 0, max(len(kicks), domain.ShootoutKicks))
+	for _, kick := range kicks {
+		switch kick.Outcome {
+		case domain.PenaltyScored:
+			marks = append(marks, scoredStyle.Render("●"))
+		case domain.PenaltySaved:
+			marks = append(marks, failedStyle.Render("○"))
+		case domain.PenaltyMissed:
+			marks = append(marks, failedStyle.Render("✕"))
+		}
+	}
+	for len(marks) < domain.ShootoutKicks {
+		marks = append(marks, pendingStyle.Render("·"))
+	}
+	return strings.Join(marks, " ")
+}
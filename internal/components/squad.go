@@ -0,0 +1,159 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cameronjpr/gaffer/internal/domain"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Fitness describes whether a player is fit to play
+func Fitness(player domain.Player) string {
+	switch {
+	case player.Injury != nil:
+		return fmt.Sprintf("Injured (%dd)", player.Injury.DaysRemaining)
+	case player.SuspendedMatches > 0:
+		return fmt.Sprintf("Banned (%d)", player.SuspendedMatches)
+	default:
+		return "Fit"
+	}
+}
+
+// Form renders a player's recent average rating, or a dash before their first appearance
+func Form(stats domain.SeasonStats) string {
+	if stats.Appearances == 0 {
+		return "–"
+	}
+	return fmt.Sprintf("%.1f", stats.Form)
+}
+
+// MoraleLevel names how happy a player is
+func MoraleLevel(morale int) string {
+	switch {
+	case morale >= 80:
+		return "Superb"
+	case morale >= 60:
+		return "Good"
+	case morale >= 40:
+		return "Okay"
+	case morale >= 20:
+		return "Poor"
+	default:
+		return "Abysmal"
+	}
+}
+
+// Morale shows how happy a player is, coloured by whether it's lifting or
+// weighing on their game
+func Morale(player domain.Player) string {
+	colour := "252"
+	switch {
+	case player.Morale >= 60:
+		colour = "42"
+	case player.Morale < 20:
+		colour = "196"
+	case player.Morale < 40:
+		colour = "214"
+	}
+	return lipgloss.NewStyle().Foreground(lipgloss.Color(colour)).Render(
+		fmt.Sprintf("%s (%d)", MoraleLevel(player.Morale), player.Morale))
+}
+
+// Cohesion says how well the club's players know each other
+func Cohesion(club *domain.Club) string {
+	return fmt.Sprintf("%s (%d)", MoraleLevel(club.Cohesion), club.Cohesion)
+}
+
+// SquadList renders the squad as a table with the cursor row highlighted and
+// contracts in their final year flagged
+func SquadList(players []domain.Player, stats map[int64]domain.SeasonStats, cursor, startYear int) string {
+	headerStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("252"))
+	cursorStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("170"))
+	unavailableStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("241"))
+	expiringStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("214"))
+
+	lines := []string{
+		headerStyle.Render(fmt.Sprintf("  %-22s %-3s %3s  %-13s %-7s %4s %4s %4s %3s %4s",
+			"Name", "Pos", "Qua", "Fitness", "Morale", "Form", "Apps", "Gls", "Crd", "Ends")),
+	}
+
+	if len(players) == 0 {
+		lines = append(lines, unavailableStyle.Render("  No players match this filter"))
+	}
+
+	for i, player := range players {
+		playerStats := stats[player.ID]
+		line := fmt.Sprintf("%-22s %-3s %3d  %-13s %-7s %4s %4d %4d %3d",
+			player.Name,
+			player.Position,
+			player.Quality,
+			Fitness(player),
+			MoraleLevel(player.Morale),
+			Form(playerStats),
+			playerStats.Appearances,
+			playerStats.Goals,
+			playerStats.YellowCards+playerStats.RedCards)
+		ends := fmt.Sprintf(" %4d", player.Contract.Ends)
+
+		switch {
+		case i == cursor:
+			line = cursorStyle.Render("> " + line + ends)
+		case player.Contract.InFinalYear(startYear):
+			line = "  " + line + expiringStyle.Render(ends)
+		case !player.IsAvailable():
+			line = unavailableStyle.Render("  " + line + ends)
+		default:
+			line = "  " + line + ends
+		}
+		lines = append(lines, line)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// PlayerDetail renders a profile card for a single player on the day
+func PlayerDetail(player domain.Player, stats domain.SeasonStats, today time.Time) string {
+	labelStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("241")).Width(16)
+	row := func(label, value string) string {
+		return labelStyle.Render(label) + value
+	}
+
+	averageRating := "–"
+	if stats.Appearances > 0 {
+		averageRating = fmt.Sprintf("%.2f", stats.AverageRating)
+	}
+	nationality := "–"
+	if player.Nationality != "" {
+		nationality = player.Nationality
+	}
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		lipgloss.NewStyle().Bold(true).Render(player.Name),
+		"",
+		row("Position", player.Position),
+		row("Nationality", nationality),
+		row("Born", fmt.Sprintf("%s (age %d)", player.DateOfBirth.Format("2 Jan 2006"), player.AgeOn(today))),
+		row("Quality", fmt.Sprintf("%d/20", player.Quality)),
+		row("Potential", fmt.Sprintf("%d/20", player.Potential)),
+		row("Fitness", Fitness(player)),
+		row("Sharpness", Sharpness(player)),
+		row("Morale", Morale(player)),
+		row("Form", Form(stats)),
+		"",
+		lipgloss.NewStyle().Bold(true).Render("Season"),
+		row("Appearances", fmt.Sprintf("%d (%d starts)", stats.Appearances, stats.Starts)),
+		row("Goals", fmt.Sprintf("%d", stats.Goals)),
+		row("Yellow cards", fmt.Sprintf("%d", stats.YellowCards)),
+		row("Red cards", fmt.Sprintf("%d", stats.RedCards)),
+		row("Avg. rating", averageRating),
+		"",
+		lipgloss.NewStyle().Bold(true).Render("Contract"),
+		row("Wage", Wage(player.Contract.Wage)),
+		row("Until", ContractEnds(player.Contract, domain.SeasonStartYear(today))),
+		row("Role", player.Contract.Role.String()),
+		row("Release clause", ReleaseClause(player.Contract)),
+	)
+}
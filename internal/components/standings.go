@@ -0,0 +1,136 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cameronjpr/gaffer/internal/domain"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// zoneColours colours the position of clubs in each part of the table
+var zoneColours = map[domain.LeagueZone]lipgloss.Color{
+	domain.ChampionsLeagueZone:  lipgloss.Color("33"),
+	domain.EuropaLeagueZone:     lipgloss.Color("208"),
+	domain.PromotionZone:        lipgloss.Color("42"),
+	domain.PromotionPlayOffZone: lipgloss.Color("220"),
+	domain.RelegationZone:       lipgloss.Color("196"),
+	domain.KnockoutStageZone:    lipgloss.Color("45"),
+}
+
+// outcomeColours colours each result in the form guide
+var outcomeColours = map[domain.Outcome]lipgloss.Color{
+	domain.Win:  lipgloss.Color("42"),
+	domain.Draw: lipgloss.Color("244"),
+	domain.Loss: lipgloss.Color("196"),
+}
+
+// StandingsChrome returns the number of lines the standings add around the
+// club rows: the header, legend and any notes on deductions and play-offs
+func StandingsChrome(lt *domain.LeagueTable) int {
+	lines := 3 + len(lt.PlayOffs)
+	for _, position := range lt.Positions {
+		if position.Deducted > 0 {
+			lines++
+		}
+	}
+	return lines
+}
+
+// Standings renders the full league table with zones, a form guide and the
+// user's club highlighted, showing up to rows clubs from offset onwards.
+func Standings(lt *domain.LeagueTable, userClubID int64, offset, rows int) string {
+	headerStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("252"))
+	userStyle := lipgloss.NewStyle().Bold(true).Background(lipgloss.Color("236"))
+	mutedStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("241"))
+
+	lines := []string{
+		headerStyle.Render(fmt.Sprintf("  %3s  %-18s %3s %3s %3s %3s %4s %4s %4s %4s  %s",
+			"Pos", "Club", "P", "W", "D", "L", "GF", "GA", "GD", "Pts", "Form")),
+	}
+
+	end := min(len(lt.Positions), offset+rows)
+	for i := offset; i < end; i++ {
+		position := lt.Positions[i]
+
+		marker := "  "
+		if colour, ok := zoneColours[lt.Zone(i)]; ok {
+			marker = lipgloss.NewStyle().Foreground(colour).Render("▌ ")
+		}
+
+		line := fmt.Sprintf("%3d  %-18s %3d %3d %3d %3d %4d %4d %+4d %4d  ",
+			i+1,
+			position.Club.Name,
+			position.Played,
+			position.Won,
+			position.Drawn,
+			position.Lost,
+			position.GoalsFor,
+			position.GoalsAgainst,
+			position.GoalDifference,
+			position.Points)
+		if position.Club.ID == userClubID {
+			line = userStyle.Render(line)
+		}
+
+		lines = append(lines, marker+line+FormGuide(position.Form))
+	}
+
+	legend := []string{}
+	for _, zone := range []struct {
+		zone  domain.LeagueZone
+		label string
+	}{
+		{domain.ChampionsLeagueZone, "Champions League"},
+		{domain.EuropaLeagueZone, "Europa League"},
+		{domain.PromotionZone, "Promotion"},
+		{domain.PromotionPlayOffZone, "Play-offs"},
+		{domain.RelegationZone, "Relegation"},
+		{domain.KnockoutStageZone, "Knockout stage"},
+	} {
+		if !hasZone(lt, zone.zone) {
+			continue
+		}
+		legend = append(legend, lipgloss.NewStyle().Foreground(zoneColours[zone.zone]).Render("▌ ")+mutedStyle.Render(zone.label))
+	}
+	lines = append(lines, "", strings.Join(legend, "   "))
+
+	// Notes on deductions and unbroken ties sit below the legend
+	for _, position := range lt.Positions {
+		if position.Deducted > 0 {
+			lines = append(lines, mutedStyle.Render(fmt.Sprintf("%s deducted %d points", position.Club.Name, position.Deducted)))
+		}
+	}
+	for _, playOff := range lt.PlayOffs {
+		names := make([]string, len(playOff.Clubs))
+		for i, club := range playOff.Clubs {
+			names[i] = club.Name
+		}
+		note := "%s play-off if the season ended now: %s"
+		if len(playOff.Matches) > 0 {
+			note = "%s settled by a play-off: %s"
+		}
+		lines = append(lines, mutedStyle.Render(fmt.Sprintf(note, playOff.Reason, strings.Join(names, ", "))))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// hasZone reports whether any position in the table falls in the zone
+func hasZone(lt *domain.LeagueTable, zone domain.LeagueZone) bool {
+	for i := range lt.Positions {
+		if lt.Zone(i) == zone {
+			return true
+		}
+	}
+	return false
+}
+
+// FormGuide renders recent results as coloured W/D/L letters, oldest first
+func FormGuide(form []domain.Outcome) string {
+	letters := make([]string, len(form))
+	for i, outcome := range form {
+		letters[i] = lipgloss.NewStyle().Bold(true).Foreground(outcomeColours[outcome]).Render(outcome.String())
+	}
+	return strings.Join(letters, " ")
+}
@@ -0,0 +1,21 @@
+package components
+
+import (
+	"fmt"
+
+	"github.com/cameronjpr/gaffer/internal/domain"
+)
+
+func Suspensions(suspensions []domain.Suspension) string {
+	suspensionsStr := "Suspensions:\n"
+
+	if len(suspensions) == 0 {
+		suspensionsStr += "No players suspended\n"
+	} else {
+		for _, suspension := range suspensions {
+			suspensionsStr += fmt.Sprintf("%s – misses %d (%s)\n", suspension.PlayerName, suspension.MatchesRemaining, suspension.Reason)
+		}
+	}
+
+	return suspensionsStr
+}
@@ -0,0 +1,38 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Tabs renders a row of numbered tab labels with the active tab highlighted
+func Tabs(width int, tabs []string, active int) string {
+	activeStyle := lipgloss.NewStyle().
+		Bold(true).
+		Padding(0, 2).
+		Foreground(lipgloss.Color("170")).
+		Underline(true)
+
+	inactiveStyle := lipgloss.NewStyle().
+		Padding(0, 2).
+		Foreground(lipgloss.Color("241"))
+
+	labels := make([]string, len(tabs))
+	for i, tab := range tabs {
+		label := fmt.Sprintf("%d %s", i+1, tab)
+		if i == active {
+			labels[i] = activeStyle.Render(label)
+		} else {
+			labels[i] = inactiveStyle.Render(label)
+		}
+	}
+
+	return lipgloss.NewStyle().
+		Width(width).
+		Align(lipgloss.Center).
+		Border(lipgloss.NormalBorder(), false, false, true, false).
+		BorderForeground(lipgloss.Color("240")).
+		Render(strings.Join(labels, ""))
+}
@@ -0,0 +1,106 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cameronjpr/gaffer/internal/domain"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var intensityEffects = map[domain.TrainingIntensity]string{
+	domain.IntensityLight:   "Fresh legs and few injuries, but slow progress",
+	domain.IntensityNormal:  "A balance of sharpness, progress and rest",
+	domain.IntensityIntense: "Sharper and quicker to improve, but tired and injury-prone",
+}
+
+var focusEffects = map[domain.TrainingFocus]string{
+	domain.FocusGeneral:   "No particular focus",
+	domain.FocusAttacking: "Midfielders and attackers improve quicker",
+	domain.FocusDefending: "Keepers and defenders improve quicker",
+	domain.FocusSetPieces: "More composed from the penalty spot",
+	domain.FocusFitness:   "Sharper and fresher, and veterans decline slower",
+}
+
+// Sharpness shows a player's match sharpness, coloured by how ready they are
+// to play
+func Sharpness(player domain.Player) string {
+	colour := "42"
+	switch {
+	case player.Sharpness < 50:
+		colour = "196"
+	case player.Sharpness < 75:
+		colour = "214"
+	}
+	return lipgloss.NewStyle().Foreground(lipgloss.Color(colour)).Render(fmt.Sprintf("%d%%", player.Sharpness))
+}
+
+// Familiarity says how used the club is to the formation it last played
+func Familiarity(club *domain.Club) string {
+	switch {
+	case club.Formation == "":
+		return "–"
+	case club.Familiarity == 1:
+		return club.Formation + ", played once"
+	}
+	return fmt.Sprintf("%s, %d matches running", club.Formation, club.Familiarity)
+}
+
+// TrainingPlanner renders the club's training schedule and what each player
+// works on, with the cursor on the intensity (0), the squad's focus (1) or a
+// player below
+func TrainingPlanner(club *domain.Club, players []domain.Player, cursor int) string {
+	headingStyle := lipgloss.NewStyle().Bold(true)
+	headerStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("252"))
+	cursorStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("170"))
+	mutedStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("241"))
+	labelStyle := lipgloss.NewStyle().Width(12)
+
+	setting := func(row int, label, value, effect string) string {
+		line := labelStyle.Render(label) + fmt.Sprintf("‹ %-10s ›", value)
+		if row == cursor {
+			return cursorStyle.Render("> "+line) + "  " + mutedStyle.Render(effect)
+		}
+		return "  " + line + "  " + mutedStyle.Render(effect)
+	}
+
+	plan := club.Training
+	lines := []string{
+		headingStyle.Render("Weekly schedule"),
+		"",
+		setting(0, "Intensity", plan.Intensity.String(), intensityEffects[plan.Intensity]),
+		setting(1, "Focus", plan.Focus.String(), focusEffects[plan.Focus]),
+		"  " + labelStyle.Render("Formation") + Familiarity(club),
+		"",
+		headingStyle.Render("Individual focus"),
+		"",
+		headerStyle.Render(fmt.Sprintf("  %-22s %-3s %3s %5s  %-13s %s",
+			"Name", "Pos", "Qua", "Sharp", "Fitness", "Focus")),
+	}
+
+	for i, player := range players {
+		focus := player.TrainingFocus.String()
+		if player.TrainingFocus == domain.FocusGeneral {
+			focus = "Squad (" + plan.Focus.String() + ")"
+		}
+		line := fmt.Sprintf("%-22s %-3s %3d %5s  %-13s %s",
+			player.Name,
+			player.Position,
+			player.Quality,
+			fmt.Sprintf("%d%%", player.Sharpness),
+			Fitness(player),
+			focus)
+
+		switch {
+		case i+2 == cursor:
+			line = cursorStyle.Render("> " + line)
+		case !player.IsAvailable():
+			line = mutedStyle.Render("  " + line)
+		default:
+			line = "  " + line
+		}
+		lines = append(lines, line)
+	}
+
+	return strings.Join(lines, "\n")
+}
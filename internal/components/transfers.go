@@ -0,0 +1,157 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cameronjpr/gaffer/internal/domain"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Money writes a fee the way the papers would, e.g. "£850k" or "£12.5m"
+func Money(amount int64) string {
+	sign := ""
+	if amount < 0 {
+		sign, amount = "-", -amount
+	}
+	if amount < 1_000_000 {
+		return fmt.Sprintf("%s£%dk", sign, amount/1_000)
+	}
+	millions := strings.TrimRight(strings.TrimRight(fmt.Sprintf("%.2f", float64(amount)/1_000_000), "0"), ".")
+	return fmt.Sprintf("%s£%sm", sign, millions)
+}
+
+// TransferWindowStatus says whether the transfer window is open, and until
+// when, or when it next opens
+func TransferWindowStatus(startYear int, today time.Time) string {
+	windowStr := "Transfer window:\n"
+
+	if window := domain.OpenTransferWindow(startYear, today); window != nil {
+		return windowStr + fmt.Sprintf("%s window open until %s\n", window.Name, Date(window.Closes))
+	}
+	if next := domain.NextTransferWindow(startYear, today); next != nil {
+		return windowStr + fmt.Sprintf("Shut until %s\n", Date(next.Opens))
+	}
+	return windowStr + "Shut until the summer\n"
+}
+
+// MarketList renders a page of the transfer market on the day, from the
+// offset down, with the cursor row highlighted
+func MarketList(entries []domain.MarketEntry, cursor, offset, rows int, today time.Time) string {
+	headerStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("252"))
+	cursorStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("170"))
+	mutedStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("241"))
+
+	lines := []string{
+		headerStyle.Render(fmt.Sprintf("  %-22s %-3s %3s %3s  %-20s %7s",
+			"Name", "Pos", "Qua", "Age", "Club", "Value")),
+	}
+
+	if len(entries) == 0 {
+		lines = append(lines, mutedStyle.Render("  No players match this search"))
+	}
+
+	end := min(len(entries), offset+rows)
+	for i := offset; i < end; i++ {
+		entry := entries[i]
+		line := fmt.Sprintf("%-22s %-3s %3d %3d  %-20s %7s",
+			entry.Player.Name,
+			entry.Player.Position,
+			entry.Player.Quality,
+			entry.Player.AgeOn(today),
+			clip(entry.Club.Club.Name, 20),
+			Money(entry.Player.Valuation(today)))
+
+		if i == cursor {
+			line = cursorStyle.Render("> " + line)
+		} else {
+			line = "  " + line
+		}
+		lines = append(lines, line)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// clip shortens text to fit a column of the given width
+func clip(text string, width int) string {
+	runes := []rune(text)
+	if len(runes) <= width {
+		return text
+	}
+	return string(runes[:width-1]) + "…"
+}
+
+// Transfers lists completed deals under the title, latest first
+func Transfers(title string, transfers []domain.Transfer, clubID int64, limit int) string {
+	headingStyle := lipgloss.NewStyle().Bold(true)
+	userStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("170"))
+	mutedStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("241"))
+
+	lines := []string{headingStyle.Render(title)}
+	if len(transfers) == 0 {
+		lines = append(lines, mutedStyle.Render("No transfers yet this season"))
+	}
+
+	for _, transfer := range transfers[:min(len(transfers), limit)] {
+		fee := Money(transfer.Fee)
+		if transfer.Loan {
+			fee = "loan"
+		}
+		line := fmt.Sprintf("%s %s: %s → %s, %s",
+			Date(transfer.Date),
+			transfer.Player.Name,
+			transfer.From.Name,
+			transfer.To.Name,
+			fee)
+		if transfer.From.ID == clubID || transfer.To.ID == clubID {
+			line = userStyle.Render(line)
+		}
+		lines = append(lines, line)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// Negotiation shows how talks for a player stand on the day and the fee about
+// to be offered
+func Negotiation(negotiation *domain.Negotiation, offer int64, today time.Time) string {
+	headingStyle := lipgloss.NewStyle().Bold(true)
+	labelStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("241")).Width(14)
+	row := func(label, value string) string {
+		return labelStyle.Render(label) + value
+	}
+
+	lines := []string{
+		headingStyle.Render(fmt.Sprintf("%s (%s)", negotiation.Player.Name, negotiation.Seller.Name)),
+		"",
+		row("Value", Money(negotiation.Player.Valuation(today))),
+		row("Asking price", Money(negotiation.Asking)),
+	}
+	if negotiation.Counter > 0 {
+		lines = append(lines, row("Their counter", Money(negotiation.Counter)))
+	}
+	for i, bid := range negotiation.Bids {
+		lines = append(lines, row(fmt.Sprintf("Bid %d", i+1), Money(bid)))
+	}
+
+	lines = append(lines, "")
+	switch negotiation.Status {
+	case domain.NegotiationOpen:
+		lines = append(lines,
+			row("Your offer", headingStyle.Render(Money(offer))),
+			row("Your budget", Money(negotiation.Buyer.TransferBudget)),
+			row("Bids left", fmt.Sprintf("%d", domain.MaxBids-len(negotiation.Bids))))
+	case domain.NegotiationAgreed:
+		lines = append(lines, fmt.Sprintf("Fee agreed: %s", Money(negotiation.Fee())))
+	default:
+		reason := negotiation.Refusal
+		if reason == "" {
+			reason = negotiation.Seller.Name + " have broken off talks"
+		}
+		lines = append(lines, reason)
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -7,19 +7,40 @@ package db
 
 import (
 	"context"
+	"database/sql"
 )
 
+const adjustClubFinances = `-- name: AdjustClubFinances :exec
+UPDATE clubs
+SET balance = balance + ?,
+    transfer_budget = MAX(transfer_budget + ?, 0)
+WHERE id = ?
+`
+
+type AdjustClubFinancesParams struct {
+	Balance        int64 `json:"balance"`
+	TransferBudget int64 `json:"transfer_budget"`
+	ID             int64 `json:"id"`
+}
+
+// Pays money in or out of a club's account and moves its transfer budget
+func (q *Queries) AdjustClubFinances(ctx context.Context, arg AdjustClubFinancesParams) error {
+	_, err := q.db.ExecContext(ctx, adjustClubFinances, arg.Balance, arg.TransferBudget, arg.ID)
+	return err
+}
+
 const createClub = `-- name: CreateClub :one
-INSERT INTO clubs (name, strength, background_color, foreground_color)
-VALUES (?, ?, ?, ?)
-RETURNING id, name, strength, background_color, foreground_color, created_at
+INSERT INTO clubs (name, strength, background_color, foreground_color, division_id)
+VALUES (?, ?, ?, ?, ?)
+RETURNING id, name, strength, background_color, foreground_color, created_at, division_id, balance, transfer_budget, academy, training_intensity, training_focus, formation, familiarity, cohesion, objective, confidence, vacant_since
 `
 
 type CreateClubParams struct {
-	Name            string `json:"name"`
-	Strength        int64  `json:"strength"`
-	BackgroundColor string `json:"background_color"`
-	ForegroundColor string `json:"foreground_color"`
+	Name            string        `json:"name"`
+	Strength        int64         `json:"strength"`
+	BackgroundColor string        `json:"background_color"`
+	ForegroundColor string        `json:"foreground_color"`
+	DivisionID      sql.NullInt64 `json:"division_id"`
 }
 
 func (q *Queries) CreateClub(ctx context.Context, arg CreateClubParams) (Club, error) {
@@ -28,6 +49,7 @@ func (q *Queries) CreateClub(ctx context.Context, arg CreateClubParams) (Club, e
 		arg.Strength,
 		arg.BackgroundColor,
 		arg.ForegroundColor,
+		arg.DivisionID,
 	)
 	var i Club
 	err := row.Scan(
@@ -37,10 +59,37 @@ func (q *Queries) CreateClub(ctx context.Context, arg CreateClubParams) (Club, e
 		&i.BackgroundColor,
 		&i.ForegroundColor,
 		&i.CreatedAt,
+		&i.DivisionID,
+		&i.Balance,
+		&i.TransferBudget,
+		&i.Academy,
+		&i.TrainingIntensity,
+		&i.TrainingFocus,
+		&i.Formation,
+		&i.Familiarity,
+		&i.Cohesion,
+		&i.Objective,
+		&i.Confidence,
+		&i.VacantSince,
 	)
 	return i, err
 }
 
+const createLastStarter = `-- name: CreateLastStarter :exec
+INSERT INTO last_starters (club_id, player_id)
+VALUES (?, ?)
+`
+
+type CreateLastStarterParams struct {
+	ClubID   int64 `json:"club_id"`
+	PlayerID int64 `json:"player_id"`
+}
+
+func (q *Queries) CreateLastStarter(ctx context.Context, arg CreateLastStarterParams) error {
+	_, err := q.db.ExecContext(ctx, createLastStarter, arg.ClubID, arg.PlayerID)
+	return err
+}
+
 const deleteClub = `-- name: DeleteClub :exec
 DELETE FROM clubs WHERE id = ?
 `
@@ -50,8 +99,17 @@ func (q *Queries) DeleteClub(ctx context.Context, id int64) error {
 	return err
 }
 
+const deleteLastStartersByClubID = `-- name: DeleteLastStartersByClubID :exec
+DELETE FROM last_starters WHERE club_id = ?
+`
+
+func (q *Queries) DeleteLastStartersByClubID(ctx context.Context, clubID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteLastStartersByClubID, clubID)
+	return err
+}
+
 const getAllClubs = `-- name: GetAllClubs :many
-SELECT id, name, strength, background_color, foreground_color, created_at FROM clubs ORDER BY name
+SELECT id, name, strength, background_color, foreground_color, created_at, division_id, balance, transfer_budget, academy, training_intensity, training_focus, formation, familiarity, cohesion, objective, confidence, vacant_since FROM clubs ORDER BY name
 `
 
 func (q *Queries) GetAllClubs(ctx context.Context) ([]Club, error) {
@@ -70,6 +128,18 @@ func (q *Queries) GetAllClubs(ctx context.Context) ([]Club, error) {
 			&i.BackgroundColor,
 			&i.ForegroundColor,
 			&i.CreatedAt,
+			&i.DivisionID,
+			&i.Balance,
+			&i.TransferBudget,
+			&i.Academy,
+			&i.TrainingIntensity,
+			&i.TrainingFocus,
+			&i.Formation,
+			&i.Familiarity,
+			&i.Cohesion,
+			&i.Objective,
+			&i.Confidence,
+			&i.VacantSince,
 		); err != nil {
 			return nil, err
 		}
@@ -85,7 +155,7 @@ func (q *Queries) GetAllClubs(ctx context.Context) ([]Club, error) {
 }
 
 const getClubByID = `-- name: GetClubByID :one
-SELECT id, name, strength, background_color, foreground_color, created_at FROM clubs WHERE id = ? LIMIT 1
+SELECT id, name, strength, background_color, foreground_color, created_at, division_id, balance, transfer_budget, academy, training_intensity, training_focus, formation, familiarity, cohesion, objective, confidence, vacant_since FROM clubs WHERE id = ? LIMIT 1
 `
 
 func (q *Queries) GetClubByID(ctx context.Context, id int64) (Club, error) {
@@ -98,12 +168,24 @@ func (q *Queries) GetClubByID(ctx context.Context, id int64) (Club, error) {
 		&i.BackgroundColor,
 		&i.ForegroundColor,
 		&i.CreatedAt,
+		&i.DivisionID,
+		&i.Balance,
+		&i.TransferBudget,
+		&i.Academy,
+		&i.TrainingIntensity,
+		&i.TrainingFocus,
+		&i.Formation,
+		&i.Familiarity,
+		&i.Cohesion,
+		&i.Objective,
+		&i.Confidence,
+		&i.VacantSince,
 	)
 	return i, err
 }
 
 const getClubByName = `-- name: GetClubByName :one
-SELECT id, name, strength, background_color, foreground_color, created_at FROM clubs WHERE name = ? LIMIT 1
+SELECT id, name, strength, background_color, foreground_color, created_at, division_id, balance, transfer_budget, academy, training_intensity, training_focus, formation, familiarity, cohesion, objective, confidence, vacant_since FROM clubs WHERE name = ? LIMIT 1
 `
 
 func (q *Queries) GetClubByName(ctx context.Context, name string) (Club, error) {
@@ -116,6 +198,155 @@ func (q *Queries) GetClubByName(ctx context.Context, name string) (Club, error)
 		&i.BackgroundColor,
 		&i.ForegroundColor,
 		&i.CreatedAt,
+		&i.DivisionID,
+		&i.Balance,
+		&i.TransferBudget,
+		&i.Academy,
+		&i.TrainingIntensity,
+		&i.TrainingFocus,
+		&i.Formation,
+		&i.Familiarity,
+		&i.Cohesion,
+		&i.Objective,
+		&i.Confidence,
+		&i.VacantSince,
 	)
 	return i, err
 }
+
+const getLastStartersByClubID = `-- name: GetLastStartersByClubID :many
+SELECT player_id FROM last_starters WHERE club_id = ?
+`
+
+func (q *Queries) GetLastStartersByClubID(ctx context.Context, clubID int64) ([]int64, error) {
+	rows, err := q.db.QueryContext(ctx, getLastStartersByClubID, clubID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var player_id int64
+		if err := rows.Scan(&player_id); err != nil {
+			return nil, err
+		}
+		items = append(items, player_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setClubAcademy = `-- name: SetClubAcademy :exec
+UPDATE clubs SET academy = ? WHERE id = ?
+`
+
+type SetClubAcademyParams struct {
+	Academy int64 `json:"academy"`
+	ID      int64 `json:"id"`
+}
+
+func (q *Queries) SetClubAcademy(ctx context.Context, arg SetClubAcademyParams) error {
+	_, err := q.db.ExecContext(ctx, setClubAcademy, arg.Academy, arg.ID)
+	return err
+}
+
+const setClubBoard = `-- name: SetClubBoard :exec
+UPDATE clubs SET objective = ?, confidence = ?, vacant_since = ? WHERE id = ?
+`
+
+type SetClubBoardParams struct {
+	Objective   int64  `json:"objective"`
+	Confidence  int64  `json:"confidence"`
+	VacantSince string `json:"vacant_since"`
+	ID          int64  `json:"id"`
+}
+
+// What the board expects of the manager, how much faith it has in them and
+// when the job last fell vacant
+func (q *Queries) SetClubBoard(ctx context.Context, arg SetClubBoardParams) error {
+	_, err := q.db.ExecContext(ctx, setClubBoard,
+		arg.Objective,
+		arg.Confidence,
+		arg.VacantSince,
+		arg.ID,
+	)
+	return err
+}
+
+const setClubCohesion = `-- name: SetClubCohesion :exec
+UPDATE clubs SET cohesion = ? WHERE id = ?
+`
+
+type SetClubCohesionParams struct {
+	Cohesion int64 `json:"cohesion"`
+	ID       int64 `json:"id"`
+}
+
+func (q *Queries) SetClubCohesion(ctx context.Context, arg SetClubCohesionParams) error {
+	_, err := q.db.ExecContext(ctx, setClubCohesion, arg.Cohesion, arg.ID)
+	return err
+}
+
+const setClubFamiliarity = `-- name: SetClubFamiliarity :exec
+UPDATE clubs SET formation = ?, familiarity = ? WHERE id = ?
+`
+
+type SetClubFamiliarityParams struct {
+	Formation   string `json:"formation"`
+	Familiarity int64  `json:"familiarity"`
+	ID          int64  `json:"id"`
+}
+
+// The formation a club last played and how many matches running it has used it
+func (q *Queries) SetClubFamiliarity(ctx context.Context, arg SetClubFamiliarityParams) error {
+	_, err := q.db.ExecContext(ctx, setClubFamiliarity, arg.Formation, arg.Familiarity, arg.ID)
+	return err
+}
+
+const setClubTraining = `-- name: SetClubTraining :exec
+UPDATE clubs SET training_intensity = ?, training_focus = ? WHERE id = ?
+`
+
+type SetClubTrainingParams struct {
+	TrainingIntensity int64 `json:"training_intensity"`
+	TrainingFocus     int64 `json:"training_focus"`
+	ID                int64 `json:"id"`
+}
+
+func (q *Queries) SetClubTraining(ctx context.Context, arg SetClubTrainingParams) error {
+	_, err := q.db.ExecContext(ctx, setClubTraining, arg.TrainingIntensity, arg.TrainingFocus, arg.ID)
+	return err
+}
+
+const setClubTransferBudget = `-- name: SetClubTransferBudget :exec
+UPDATE clubs SET transfer_budget = ? WHERE id = ?
+`
+
+type SetClubTransferBudgetParams struct {
+	TransferBudget int64 `json:"transfer_budget"`
+	ID             int64 `json:"id"`
+}
+
+func (q *Queries) SetClubTransferBudget(ctx context.Context, arg SetClubTransferBudgetParams) error {
+	_, err := q.db.ExecContext(ctx, setClubTransferBudget, arg.TransferBudget, arg.ID)
+	return err
+}
+
+const updateClubDivision = `-- name: UpdateClubDivision :exec
+UPDATE clubs SET division_id = ? WHERE id = ?
+`
+
+type UpdateClubDivisionParams struct {
+	DivisionID sql.NullInt64 `json:"division_id"`
+	ID         int64         `json:"id"`
+}
+
+func (q *Queries) UpdateClubDivision(ctx context.Context, arg UpdateClubDivisionParams) error {
+	_, err := q.db.ExecContext(ctx, updateClubDivision, arg.DivisionID, arg.ID)
+	return err
+}
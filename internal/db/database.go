@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 
 	_ "modernc.org/sqlite"
 )
@@ -36,16 +37,65 @@ func InitDB(dbPath string) (*sql.DB, error) {
 	return db, nil
 }
 
-// runMigrations executes the schema SQL
+// runMigrations executes each schema file in order, skipping any that have
+// already been applied to this database
 func runMigrations(db *sql.DB) error {
-	schema, err := os.ReadFile("db/schema/001_initial.sql")
+	if _, err := db.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+		name TEXT PRIMARY KEY,
+		applied_at DATETIME DEFAULT CURRENT_TIMESTAMP
+	)`); err != nil {
+		return fmt.Errorf("failed to create schema_migrations table: %w", err)
+	}
+
+	files, err := filepath.Glob("db/schema/*.sql")
 	if err != nil {
-		return fmt.Errorf("failed to read schema file: %w", err)
+		return fmt.Errorf("failed to list schema files: %w", err)
 	}
+	if len(files) == 0 {
+		return fmt.Errorf("no schema files found in db/schema")
+	}
+	sort.Strings(files)
+
+	for _, file := range files {
+		name := filepath.Base(file)
 
-	if _, err := db.Exec(string(schema)); err != nil {
-		return fmt.Errorf("failed to execute schema: %w", err)
+		var applied int
+		if err := db.QueryRow(`SELECT COUNT(*) FROM schema_migrations WHERE name = ?`, name).Scan(&applied); err != nil {
+			return fmt.Errorf("failed to check migration %s: %w", name, err)
+		}
+		if applied > 0 {
+			continue
+		}
+
+		schema, err := os.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf("failed to read schema file %s: %w", name, err)
+		}
+
+		if err := applyMigration(db, name, string(schema)); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
+
+// applyMigration runs a schema file and records it as applied in one
+// transaction, so a failing statement leaves the database as it was
+func applyMigration(db *sql.DB, name, schema string) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin migration %s: %w", name, err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(schema); err != nil {
+		return fmt.Errorf("failed to execute schema %s: %w", name, err)
+	}
+
+	if _, err := tx.Exec(`INSERT INTO schema_migrations (name) VALUES (?)`, name); err != nil {
+		return fmt.Errorf("failed to record migration %s: %w", name, err)
+	}
+
+	return tx.Commit()
+}
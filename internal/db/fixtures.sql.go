@@ -7,22 +7,51 @@ package db
 
 import (
 	"context"
+	"database/sql"
 )
 
+const countPlayedFixtures = `-- name: CountPlayedFixtures :one
+SELECT COUNT(*) FROM fixtures f
+JOIN matches m ON m.fixture_id = f.id AND m.is_completed = 1
+WHERE f.season_id = (SELECT id FROM seasons WHERE is_current = 1)
+  AND f.division_id = ?
+`
+
+func (q *Queries) CountPlayedFixtures(ctx context.Context, divisionID sql.NullInt64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countPlayedFixtures, divisionID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createFixture = `-- name: CreateFixture :one
-INSERT INTO fixtures (gameweek, home_team_id, away_team_id)
-VALUES (?, ?, ?)
-RETURNING id, gameweek, home_team_id, away_team_id, created_at
+INSERT INTO fixtures (gameweek, home_team_id, away_team_id, season_id, division_id, cup_round_id, leg, match_date)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+RETURNING id, gameweek, home_team_id, away_team_id, created_at, season_id, division_id, cup_round_id, leg, match_date
 `
 
 type CreateFixtureParams struct {
-	Gameweek   int64 `json:"gameweek"`
-	HomeTeamID int64 `json:"home_team_id"`
-	AwayTeamID int64 `json:"away_team_id"`
+	Gameweek   int64         `json:"gameweek"`
+	HomeTeamID int64         `json:"home_team_id"`
+	AwayTeamID int64         `json:"away_team_id"`
+	SeasonID   sql.NullInt64 `json:"season_id"`
+	DivisionID sql.NullInt64 `json:"division_id"`
+	CupRoundID sql.NullInt64 `json:"cup_round_id"`
+	Leg        int64         `json:"leg"`
+	MatchDate  string        `json:"match_date"`
 }
 
 func (q *Queries) CreateFixture(ctx context.Context, arg CreateFixtureParams) (Fixture, error) {
-	row := q.db.QueryRowContext(ctx, createFixture, arg.Gameweek, arg.HomeTeamID, arg.AwayTeamID)
+	row := q.db.QueryRowContext(ctx, createFixture,
+		arg.Gameweek,
+		arg.HomeTeamID,
+		arg.AwayTeamID,
+		arg.SeasonID,
+		arg.DivisionID,
+		arg.CupRoundID,
+		arg.Leg,
+		arg.MatchDate,
+	)
 	var i Fixture
 	err := row.Scan(
 		&i.ID,
@@ -30,10 +59,26 @@ func (q *Queries) CreateFixture(ctx context.Context, arg CreateFixtureParams) (F
 		&i.HomeTeamID,
 		&i.AwayTeamID,
 		&i.CreatedAt,
+		&i.SeasonID,
+		&i.DivisionID,
+		&i.CupRoundID,
+		&i.Leg,
+		&i.MatchDate,
 	)
 	return i, err
 }
 
+const deleteCurrentSeasonFixtures = `-- name: DeleteCurrentSeasonFixtures :exec
+DELETE FROM fixtures
+WHERE season_id = (SELECT id FROM seasons WHERE is_current = 1)
+  AND division_id = ?
+`
+
+func (q *Queries) DeleteCurrentSeasonFixtures(ctx context.Context, divisionID sql.NullInt64) error {
+	_, err := q.db.ExecContext(ctx, deleteCurrentSeasonFixtures, divisionID)
+	return err
+}
+
 const deleteFixture = `-- name: DeleteFixture :exec
 DELETE FROM fixtures WHERE id = ?
 `
@@ -44,7 +89,9 @@ func (q *Queries) DeleteFixture(ctx context.Context, id int64) error {
 }
 
 const getAllFixtures = `-- name: GetAllFixtures :many
-SELECT id, gameweek, home_team_id, away_team_id, created_at FROM fixtures ORDER BY id
+SELECT id, gameweek, home_team_id, away_team_id, created_at, season_id, division_id, cup_round_id, leg, match_date FROM fixtures
+WHERE season_id = (SELECT id FROM seasons WHERE is_current = 1)
+ORDER BY id
 `
 
 func (q *Queries) GetAllFixtures(ctx context.Context) ([]Fixture, error) {
@@ -62,6 +109,11 @@ func (q *Queries) GetAllFixtures(ctx context.Context) ([]Fixture, error) {
 			&i.HomeTeamID,
 			&i.AwayTeamID,
 			&i.CreatedAt,
+			&i.SeasonID,
+			&i.DivisionID,
+			&i.CupRoundID,
+			&i.Leg,
+			&i.MatchDate,
 		); err != nil {
 			return nil, err
 		}
@@ -76,8 +128,41 @@ func (q *Queries) GetAllFixtures(ctx context.Context) ([]Fixture, error) {
 	return items, nil
 }
 
+const getFirstLegResult = `-- name: GetFirstLegResult :one
+SELECT m.home_score, m.away_score
+FROM fixtures f
+JOIN matches m ON m.fixture_id = f.id AND m.is_completed = 1
+WHERE f.cup_round_id = ?
+  AND f.home_team_id = ?
+  AND f.away_team_id = ?
+  AND f.leg = 1
+LIMIT 1
+`
+
+type GetFirstLegResultParams struct {
+	CupRoundID sql.NullInt64 `json:"cup_round_id"`
+	HomeTeamID int64         `json:"home_team_id"`
+	AwayTeamID int64         `json:"away_team_id"`
+}
+
+type GetFirstLegResultRow struct {
+	HomeScore int64 `json:"home_score"`
+	AwayScore int64 `json:"away_score"`
+}
+
+// The first leg of a two-legged cup tie, played with home and away reversed
+func (q *Queries) GetFirstLegResult(ctx context.Context, arg GetFirstLegResultParams) (GetFirstLegResultRow, error) {
+	row := q.db.QueryRowContext(ctx, getFirstLegResult, arg.CupRoundID, arg.HomeTeamID, arg.AwayTeamID)
+	var i GetFirstLegResultRow
+	err := row.Scan(
+		&i.HomeScore,
+		&i.AwayScore,
+	)
+	return i, err
+}
+
 const getFixtureByID = `-- name: GetFixtureByID :one
-SELECT id, gameweek, home_team_id, away_team_id, created_at FROM fixtures WHERE id = ? LIMIT 1
+SELECT id, gameweek, home_team_id, away_team_id, created_at, season_id, division_id, cup_round_id, leg, match_date FROM fixtures WHERE id = ? LIMIT 1
 `
 
 func (q *Queries) GetFixtureByID(ctx context.Context, id int64) (Fixture, error) {
@@ -89,13 +174,19 @@ func (q *Queries) GetFixtureByID(ctx context.Context, id int64) (Fixture, error)
 		&i.HomeTeamID,
 		&i.AwayTeamID,
 		&i.CreatedAt,
+		&i.SeasonID,
+		&i.DivisionID,
+		&i.CupRoundID,
+		&i.Leg,
+		&i.MatchDate,
 	)
 	return i, err
 }
 
 const getFixturesByClubID = `-- name: GetFixturesByClubID :many
-SELECT id, gameweek, home_team_id, away_team_id, created_at FROM fixtures
-WHERE home_team_id = ? OR away_team_id = ?
+SELECT id, gameweek, home_team_id, away_team_id, created_at, season_id, division_id, cup_round_id, leg, match_date FROM fixtures
+WHERE (home_team_id = ? OR away_team_id = ?)
+  AND season_id = (SELECT id FROM seasons WHERE is_current = 1)
 ORDER BY id
 `
 
@@ -119,6 +210,11 @@ func (q *Queries) GetFixturesByClubID(ctx context.Context, arg GetFixturesByClub
 			&i.HomeTeamID,
 			&i.AwayTeamID,
 			&i.CreatedAt,
+			&i.SeasonID,
+			&i.DivisionID,
+			&i.CupRoundID,
+			&i.Leg,
+			&i.MatchDate,
 		); err != nil {
 			return nil, err
 		}
@@ -134,12 +230,13 @@ func (q *Queries) GetFixturesByClubID(ctx context.Context, arg GetFixturesByClub
 }
 
 const getUnplayedByClubID = `-- name: GetUnplayedByClubID :many
-SELECT f.id, f.gameweek, f.home_team_id, f.away_team_id, f.created_at
+SELECT f.id, f.gameweek, f.home_team_id, f.away_team_id, f.created_at, f.season_id, f.division_id, f.cup_round_id, f.leg, f.match_date
 FROM fixtures f
 LEFT JOIN matches m ON m.fixture_id = f.id AND m.is_completed = 1
 WHERE (f.home_team_id = ?1 OR f.away_team_id = ?1)
+  AND f.season_id = (SELECT id FROM seasons WHERE is_current = 1)
   AND m.id IS NULL
-ORDER BY f.gameweek, f.id
+ORDER BY f.match_date, f.id
 `
 
 func (q *Queries) GetUnplayedByClubID(ctx context.Context, homeTeamID int64) ([]Fixture, error) {
@@ -157,6 +254,100 @@ func (q *Queries) GetUnplayedByClubID(ctx context.Context, homeTeamID int64) ([]
 			&i.HomeTeamID,
 			&i.AwayTeamID,
 			&i.CreatedAt,
+			&i.SeasonID,
+			&i.DivisionID,
+			&i.CupRoundID,
+			&i.Leg,
+			&i.MatchDate,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUnplayedFixtures = `-- name: GetUnplayedFixtures :many
+SELECT f.id, f.gameweek, f.home_team_id, f.away_team_id, f.created_at, f.season_id, f.division_id, f.cup_round_id, f.leg, f.match_date
+FROM fixtures f
+LEFT JOIN matches m ON m.fixture_id = f.id AND m.is_completed = 1
+WHERE f.season_id = (SELECT id FROM seasons WHERE is_current = 1)
+  AND m.id IS NULL
+ORDER BY f.match_date, f.id
+`
+
+func (q *Queries) GetUnplayedFixtures(ctx context.Context) ([]Fixture, error) {
+	rows, err := q.db.QueryContext(ctx, getUnplayedFixtures)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Fixture{}
+	for rows.Next() {
+		var i Fixture
+		if err := rows.Scan(
+			&i.ID,
+			&i.Gameweek,
+			&i.HomeTeamID,
+			&i.AwayTeamID,
+			&i.CreatedAt,
+			&i.SeasonID,
+			&i.DivisionID,
+			&i.CupRoundID,
+			&i.Leg,
+			&i.MatchDate,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUnplayedFixturesThrough = `-- name: GetUnplayedFixturesThrough :many
+-- Every unplayed fixture in the current season dated on or before the given day
+SELECT f.id, f.gameweek, f.home_team_id, f.away_team_id, f.created_at, f.season_id, f.division_id, f.cup_round_id, f.leg, f.match_date
+FROM fixtures f
+LEFT JOIN matches m ON m.fixture_id = f.id AND m.is_completed = 1
+WHERE f.season_id = (SELECT id FROM seasons WHERE is_current = 1)
+  AND f.match_date <= ?
+  AND m.id IS NULL
+ORDER BY f.match_date, f.id
+`
+
+// Every unplayed fixture in the current season dated on or before the given day
+func (q *Queries) GetUnplayedFixturesThrough(ctx context.Context, matchDate string) ([]Fixture, error) {
+	rows, err := q.db.QueryContext(ctx, getUnplayedFixturesThrough, matchDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Fixture{}
+	for rows.Next() {
+		var i Fixture
+		if err := rows.Scan(
+			&i.ID,
+			&i.Gameweek,
+			&i.HomeTeamID,
+			&i.AwayTeamID,
+			&i.CreatedAt,
+			&i.SeasonID,
+			&i.DivisionID,
+			&i.CupRoundID,
+			&i.Leg,
+			&i.MatchDate,
 		); err != nil {
 			return nil, err
 		}
@@ -7,6 +7,7 @@ package db
 
 import (
 	"context"
+	"database/sql"
 )
 
 const completeMatch = `-- name: CompleteMatch :exec
@@ -41,7 +42,7 @@ INSERT INTO matches (
     home_attacking_direction
 )
 VALUES (?, ?, ?, ?, ?, ?, ?)
-RETURNING id, fixture_id, current_minute, current_half, home_score, away_score, active_zone, home_attacking_direction, is_completed, completed_at, created_at, updated_at
+RETURNING id, fixture_id, current_minute, current_half, home_score, away_score, active_zone, home_attacking_direction, is_completed, completed_at, created_at, updated_at, home_penalties, away_penalties
 `
 
 type CreateMatchParams struct {
@@ -78,6 +79,8 @@ func (q *Queries) CreateMatch(ctx context.Context, arg CreateMatchParams) (Match
 		&i.CompletedAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.HomePenalties,
+		&i.AwayPenalties,
 	)
 	return i, err
 }
@@ -101,7 +104,7 @@ func (q *Queries) DeleteMatch(ctx context.Context, id int64) error {
 }
 
 const getCompletedMatches = `-- name: GetCompletedMatches :many
-SELECT id, fixture_id, current_minute, current_half, home_score, away_score, active_zone, home_attacking_direction, is_completed, completed_at, created_at, updated_at FROM matches WHERE is_completed = 1 ORDER BY completed_at DESC
+SELECT id, fixture_id, current_minute, current_half, home_score, away_score, active_zone, home_attacking_direction, is_completed, completed_at, created_at, updated_at, home_penalties, away_penalties FROM matches WHERE is_completed = 1 ORDER BY completed_at DESC
 `
 
 func (q *Queries) GetCompletedMatches(ctx context.Context) ([]Match, error) {
@@ -126,6 +129,8 @@ func (q *Queries) GetCompletedMatches(ctx context.Context) ([]Match, error) {
 			&i.CompletedAt,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.HomePenalties,
+			&i.AwayPenalties,
 		); err != nil {
 			return nil, err
 		}
@@ -141,7 +146,7 @@ func (q *Queries) GetCompletedMatches(ctx context.Context) ([]Match, error) {
 }
 
 const getMatchByFixtureID = `-- name: GetMatchByFixtureID :one
-SELECT id, fixture_id, current_minute, current_half, home_score, away_score, active_zone, home_attacking_direction, is_completed, completed_at, created_at, updated_at FROM matches WHERE fixture_id = ? LIMIT 1
+SELECT id, fixture_id, current_minute, current_half, home_score, away_score, active_zone, home_attacking_direction, is_completed, completed_at, created_at, updated_at, home_penalties, away_penalties FROM matches WHERE fixture_id = ? LIMIT 1
 `
 
 func (q *Queries) GetMatchByFixtureID(ctx context.Context, fixtureID int64) (Match, error) {
@@ -160,12 +165,14 @@ func (q *Queries) GetMatchByFixtureID(ctx context.Context, fixtureID int64) (Mat
 		&i.CompletedAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.HomePenalties,
+		&i.AwayPenalties,
 	)
 	return i, err
 }
 
 const getMatchByID = `-- name: GetMatchByID :one
-SELECT id, fixture_id, current_minute, current_half, home_score, away_score, active_zone, home_attacking_direction, is_completed, completed_at, created_at, updated_at FROM matches WHERE id = ? LIMIT 1
+SELECT id, fixture_id, current_minute, current_half, home_score, away_score, active_zone, home_attacking_direction, is_completed, completed_at, created_at, updated_at, home_penalties, away_penalties FROM matches WHERE id = ? LIMIT 1
 `
 
 func (q *Queries) GetMatchByID(ctx context.Context, id int64) (Match, error) {
@@ -184,10 +191,31 @@ func (q *Queries) GetMatchByID(ctx context.Context, id int64) (Match, error) {
 		&i.CompletedAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.HomePenalties,
+		&i.AwayPenalties,
 	)
 	return i, err
 }
 
+const recordShootout = `-- name: RecordShootout :exec
+UPDATE matches
+SET home_penalties = ?,
+    away_penalties = ?,
+    updated_at = CURRENT_TIMESTAMP
+WHERE fixture_id = ?
+`
+
+type RecordShootoutParams struct {
+	HomePenalties sql.NullInt64 `json:"home_penalties"`
+	AwayPenalties sql.NullInt64 `json:"away_penalties"`
+	FixtureID     int64         `json:"fixture_id"`
+}
+
+func (q *Queries) RecordShootout(ctx context.Context, arg RecordShootoutParams) error {
+	_, err := q.db.ExecContext(ctx, recordShootout, arg.HomePenalties, arg.AwayPenalties, arg.FixtureID)
+	return err
+}
+
 const updateMatch = `-- name: UpdateMatch :exec
 UPDATE matches
 SET current_minute = ?,
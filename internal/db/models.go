@@ -9,20 +9,82 @@ import (
 )
 
 type Club struct {
-	ID              int64        `json:"id"`
-	Name            string       `json:"name"`
-	Strength        int64        `json:"strength"`
-	BackgroundColor string       `json:"background_color"`
-	ForegroundColor string       `json:"foreground_color"`
-	CreatedAt       sql.NullTime `json:"created_at"`
+	ID                int64         `json:"id"`
+	Name              string        `json:"name"`
+	Strength          int64         `json:"strength"`
+	BackgroundColor   string        `json:"background_color"`
+	ForegroundColor   string        `json:"foreground_color"`
+	CreatedAt         sql.NullTime  `json:"created_at"`
+	DivisionID        sql.NullInt64 `json:"division_id"`
+	Balance           int64         `json:"balance"`
+	TransferBudget    int64         `json:"transfer_budget"`
+	Academy           int64         `json:"academy"`
+	TrainingIntensity int64         `json:"training_intensity"`
+	TrainingFocus     int64         `json:"training_focus"`
+	Formation         string        `json:"formation"`
+	Familiarity       int64         `json:"familiarity"`
+	Cohesion          int64         `json:"cohesion"`
+	Objective         int64         `json:"objective"`
+	Confidence        int64         `json:"confidence"`
+	VacantSince       string        `json:"vacant_since"`
 }
 
-type Fixture struct {
+type Cup struct {
+	ID        int64        `json:"id"`
+	Name      string       `json:"name"`
+	CreatedAt sql.NullTime `json:"created_at"`
+}
+
+type CupEntry struct {
+	ID        int64        `json:"id"`
+	CupID     int64        `json:"cup_id"`
+	SeasonID  int64        `json:"season_id"`
+	ClubID    int64        `json:"club_id"`
+	GroupName string       `json:"group_name"`
+	CreatedAt sql.NullTime `json:"created_at"`
+}
+
+type CupRound struct {
 	ID         int64        `json:"id"`
+	CupID      int64        `json:"cup_id"`
+	SeasonID   int64        `json:"season_id"`
+	Number     int64        `json:"number"`
+	Name       string       `json:"name"`
 	Gameweek   int64        `json:"gameweek"`
-	HomeTeamID int64        `json:"home_team_id"`
-	AwayTeamID int64        `json:"away_team_id"`
 	CreatedAt  sql.NullTime `json:"created_at"`
+	Legs       int64        `json:"legs"`
+	GroupStage int64        `json:"group_stage"`
+}
+
+type Division struct {
+	ID        int64        `json:"id"`
+	Name      string       `json:"name"`
+	Tier      int64        `json:"tier"`
+	CreatedAt sql.NullTime `json:"created_at"`
+}
+
+type FinanceTransaction struct {
+	ID              int64        `json:"id"`
+	SeasonID        int64        `json:"season_id"`
+	ClubID          int64        `json:"club_id"`
+	Category        string       `json:"category"`
+	Amount          int64        `json:"amount"`
+	Description     string       `json:"description"`
+	TransactionDate string       `json:"transaction_date"`
+	CreatedAt       sql.NullTime `json:"created_at"`
+}
+
+type Fixture struct {
+	ID         int64         `json:"id"`
+	Gameweek   int64         `json:"gameweek"`
+	HomeTeamID int64         `json:"home_team_id"`
+	AwayTeamID int64         `json:"away_team_id"`
+	CreatedAt  sql.NullTime  `json:"created_at"`
+	SeasonID   sql.NullInt64 `json:"season_id"`
+	DivisionID sql.NullInt64 `json:"division_id"`
+	CupRoundID sql.NullInt64 `json:"cup_round_id"`
+	Leg        int64         `json:"leg"`
+	MatchDate  string        `json:"match_date"`
 }
 
 type GameState struct {
@@ -33,19 +95,50 @@ type GameState struct {
 	UpdatedAt      sql.NullTime `json:"updated_at"`
 }
 
+type Injury struct {
+	ID            int64         `json:"id"`
+	PlayerID      int64         `json:"player_id"`
+	FixtureID     sql.NullInt64 `json:"fixture_id"`
+	InjuryType    int64         `json:"injury_type"`
+	DaysOut       int64         `json:"days_out"`
+	DaysRemaining int64         `json:"days_remaining"`
+	CreatedAt     sql.NullTime  `json:"created_at"`
+}
+
+type LastStarter struct {
+	ClubID   int64 `json:"club_id"`
+	PlayerID int64 `json:"player_id"`
+}
+
+type Lineup struct {
+	ClubID          int64         `json:"club_id"`
+	Formation       int64         `json:"formation"`
+	CaptainID       sql.NullInt64 `json:"captain_id"`
+	SetPieceTakerID sql.NullInt64 `json:"set_piece_taker_id"`
+	UpdatedAt       sql.NullTime  `json:"updated_at"`
+}
+
+type LineupPlayer struct {
+	ClubID   int64 `json:"club_id"`
+	PlayerID int64 `json:"player_id"`
+	Slot     int64 `json:"slot"`
+}
+
 type Match struct {
-	ID                     int64        `json:"id"`
-	FixtureID              int64        `json:"fixture_id"`
-	CurrentMinute          int64        `json:"current_minute"`
-	CurrentHalf            int64        `json:"current_half"`
-	HomeScore              int64        `json:"home_score"`
-	AwayScore              int64        `json:"away_score"`
-	ActiveZone             int64        `json:"active_zone"`
-	HomeAttackingDirection int64        `json:"home_attacking_direction"`
-	IsCompleted            int64        `json:"is_completed"`
-	CompletedAt            sql.NullTime `json:"completed_at"`
-	CreatedAt              sql.NullTime `json:"created_at"`
-	UpdatedAt              sql.NullTime `json:"updated_at"`
+	ID                     int64         `json:"id"`
+	FixtureID              int64         `json:"fixture_id"`
+	CurrentMinute          int64         `json:"current_minute"`
+	CurrentHalf            int64         `json:"current_half"`
+	HomeScore              int64         `json:"home_score"`
+	AwayScore              int64         `json:"away_score"`
+	ActiveZone             int64         `json:"active_zone"`
+	HomeAttackingDirection int64         `json:"home_attacking_direction"`
+	IsCompleted            int64         `json:"is_completed"`
+	CompletedAt            sql.NullTime  `json:"completed_at"`
+	CreatedAt              sql.NullTime  `json:"created_at"`
+	UpdatedAt              sql.NullTime  `json:"updated_at"`
+	HomePenalties          sql.NullInt64 `json:"home_penalties"`
+	AwayPenalties          sql.NullInt64 `json:"away_penalties"`
 }
 
 type MatchEvent struct {
@@ -58,10 +151,113 @@ type MatchEvent struct {
 	CreatedAt  sql.NullTime   `json:"created_at"`
 }
 
+type Message struct {
+	ID          int64         `json:"id"`
+	MessageDate string        `json:"message_date"`
+	Kind        int64         `json:"kind"`
+	Subject     string        `json:"subject"`
+	Body        string        `json:"body"`
+	IsRead      int64         `json:"is_read"`
+	Status      int64         `json:"status"`
+	PlayerID    sql.NullInt64 `json:"player_id"`
+	ClubID      sql.NullInt64 `json:"club_id"`
+	Fee         int64         `json:"fee"`
+	CreatedAt   sql.NullTime  `json:"created_at"`
+}
+
 type Player struct {
+	ID               int64         `json:"id"`
+	ClubID           int64         `json:"club_id"`
+	Name             string        `json:"name"`
+	Quality          int64         `json:"quality"`
+	CreatedAt        sql.NullTime  `json:"created_at"`
+	Position         string        `json:"position"`
+	Age              int64         `json:"age"`
+	Fatigue          int64         `json:"fatigue"`
+	LoanedFromClubID sql.NullInt64 `json:"loaned_from_club_id"`
+	Wage             int64         `json:"wage"`
+	ContractEnds     int64         `json:"contract_ends"`
+	ReleaseClause    int64         `json:"release_clause"`
+	SquadRole        int64         `json:"squad_role"`
+	DateOfBirth      string        `json:"date_of_birth"`
+	Potential        int64         `json:"potential"`
+	Progress         int64         `json:"progress"`
+	Nationality      string        `json:"nationality"`
+	Sharpness        int64         `json:"sharpness"`
+	TrainingFocus    int64         `json:"training_focus"`
+	Morale           int64         `json:"morale"`
+}
+
+type PlayerAppearance struct {
+	ID          int64        `json:"id"`
+	PlayerID    int64        `json:"player_id"`
+	FixtureID   int64        `json:"fixture_id"`
+	Started     int64        `json:"started"`
+	Goals       int64        `json:"goals"`
+	YellowCards int64        `json:"yellow_cards"`
+	RedCards    int64        `json:"red_cards"`
+	Rating      float64      `json:"rating"`
+	CreatedAt   sql.NullTime `json:"created_at"`
+}
+
+type PlayerCard struct {
 	ID        int64        `json:"id"`
+	PlayerID  int64        `json:"player_id"`
+	FixtureID int64        `json:"fixture_id"`
+	EventType int64        `json:"event_type"`
+	Minute    int64        `json:"minute"`
+	CreatedAt sql.NullTime `json:"created_at"`
+}
+
+type PointsDeduction struct {
+	ID        int64        `json:"id"`
+	SeasonID  int64        `json:"season_id"`
 	ClubID    int64        `json:"club_id"`
-	Name      string       `json:"name"`
-	Quality   int64        `json:"quality"`
+	Points    int64        `json:"points"`
+	Reason    string       `json:"reason"`
 	CreatedAt sql.NullTime `json:"created_at"`
 }
+
+type Season struct {
+	ID          int64        `json:"id"`
+	StartYear   int64        `json:"start_year"`
+	IsCurrent   int64        `json:"is_current"`
+	CompletedAt sql.NullTime `json:"completed_at"`
+	CreatedAt   sql.NullTime `json:"created_at"`
+	Today       string       `json:"today"`
+}
+
+type SeasonStanding struct {
+	SeasonID     int64         `json:"season_id"`
+	ClubID       int64         `json:"club_id"`
+	Position     int64         `json:"position"`
+	Played       int64         `json:"played"`
+	Won          int64         `json:"won"`
+	Drawn        int64         `json:"drawn"`
+	Lost         int64         `json:"lost"`
+	GoalsFor     int64         `json:"goals_for"`
+	GoalsAgainst int64         `json:"goals_against"`
+	Points       int64         `json:"points"`
+	DivisionID   sql.NullInt64 `json:"division_id"`
+}
+
+type Suspension struct {
+	ID               int64        `json:"id"`
+	PlayerID         int64        `json:"player_id"`
+	Reason           string       `json:"reason"`
+	MatchesBanned    int64        `json:"matches_banned"`
+	MatchesRemaining int64        `json:"matches_remaining"`
+	CreatedAt        sql.NullTime `json:"created_at"`
+}
+
+type Transfer struct {
+	ID           int64        `json:"id"`
+	SeasonID     int64        `json:"season_id"`
+	PlayerID     int64        `json:"player_id"`
+	FromClubID   int64        `json:"from_club_id"`
+	ToClubID     int64        `json:"to_club_id"`
+	Fee          int64        `json:"fee"`
+	TransferDate string       `json:"transfer_date"`
+	CreatedAt    sql.NullTime `json:"created_at"`
+	IsLoan       int64        `json:"is_loan"`
+}
@@ -9,20 +9,60 @@ import (
 	"context"
 )
 
+const backfillPlayerAge = `-- name: BackfillPlayerAge :exec
+UPDATE players SET age = ?
+WHERE club_id = ? AND name = ? AND age = 0
+`
+
+type BackfillPlayerAgeParams struct {
+	Age    int64  `json:"age"`
+	ClubID int64  `json:"club_id"`
+	Name   string `json:"name"`
+}
+
+func (q *Queries) BackfillPlayerAge(ctx context.Context, arg BackfillPlayerAgeParams) error {
+	_, err := q.db.ExecContext(ctx, backfillPlayerAge, arg.Age, arg.ClubID, arg.Name)
+	return err
+}
+
+const backfillPlayerPosition = `-- name: BackfillPlayerPosition :exec
+UPDATE players SET position = ?
+WHERE club_id = ? AND name = ? AND position = ''
+`
+
+type BackfillPlayerPositionParams struct {
+	Position string `json:"position"`
+	ClubID   int64  `json:"club_id"`
+	Name     string `json:"name"`
+}
+
+func (q *Queries) BackfillPlayerPosition(ctx context.Context, arg BackfillPlayerPositionParams) error {
+	_, err := q.db.ExecContext(ctx, backfillPlayerPosition, arg.Position, arg.ClubID, arg.Name)
+	return err
+}
+
 const createPlayer = `-- name: CreatePlayer :one
-INSERT INTO players (club_id, name, quality)
-VALUES (?, ?, ?)
-RETURNING id, club_id, name, quality, created_at
+INSERT INTO players (club_id, name, quality, position, age)
+VALUES (?, ?, ?, ?, ?)
+RETURNING id, club_id, name, quality, created_at, position, age, fatigue, loaned_from_club_id, wage, contract_ends, release_clause, squad_role, date_of_birth, potential, progress, nationality, sharpness, training_focus, morale
 `
 
 type CreatePlayerParams struct {
-	ClubID  int64  `json:"club_id"`
-	Name    string `json:"name"`
-	Quality int64  `json:"quality"`
+	ClubID   int64  `json:"club_id"`
+	Name     string `json:"name"`
+	Quality  int64  `json:"quality"`
+	Position string `json:"position"`
+	Age      int64  `json:"age"`
 }
 
 func (q *Queries) CreatePlayer(ctx context.Context, arg CreatePlayerParams) (Player, error) {
-	row := q.db.QueryRowContext(ctx, createPlayer, arg.ClubID, arg.Name, arg.Quality)
+	row := q.db.QueryRowContext(ctx, createPlayer,
+		arg.ClubID,
+		arg.Name,
+		arg.Quality,
+		arg.Position,
+		arg.Age,
+	)
 	var i Player
 	err := row.Scan(
 		&i.ID,
@@ -30,6 +70,21 @@ func (q *Queries) CreatePlayer(ctx context.Context, arg CreatePlayerParams) (Pla
 		&i.Name,
 		&i.Quality,
 		&i.CreatedAt,
+		&i.Position,
+		&i.Age,
+		&i.Fatigue,
+		&i.LoanedFromClubID,
+		&i.Wage,
+		&i.ContractEnds,
+		&i.ReleaseClause,
+		&i.SquadRole,
+		&i.DateOfBirth,
+		&i.Potential,
+		&i.Progress,
+		&i.Nationality,
+		&i.Sharpness,
+		&i.TrainingFocus,
+		&i.Morale,
 	)
 	return i, err
 }
@@ -44,7 +99,7 @@ func (q *Queries) DeletePlayer(ctx context.Context, id int64) error {
 }
 
 const getPlayerByID = `-- name: GetPlayerByID :one
-SELECT id, club_id, name, quality, created_at FROM players WHERE id = ? LIMIT 1
+SELECT id, club_id, name, quality, created_at, position, age, fatigue, loaned_from_club_id, wage, contract_ends, release_clause, squad_role, date_of_birth, potential, progress, nationality, sharpness, training_focus, morale FROM players WHERE id = ? LIMIT 1
 `
 
 func (q *Queries) GetPlayerByID(ctx context.Context, id int64) (Player, error) {
@@ -56,12 +111,27 @@ func (q *Queries) GetPlayerByID(ctx context.Context, id int64) (Player, error) {
 		&i.Name,
 		&i.Quality,
 		&i.CreatedAt,
+		&i.Position,
+		&i.Age,
+		&i.Fatigue,
+		&i.LoanedFromClubID,
+		&i.Wage,
+		&i.ContractEnds,
+		&i.ReleaseClause,
+		&i.SquadRole,
+		&i.DateOfBirth,
+		&i.Potential,
+		&i.Progress,
+		&i.Nationality,
+		&i.Sharpness,
+		&i.TrainingFocus,
+		&i.Morale,
 	)
 	return i, err
 }
 
 const getPlayersByClubID = `-- name: GetPlayersByClubID :many
-SELECT id, club_id, name, quality, created_at FROM players WHERE club_id = ? ORDER BY id
+SELECT id, club_id, name, quality, created_at, position, age, fatigue, loaned_from_club_id, wage, contract_ends, release_clause, squad_role, date_of_birth, potential, progress, nationality, sharpness, training_focus, morale FROM players WHERE club_id = ? ORDER BY id
 `
 
 func (q *Queries) GetPlayersByClubID(ctx context.Context, clubID int64) ([]Player, error) {
@@ -79,6 +149,21 @@ func (q *Queries) GetPlayersByClubID(ctx context.Context, clubID int64) ([]Playe
 			&i.Name,
 			&i.Quality,
 			&i.CreatedAt,
+			&i.Position,
+			&i.Age,
+			&i.Fatigue,
+			&i.LoanedFromClubID,
+			&i.Wage,
+			&i.ContractEnds,
+			&i.ReleaseClause,
+			&i.SquadRole,
+			&i.DateOfBirth,
+			&i.Potential,
+			&i.Progress,
+			&i.Nationality,
+			&i.Sharpness,
+			&i.TrainingFocus,
+			&i.Morale,
 		); err != nil {
 			return nil, err
 		}
@@ -92,3 +177,191 @@ func (q *Queries) GetPlayersByClubID(ctx context.Context, clubID int64) ([]Playe
 	}
 	return items, nil
 }
+
+const loanPlayer = `-- name: LoanPlayer :exec
+UPDATE players SET loaned_from_club_id = club_id, club_id = ? WHERE id = ?
+`
+
+type LoanPlayerParams struct {
+	ClubID int64 `json:"club_id"`
+	ID     int64 `json:"id"`
+}
+
+// Sends a player out on loan, remembering the club they'll return to
+func (q *Queries) LoanPlayer(ctx context.Context, arg LoanPlayerParams) error {
+	_, err := q.db.ExecContext(ctx, loanPlayer, arg.ClubID, arg.ID)
+	return err
+}
+
+const recordPlayerFatigue = `-- name: RecordPlayerFatigue :exec
+UPDATE players SET fatigue = ? WHERE id = ?
+`
+
+type RecordPlayerFatigueParams struct {
+	Fatigue int64 `json:"fatigue"`
+	ID      int64 `json:"id"`
+}
+
+// How tired a player finished a match
+func (q *Queries) RecordPlayerFatigue(ctx context.Context, arg RecordPlayerFatigueParams) error {
+	_, err := q.db.ExecContext(ctx, recordPlayerFatigue, arg.Fatigue, arg.ID)
+	return err
+}
+
+const recoverPlayerFatigue = `-- name: RecoverPlayerFatigue :exec
+UPDATE players SET fatigue = MAX(fatigue - ?, 0) WHERE fatigue > 0
+`
+
+// Every player shakes off some tiredness with rest
+func (q *Queries) RecoverPlayerFatigue(ctx context.Context, amount int64) error {
+	_, err := q.db.ExecContext(ctx, recoverPlayerFatigue, amount)
+	return err
+}
+
+const returnLoanedPlayers = `-- name: ReturnLoanedPlayers :exec
+UPDATE players SET club_id = loaned_from_club_id, loaned_from_club_id = NULL
+WHERE loaned_from_club_id IS NOT NULL
+`
+
+// Every player out on loan goes back to their own club
+func (q *Queries) ReturnLoanedPlayers(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, returnLoanedPlayers)
+	return err
+}
+
+const setPlayerBirthAndPotential = `-- name: SetPlayerBirthAndPotential :exec
+UPDATE players SET date_of_birth = ?, potential = ? WHERE id = ?
+`
+
+type SetPlayerBirthAndPotentialParams struct {
+	DateOfBirth string `json:"date_of_birth"`
+	Potential   int64  `json:"potential"`
+	ID          int64  `json:"id"`
+}
+
+func (q *Queries) SetPlayerBirthAndPotential(ctx context.Context, arg SetPlayerBirthAndPotentialParams) error {
+	_, err := q.db.ExecContext(ctx, setPlayerBirthAndPotential, arg.DateOfBirth, arg.Potential, arg.ID)
+	return err
+}
+
+const setPlayerContract = `-- name: SetPlayerContract :exec
+UPDATE players SET wage = ?, contract_ends = ?, release_clause = ?, squad_role = ?
+WHERE id = ?
+`
+
+type SetPlayerContractParams struct {
+	Wage          int64 `json:"wage"`
+	ContractEnds  int64 `json:"contract_ends"`
+	ReleaseClause int64 `json:"release_clause"`
+	SquadRole     int64 `json:"squad_role"`
+	ID            int64 `json:"id"`
+}
+
+// Registers a player on new terms with their club
+func (q *Queries) SetPlayerContract(ctx context.Context, arg SetPlayerContractParams) error {
+	_, err := q.db.ExecContext(ctx, setPlayerContract,
+		arg.Wage,
+		arg.ContractEnds,
+		arg.ReleaseClause,
+		arg.SquadRole,
+		arg.ID,
+	)
+	return err
+}
+
+const setPlayerDevelopment = `-- name: SetPlayerDevelopment :exec
+UPDATE players SET quality = ?, progress = ? WHERE id = ?
+`
+
+type SetPlayerDevelopmentParams struct {
+	Quality  int64 `json:"quality"`
+	Progress int64 `json:"progress"`
+	ID       int64 `json:"id"`
+}
+
+// A player's quality and progress after a month's development
+func (q *Queries) SetPlayerDevelopment(ctx context.Context, arg SetPlayerDevelopmentParams) error {
+	_, err := q.db.ExecContext(ctx, setPlayerDevelopment, arg.Quality, arg.Progress, arg.ID)
+	return err
+}
+
+const setPlayerMorale = `-- name: SetPlayerMorale :exec
+UPDATE players SET morale = ? WHERE id = ?
+`
+
+type SetPlayerMoraleParams struct {
+	Morale int64 `json:"morale"`
+	ID     int64 `json:"id"`
+}
+
+func (q *Queries) SetPlayerMorale(ctx context.Context, arg SetPlayerMoraleParams) error {
+	_, err := q.db.ExecContext(ctx, setPlayerMorale, arg.Morale, arg.ID)
+	return err
+}
+
+const setPlayerNationality = `-- name: SetPlayerNationality :exec
+UPDATE players SET nationality = ? WHERE id = ?
+`
+
+type SetPlayerNationalityParams struct {
+	Nationality string `json:"nationality"`
+	ID          int64  `json:"id"`
+}
+
+func (q *Queries) SetPlayerNationality(ctx context.Context, arg SetPlayerNationalityParams) error {
+	_, err := q.db.ExecContext(ctx, setPlayerNationality, arg.Nationality, arg.ID)
+	return err
+}
+
+const setPlayerTraining = `-- name: SetPlayerTraining :exec
+UPDATE players SET quality = ?, progress = ?, fatigue = ?, sharpness = ? WHERE id = ?
+`
+
+type SetPlayerTrainingParams struct {
+	Quality   int64 `json:"quality"`
+	Progress  int64 `json:"progress"`
+	Fatigue   int64 `json:"fatigue"`
+	Sharpness int64 `json:"sharpness"`
+	ID        int64 `json:"id"`
+}
+
+// A player's development and condition after a week's training
+func (q *Queries) SetPlayerTraining(ctx context.Context, arg SetPlayerTrainingParams) error {
+	_, err := q.db.ExecContext(ctx, setPlayerTraining,
+		arg.Quality,
+		arg.Progress,
+		arg.Fatigue,
+		arg.Sharpness,
+		arg.ID,
+	)
+	return err
+}
+
+const setPlayerTrainingFocus = `-- name: SetPlayerTrainingFocus :exec
+UPDATE players SET training_focus = ? WHERE id = ?
+`
+
+type SetPlayerTrainingFocusParams struct {
+	TrainingFocus int64 `json:"training_focus"`
+	ID            int64 `json:"id"`
+}
+
+func (q *Queries) SetPlayerTrainingFocus(ctx context.Context, arg SetPlayerTrainingFocusParams) error {
+	_, err := q.db.ExecContext(ctx, setPlayerTrainingFocus, arg.TrainingFocus, arg.ID)
+	return err
+}
+
+const updatePlayerClub = `-- name: UpdatePlayerClub :exec
+UPDATE players SET club_id = ? WHERE id = ?
+`
+
+type UpdatePlayerClubParams struct {
+	ClubID int64 `json:"club_id"`
+	ID     int64 `json:"id"`
+}
+
+// Moves a player to their new club
+func (q *Queries) UpdatePlayerClub(ctx context.Context, arg UpdatePlayerClubParams) error {
+	_, err := q.db.ExecContext(ctx, updatePlayerClub, arg.ClubID, arg.ID)
+	return err
+}
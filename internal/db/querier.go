@@ -6,41 +6,144 @@ package db
 
 import (
 	"context"
+	"database/sql"
 )
 
 type Querier interface {
+	// Pays money in or out of a club's account and moves its transfer budget
+	AdjustClubFinances(ctx context.Context, arg AdjustClubFinancesParams) error
+	BackfillPlayerAge(ctx context.Context, arg BackfillPlayerAgeParams) error
+	BackfillPlayerPosition(ctx context.Context, arg BackfillPlayerPositionParams) error
 	CompleteMatch(ctx context.Context, arg CompleteMatchParams) error
+	CompleteSeason(ctx context.Context, id int64) error
+	// Card totals reset every season
+	CountCardsByPlayerID(ctx context.Context, arg CountCardsByPlayerIDParams) (int64, error)
+	CountFinanceTransactionsByClubID(ctx context.Context, clubID int64) (int64, error)
+	CountMessagesByStatus(ctx context.Context, status int64) (int64, error)
+	CountPlayedFixtures(ctx context.Context, divisionID sql.NullInt64) (int64, error)
 	CreateClub(ctx context.Context, arg CreateClubParams) (Club, error)
+	CreateCupRound(ctx context.Context, arg CreateCupRoundParams) (CupRound, error)
+	CreateDivision(ctx context.Context, arg CreateDivisionParams) (Division, error)
+	CreateFinanceTransaction(ctx context.Context, arg CreateFinanceTransactionParams) error
 	CreateFixture(ctx context.Context, arg CreateFixtureParams) (Fixture, error)
 	CreateGameState(ctx context.Context, arg CreateGameStateParams) (GameState, error)
+	CreateInjury(ctx context.Context, arg CreateInjuryParams) (Injury, error)
+	CreateLastStarter(ctx context.Context, arg CreateLastStarterParams) error
+	CreateLineupPlayer(ctx context.Context, arg CreateLineupPlayerParams) error
 	CreateMatch(ctx context.Context, arg CreateMatchParams) (Match, error)
 	CreateMatchEvent(ctx context.Context, arg CreateMatchEventParams) (MatchEvent, error)
+	CreateMessage(ctx context.Context, arg CreateMessageParams) error
 	CreatePlayer(ctx context.Context, arg CreatePlayerParams) (Player, error)
+	CreatePlayerAppearance(ctx context.Context, arg CreatePlayerAppearanceParams) error
+	CreatePlayerCard(ctx context.Context, arg CreatePlayerCardParams) (PlayerCard, error)
+	// Docks a club points in the current season
+	CreatePointsDeduction(ctx context.Context, arg CreatePointsDeductionParams) error
+	CreateSeason(ctx context.Context, arg CreateSeasonParams) (Season, error)
+	CreateSeasonStanding(ctx context.Context, arg CreateSeasonStandingParams) error
+	CreateSuspension(ctx context.Context, arg CreateSuspensionParams) (Suspension, error)
+	CreateTransfer(ctx context.Context, arg CreateTransferParams) error
 	DeleteAllGameStates(ctx context.Context) error
 	DeleteClub(ctx context.Context, id int64) error
+	DeleteCurrentSeasonFixtures(ctx context.Context, divisionID sql.NullInt64) error
 	DeleteFixture(ctx context.Context, id int64) error
 	DeleteGameState(ctx context.Context, id int64) error
 	DeleteIncompleteMatchByFixtureID(ctx context.Context, fixtureID int64) error
+	DeleteLastStartersByClubID(ctx context.Context, clubID int64) error
+	DeleteLineupPlayersByClubID(ctx context.Context, clubID int64) error
 	DeleteMatch(ctx context.Context, id int64) error
 	DeleteMatchEvents(ctx context.Context, matchID int64) error
 	DeletePlayer(ctx context.Context, id int64) error
+	GetActiveInjuriesByClubID(ctx context.Context, clubID int64) ([]GetActiveInjuriesByClubIDRow, error)
+	GetActiveSuspensionsByClubID(ctx context.Context, clubID int64) ([]GetActiveSuspensionsByClubIDRow, error)
 	GetAllClubs(ctx context.Context) ([]Club, error)
+	GetAllDivisions(ctx context.Context) ([]Division, error)
 	GetAllFixtures(ctx context.Context) ([]Fixture, error)
 	GetAllGameState(ctx context.Context) ([]GameState, error)
+	// Most recent first, so the head of each player's list is their current form
+	GetAppearancesByClubID(ctx context.Context, clubID int64) ([]GetAppearancesByClubIDRow, error)
 	GetClubByID(ctx context.Context, id int64) (Club, error)
 	GetClubByName(ctx context.Context, name string) (Club, error)
 	GetCompletedMatches(ctx context.Context) ([]Match, error)
+	GetCupByName(ctx context.Context, name string) (Cup, error)
+	GetCupRoundByID(ctx context.Context, id int64) (GetCupRoundByIDRow, error)
+	// Each tie with its result, once played
+	GetCupTiesByRoundID(ctx context.Context, cupRoundID sql.NullInt64) ([]GetCupTiesByRoundIDRow, error)
+	GetCurrentCupEntries(ctx context.Context, cupID int64) ([]CupEntry, error)
+	GetCurrentCupRounds(ctx context.Context, cupID int64) ([]CupRound, error)
+	GetCurrentSeason(ctx context.Context) (Season, error)
+	// The points each club has been docked this season
+	GetCurrentSeasonDeductions(ctx context.Context) ([]GetCurrentSeasonDeductionsRow, error)
+	// Every move this season, latest first
+	GetCurrentSeasonTransfers(ctx context.Context) ([]GetCurrentSeasonTransfersRow, error)
+	GetDivisionByName(ctx context.Context, name string) (Division, error)
 	GetEventsByMatchID(ctx context.Context, matchID int64) ([]MatchEvent, error)
+	// The first leg of a two-legged cup tie, played with home and away reversed
+	GetFirstLegResult(ctx context.Context, arg GetFirstLegResultParams) (GetFirstLegResultRow, error)
 	GetFixtureByID(ctx context.Context, id int64) (Fixture, error)
 	GetFixturesByClubID(ctx context.Context, arg GetFixturesByClubIDParams) ([]Fixture, error)
+	GetLastStartersByClubID(ctx context.Context, clubID int64) ([]int64, error)
+	GetLineupByClubID(ctx context.Context, clubID int64) (Lineup, error)
+	GetLineupPlayersByClubID(ctx context.Context, clubID int64) ([]LineupPlayer, error)
 	GetMatchByFixtureID(ctx context.Context, fixtureID int64) (Match, error)
 	GetMatchByID(ctx context.Context, id int64) (Match, error)
+	// Every message in the manager's inbox, latest first
+	GetMessages(ctx context.Context) ([]Message, error)
+	// Roughly how long each player has played in matches from the first date up
+	// to the second: 90 minutes for a start and 30 off the bench
+	GetMinutesBetween(ctx context.Context, arg GetMinutesBetweenParams) ([]GetMinutesBetweenRow, error)
 	GetMostRecentGameState(ctx context.Context) (GameState, error)
 	GetPlayerByID(ctx context.Context, id int64) (Player, error)
 	GetPlayersByClubID(ctx context.Context, clubID int64) ([]Player, error)
+	GetRecentFinanceTransactions(ctx context.Context, arg GetRecentFinanceTransactionsParams) ([]FinanceTransaction, error)
+	// What a club has taken in or paid out under each heading this season
+	GetSeasonFinanceTotals(ctx context.Context, clubID int64) ([]GetSeasonFinanceTotalsRow, error)
+	GetSeasonHistoryByClubID(ctx context.Context, clubID int64) ([]GetSeasonHistoryByClubIDRow, error)
 	GetUnplayedByClubID(ctx context.Context, homeTeamID int64) ([]Fixture, error)
+	GetUnplayedFixtures(ctx context.Context) ([]Fixture, error)
+	// Every unplayed fixture in the current season dated on or before the given day
+	GetUnplayedFixturesThrough(ctx context.Context, matchDate string) ([]Fixture, error)
+	// Sends a player out on loan, remembering the club they'll return to
+	LoanPlayer(ctx context.Context, arg LoanPlayerParams) error
+	MarkMessageRead(ctx context.Context, id int64) error
+	// How tired a player finished a match
+	RecordPlayerFatigue(ctx context.Context, arg RecordPlayerFatigueParams) error
+	RecordShootout(ctx context.Context, arg RecordShootoutParams) error
+	RecoverInjuries(ctx context.Context, days int64) error
+	// Every player shakes off some tiredness with rest
+	RecoverPlayerFatigue(ctx context.Context, amount int64) error
+	// Every player out on loan goes back to their own club
+	ReturnLoanedPlayers(ctx context.Context) error
+	// Bans run consecutively, so only the oldest active ban per player is served
+	ServeSuspensionsByClubID(ctx context.Context, clubID int64) error
+	SetClubAcademy(ctx context.Context, arg SetClubAcademyParams) error
+	// What the board expects of the manager, how much faith it has in them and
+	// when the job last fell vacant
+	SetClubBoard(ctx context.Context, arg SetClubBoardParams) error
+	SetClubCohesion(ctx context.Context, arg SetClubCohesionParams) error
+	// The formation a club last played and how many matches running it has used it
+	SetClubFamiliarity(ctx context.Context, arg SetClubFamiliarityParams) error
+	SetClubTraining(ctx context.Context, arg SetClubTrainingParams) error
+	SetClubTransferBudget(ctx context.Context, arg SetClubTransferBudgetParams) error
+	SetMessageStatus(ctx context.Context, arg SetMessageStatusParams) error
+	SetPlayerBirthAndPotential(ctx context.Context, arg SetPlayerBirthAndPotentialParams) error
+	// Registers a player on new terms with their club
+	SetPlayerContract(ctx context.Context, arg SetPlayerContractParams) error
+	// A player's quality and progress after a month's development
+	SetPlayerDevelopment(ctx context.Context, arg SetPlayerDevelopmentParams) error
+	SetPlayerMorale(ctx context.Context, arg SetPlayerMoraleParams) error
+	SetPlayerNationality(ctx context.Context, arg SetPlayerNationalityParams) error
+	// A player's development and condition after a week's training
+	SetPlayerTraining(ctx context.Context, arg SetPlayerTrainingParams) error
+	SetPlayerTrainingFocus(ctx context.Context, arg SetPlayerTrainingFocusParams) error
+	SetSeasonToday(ctx context.Context, arg SetSeasonTodayParams) error
+	UpdateClubDivision(ctx context.Context, arg UpdateClubDivisionParams) error
 	UpdateGameState(ctx context.Context, arg UpdateGameStateParams) (GameState, error)
 	UpdateMatch(ctx context.Context, arg UpdateMatchParams) error
+	// Moves a player to their new club
+	UpdatePlayerClub(ctx context.Context, arg UpdatePlayerClubParams) error
+	// Enters a club into this season's cup, or moves it into its drawn group
+	UpsertCupEntry(ctx context.Context, arg UpsertCupEntryParams) error
+	UpsertLineup(ctx context.Context, arg UpsertLineupParams) error
 }
 
 var _ Querier = (*Queries)(nil)
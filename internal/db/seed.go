@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 )
@@ -17,6 +18,7 @@ type ClubSeed struct {
 	Players    []PlayerSeed `json:"Players"`
 }
 
+// FixtureSeed represents the JSON structure for seeding fixtures
 type FixtureSeed struct {
 	Gameweek     int64  `json:"Gameweek"`
 	HomeClubName string `json:"Home"`
@@ -25,58 +27,118 @@ type FixtureSeed struct {
 
 // PlayerSeed represents the JSON structure for seeding players
 type PlayerSeed struct {
-	Name    string `json:"Name"`
-	Quality int64  `json:"Quality"`
+	Name     string `json:"Name"`
+	Quality  int64  `json:"Quality"`
+	Age      int64  `json:"Age"`
+	Position string `json:"Position"`
 }
 
-// SeedDatabase loads clubs and players from clubs.json and fixtures.json into the database
-func SeedDatabase(db *sql.DB, clubsJSONPath string, fixturesJSONPath string) error {
-	// Read the Clubs JSON file
-	data, err := os.ReadFile(clubsJSONPath)
+// DivisionSeed names a division of the pyramid and the JSON files its clubs
+// and, optionally, its opening season's fixtures are seeded from
+type DivisionSeed struct {
+	Name             string
+	Tier             int64
+	ClubsJSONPath    string
+	FixturesJSONPath string
+}
+
+// SeedDatabase loads each division's clubs and players into the database.
+// Clubs that already exist keep the division they have moved to, so only
+// clubs missing from an older save are created.
+func SeedDatabase(db *sql.DB, divisions []DivisionSeed) error {
+	// Create queries instance
+	queries := New(db)
+	ctx := context.Background()
+
+	for _, divisionSeed := range divisions {
+		if err := seedDivision(ctx, queries, divisionSeed); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SeedForeignClubs loads clubs from outside the pyramid, who are only met in
+// continental competition. They belong to no division.
+func SeedForeignClubs(db *sql.DB, clubsJSONPath string) error {
+	ctx := context.Background()
+
+	clubs, err := readClubSeeds(clubsJSONPath)
+	if err != nil {
+		return err
+	}
+
+	return seedClubs(ctx, New(db), clubs, sql.NullInt64{})
+}
+
+// readClubSeeds reads and parses a clubs JSON file
+func readClubSeeds(path string) ([]ClubSeed, error) {
+	data, err := os.ReadFile(path)
 	if err != nil {
-		return fmt.Errorf("failed to read clubs.json: %w", err)
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
 	}
 
-	// Parse JSON
 	var clubs []ClubSeed
 	if err := json.Unmarshal(data, &clubs); err != nil {
-		return fmt.Errorf("failed to parse clubs.json: %w", err)
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
 	}
+	return clubs, nil
+}
 
-	// Read the Fixtures JSON file
-	fixturesData, err := os.ReadFile(fixturesJSONPath)
+// ReadFixtureSeeds reads and parses a fixtures JSON file
+func ReadFixtureSeeds(path string) ([]FixtureSeed, error) {
+	data, err := os.ReadFile(path)
 	if err != nil {
-		return fmt.Errorf("failed to read fixtures.json: %w", err)
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
 	}
 
-	// Parse JSON
 	var fixtures []FixtureSeed
-	if err := json.Unmarshal(fixturesData, &fixtures); err != nil {
-		return fmt.Errorf("failed to parse fixtures.json: %w", err)
+	if err := json.Unmarshal(data, &fixtures); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
 	}
+	return fixtures, nil
+}
 
-	// Create queries instance
-	queries := New(db)
-	ctx := context.Background()
-
-	// Check if database is already seeded
-	existingClubs, err := queries.GetAllClubs(ctx)
+// seedDivision creates a division if needed, then any of its clubs not yet in the database
+func seedDivision(ctx context.Context, queries *Queries, divisionSeed DivisionSeed) error {
+	clubs, err := readClubSeeds(divisionSeed.ClubsJSONPath)
 	if err != nil {
-		return fmt.Errorf("failed to check existing clubs: %w", err)
+		return err
 	}
-	if len(existingClubs) > 0 {
-		// Database already seeded
-		return nil
+
+	division, err := queries.GetDivisionByName(ctx, divisionSeed.Name)
+	if errors.Is(err, sql.ErrNoRows) {
+		division, err = queries.CreateDivision(ctx, CreateDivisionParams{
+			Name: divisionSeed.Name,
+			Tier: divisionSeed.Tier,
+		})
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get division %s: %w", divisionSeed.Name, err)
 	}
 
-	// Seed clubs and players
+	return seedClubs(ctx, queries, clubs, sql.NullInt64{Int64: division.ID, Valid: true})
+}
+
+// seedClubs creates any of the clubs not yet in the database, with their players
+func seedClubs(ctx context.Context, queries *Queries, clubs []ClubSeed, divisionID sql.NullInt64) error {
 	for _, clubSeed := range clubs {
+		_, err := queries.GetClubByName(ctx, clubSeed.Name)
+		if err == nil {
+			continue // Already seeded
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("failed to check club %s: %w", clubSeed.Name, err)
+		}
+
 		// Create club
 		club, err := queries.CreateClub(ctx, CreateClubParams{
 			Name:            clubSeed.Name,
 			Strength:        clubSeed.Strength,
 			BackgroundColor: clubSeed.Background,
 			ForegroundColor: clubSeed.Foreground,
+			DivisionID:      divisionID,
 		})
 		if err != nil {
 			return fmt.Errorf("failed to create club %s: %w", clubSeed.Name, err)
@@ -85,9 +147,11 @@ func SeedDatabase(db *sql.DB, clubsJSONPath string, fixturesJSONPath string) err
 		// Create players for this club
 		for _, playerSeed := range clubSeed.Players {
 			_, err := queries.CreatePlayer(ctx, CreatePlayerParams{
-				ClubID:  club.ID,
-				Name:    playerSeed.Name,
-				Quality: playerSeed.Quality,
+				ClubID:   club.ID,
+				Name:     playerSeed.Name,
+				Quality:  playerSeed.Quality,
+				Position: playerSeed.Position,
+				Age:      playerSeed.Age,
 			})
 			if err != nil {
 				return fmt.Errorf("failed to create player %s for club %s: %w", playerSeed.Name, clubSeed.Name, err)
@@ -95,24 +159,40 @@ func SeedDatabase(db *sql.DB, clubsJSONPath string, fixturesJSONPath string) err
 		}
 	}
 
-	for _, fixtureSeed := range fixtures {
-		home, err := queries.GetClubByName(ctx, fixtureSeed.HomeClubName)
-		if err != nil {
-			return fmt.Errorf("failed to get home club %s: %w", fixtureSeed.HomeClubName, err)
-		}
-		away, err := queries.GetClubByName(ctx, fixtureSeed.AwayClubName)
-		if err != nil {
-			return fmt.Errorf("failed to get away club %s: %w", fixtureSeed.AwayClubName, err)
+	// Clubs seeded earlier may predate player positions and ages
+	return backfillPlayerDetails(ctx, queries, clubs)
+}
+
+// backfillPlayerDetails sets positions and ages for players seeded before they were tracked
+func backfillPlayerDetails(ctx context.Context, queries *Queries, clubs []ClubSeed) error {
+	for _, clubSeed := range clubs {
+		club, err := queries.GetClubByName(ctx, clubSeed.Name)
+		if errors.Is(err, sql.ErrNoRows) {
+			continue // Club no longer exists
 		}
-		_, err = queries.CreateFixture(ctx, CreateFixtureParams{
-			Gameweek:   fixtureSeed.Gameweek,
-			HomeTeamID: home.ID,
-			AwayTeamID: away.ID,
-		})
 		if err != nil {
-			return fmt.Errorf("failed to create fixture %s vs %s: %w", home.Name, away.Name, err)
+			return fmt.Errorf("failed to get club %s: %w", clubSeed.Name, err)
 		}
 
+		for _, playerSeed := range clubSeed.Players {
+			err := queries.BackfillPlayerPosition(ctx, BackfillPlayerPositionParams{
+				Position: playerSeed.Position,
+				ClubID:   club.ID,
+				Name:     playerSeed.Name,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to backfill position for %s: %w", playerSeed.Name, err)
+			}
+
+			err = queries.BackfillPlayerAge(ctx, BackfillPlayerAgeParams{
+				Age:    playerSeed.Age,
+				ClubID: club.ID,
+				Name:   playerSeed.Name,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to backfill age for %s: %w", playerSeed.Name, err)
+			}
+		}
 	}
 
 	return nil
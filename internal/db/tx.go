@@ -0,0 +1,33 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// Transact runs fn with queries inside a transaction, committing if it
+// succeeds and rolling back if not. Queries already running in a transaction
+// run fn inside that one, leaving it to whoever began it to commit.
+func (q *Queries) Transact(ctx context.Context, fn func(*Queries) error) error {
+	database, ok := q.db.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (*sql.Tx, error)
+	})
+	if !ok {
+		return fn(q)
+	}
+
+	tx, err := database.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := fn(q.WithTx(tx)); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,357 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Objective is where a club's board expects its manager to finish in the
+// league this season
+type Objective int
+
+const (
+	NoObjective Objective = iota
+	TitleObjective
+	TopFourObjective
+	MidTableObjective
+	SurvivalObjective
+)
+
+var objectiveNames = map[Objective]string{
+	TitleObjective:    "Win the title",
+	TopFourObjective:  "Finish in the top four",
+	MidTableObjective: "Finish in mid-table",
+	SurvivalObjective: "Avoid relegation",
+}
+
+func (o Objective) String() string {
+	return objectiveNames[o]
+}
+
+// Target is the lowest finishing position in the table that meets the
+// objective. Mid-table means finishing a few places clear of the drop.
+func (o Objective) Target(table *LeagueTable) int {
+	safety := len(table.Positions) - table.Rules.RelegationPlaces
+	switch o {
+	case TitleObjective:
+		return 1
+	case TopFourObjective:
+		return 4
+	case MidTableObjective:
+		return safety - 3
+	default:
+		return safety
+	}
+}
+
+// SetObjectives gives every board in the pyramid its objective for the
+// season from how strong its club is against the rest of the division: the
+// strongest is expected to win the title and the next three to make the top
+// four, while those in the bottom twice the relegation places only have to
+// stay up
+func SetObjectives(clubs []*ClubWithPlayers, divisions []*Division) {
+	for _, division := range divisions {
+		ranked := StrongestClubs(ClubsInDivision(clubs, division.ID), len(clubs))
+		strugglers := len(ranked) - 2*division.Rules.RelegationPlaces
+		for rank, club := range ranked {
+			switch {
+			case rank == 0:
+				club.Club.Objective = TitleObjective
+			case rank < 4:
+				club.Club.Objective = TopFourObjective
+			case rank >= strugglers:
+				club.Club.Objective = SurvivalObjective
+			default:
+				club.Club.Objective = MidTableObjective
+			}
+		}
+	}
+}
+
+// The board's confidence in the manager is out of MaxConfidence, and a new
+// manager starts on NeutralConfidence. Each league result moves it by
+// winConfidence or lossConfidence. Once settledMatches have been played the
+// table counts too: a club on course for its objective gains
+// onCourseConfidence, and one off it loses a point, plus another for every
+// adriftPlaces it is adrift, up to maxAdrift.
+const (
+	NeutralConfidence  = 60
+	MaxConfidence      = 100
+	winConfidence      = 3
+	lossConfidence     = -3
+	settledMatches     = 6
+	onCourseConfidence = 1
+	adriftPlaces       = 3
+	maxAdrift          = 3
+)
+
+// At the end of the season the board adds metObjectiveConfidence if the
+// manager met their objective, or takes missedPlaceConfidence for every
+// place they finished short of it
+const (
+	metObjectiveConfidence = 20
+	missedPlaceConfidence  = 8
+)
+
+// JudgeResult moves the board's confidence in the manager after a league
+// result, given the table it leaves the club in. It does nothing if the club
+// didn't play in the match.
+func (c *Club) JudgeResult(result Result, table *LeagueTable) {
+	scored, conceded := result.HomeGoals, result.AwayGoals
+	switch c.ID {
+	case result.Home.ID:
+	case result.Away.ID:
+		scored, conceded = conceded, scored
+	default:
+		return
+	}
+
+	change := 0
+	switch {
+	case scored > conceded:
+		change = winConfidence
+	case scored < conceded:
+		change = lossConfidence
+	}
+	if index := table.indexOf(c); index >= 0 && table.Positions[index].Played >= settledMatches {
+		if adrift := index + 1 - c.Objective.Target(table); adrift > 0 {
+			change -= min(maxAdrift, 1+adrift/adriftPlaces)
+		} else {
+			change += onCourseConfidence
+		}
+	}
+	c.addConfidence(change)
+}
+
+// ReviewSeason moves the board's confidence in the manager on whether the
+// club met its objective in the final table. It does nothing if the club
+// isn't in the table.
+func (c *Club) ReviewSeason(table *LeagueTable) {
+	index := table.indexOf(c)
+	if index < 0 {
+		return
+	}
+	if short := index + 1 - c.Objective.Target(table); short > 0 {
+		c.addConfidence(-short * missedPlaceConfidence)
+	} else {
+		c.addConfidence(metObjectiveConfidence)
+	}
+}
+
+// addConfidence moves the board's confidence, keeping it in range
+func (c *Club) addConfidence(change int) {
+	c.Confidence = max(0, min(MaxConfidence, c.Confidence+change))
+}
+
+// JobSecurity is how safe the manager's job is, from the board's confidence
+// in them
+type JobSecurity int
+
+const (
+	JobSecure JobSecurity = iota
+	JobUnderPressure
+	JobFinalWarning
+	JobLost
+)
+
+var jobSecurityNames = map[JobSecurity]string{
+	JobSecure:        "Secure",
+	JobUnderPressure: "Under pressure",
+	JobFinalWarning:  "Final warning",
+	JobLost:          "Sacked",
+}
+
+func (s JobSecurity) String() string {
+	return jobSecurityNames[s]
+}
+
+// The board warns the manager once its confidence falls below
+// pressureConfidence, gives them a final warning below
+// finalWarningConfidence, and sacks them when it runs out
+const (
+	pressureConfidence     = 35
+	finalWarningConfidence = 15
+)
+
+// JobSecurity reports how safe the manager's job is
+func (c *Club) JobSecurity() JobSecurity {
+	switch {
+	case c.Confidence <= 0:
+		return JobLost
+	case c.Confidence < finalWarningConfidence:
+		return JobFinalWarning
+	case c.Confidence < pressureConfidence:
+		return JobUnderPressure
+	default:
+		return JobSecure
+	}
+}
+
+// A job stays open for vacancyDays after a sacking before the club appoints
+// someone else. A sacked manager is offered at least minVacancies jobs, the
+// clubs whose boards have least faith in their managers making up the
+// numbers, and can step up to a club no more than stepUp places above their
+// last in the pyramid's pecking order.
+const (
+	vacancyDays  = 21
+	minVacancies = 3
+	stepUp       = 4
+)
+
+// Sack relieves the club's manager of their duties, leaving the job vacant
+// from the day
+func (c *Club) Sack(date time.Time) {
+	c.VacantSince = date
+	c.Confidence = NeutralConfidence
+}
+
+// Vacant reports whether the club is still looking for a manager on the day
+func (c *Club) Vacant(today time.Time) bool {
+	return !c.VacantSince.IsZero() && today.Sub(c.VacantSince) < vacancyDays*24*time.Hour
+}
+
+// Appoint fills the job, with the board's confidence where a new manager's
+// starts
+func (c *Club) Appoint() {
+	c.VacantSince = time.Time{}
+	c.Confidence = NeutralConfidence
+}
+
+// JudgeResults has the board of every club in the day's league results judge
+// its manager on the table the results leave its division in, keyed by
+// division, and sack any who have lost its confidence. It returns the clubs
+// judged.
+func JudgeResults(clubs []*ClubWithPlayers, results []Result, tables map[int64]*LeagueTable, date time.Time) []*Club {
+	byID := make(map[int64]*Club)
+	for _, club := range ClubsInPyramid(clubs) {
+		byID[club.Club.ID] = club.Club
+	}
+
+	var judged []*Club
+	for _, result := range results {
+		for _, id := range []int64{result.Home.ID, result.Away.ID} {
+			club, ok := byID[id]
+			if !ok || tables[club.DivisionID] == nil {
+				continue
+			}
+			club.JudgeResult(result, tables[club.DivisionID])
+			if club.JobSecurity() == JobLost {
+				club.Sack(date)
+			}
+			judged = append(judged, club)
+		}
+	}
+	return judged
+}
+
+// ReviewSeasons has the board of every club in the final tables review its
+// manager's season, sacking any who have lost its confidence. It returns the
+// clubs reviewed.
+func ReviewSeasons(clubs []*ClubWithPlayers, tables map[int64]*LeagueTable, date time.Time) []*Club {
+	byID := make(map[int64]*Club)
+	for _, club := range clubs {
+		byID[club.Club.ID] = club.Club
+	}
+
+	var reviewed []*Club
+	for _, table := range tables {
+		for _, position := range table.Positions {
+			club, ok := byID[position.Club.ID]
+			if !ok {
+				continue
+			}
+			club.ReviewSeason(table)
+			if club.JobSecurity() == JobLost {
+				club.Sack(date)
+			}
+			reviewed = append(reviewed, club)
+		}
+	}
+	return reviewed
+}
+
+// Vacancies lists the jobs open to a manager just sacked by the former club:
+// every club in the pyramid looking for a manager, topped up to minVacancies
+// with the clubs whose boards have least faith in theirs and would consider
+// the manager
+func Vacancies(clubs []*ClubWithPlayers, former *Club, today time.Time) []*ClubWithPlayers {
+	var vacancies, shaky []*ClubWithPlayers
+	for _, club := range ClubsInPyramid(clubs) {
+		switch {
+		case club.Club.ID == former.ID:
+		case club.Club.Vacant(today):
+			vacancies = append(vacancies, club)
+		case Apply(club.Club, former, clubs) == nil:
+			shaky = append(shaky, club)
+		}
+	}
+
+	sort.SliceStable(shaky, func(i, j int) bool {
+		return shaky[i].Club.Confidence < shaky[j].Club.Confidence
+	})
+	return append(vacancies, shaky[:min(len(shaky), max(0, minVacancies-len(vacancies)))]...)
+}
+
+// Apply puts a manager sacked by the former club forward for the job. It
+// fails if the club is too far above the former one in the pyramid's pecking
+// order for the board to consider them.
+func Apply(club, former *Club, clubs []*ClubWithPlayers) error {
+	if peckingOrder(club, clubs) < peckingOrder(former, clubs)-stepUp {
+		return fmt.Errorf("the %s board want a manager with a better record", club.Name)
+	}
+	return nil
+}
+
+// peckingOrder is where the club ranks by strength among the pyramid's clubs,
+// 0 the strongest
+func peckingOrder(club *Club, clubs []*ClubWithPlayers) int {
+	pyramid := ClubsInPyramid(clubs)
+	for i, ranked := range StrongestClubs(pyramid, len(pyramid)) {
+		if ranked.Club.ID == club.ID {
+			return i
+		}
+	}
+	return len(pyramid)
+}
+
+// JobReport tells the manager how the board now views their job, or that
+// they've been sacked
+func JobReport(club *Club, security JobSecurity, date time.Time) Message {
+	target := strings.ToLower(club.Objective.String())
+	reports := map[JobSecurity]Message{
+		JobSecure: {
+			Subject: "Board back behind you",
+			Body:    fmt.Sprintf("The %s board are happy with how things are going. They still expect you to %s.", club.Name, target),
+		},
+		JobUnderPressure: {
+			Subject: "Board concerned by results",
+			Body:    fmt.Sprintf("The %s board are concerned by recent results and expect them to improve. They want you to %s.", club.Name, target),
+		},
+		JobFinalWarning: {
+			Subject: "Final warning from the board",
+			Body:    fmt.Sprintf("The %s board are losing patience. Unless results improve quickly, you will be relieved of your duties.", club.Name),
+		},
+		JobLost: {
+			Subject: "Sacked",
+			Body:    fmt.Sprintf("The %s board have relieved you of your duties. You're free to apply for any vacancy.", club.Name),
+		},
+	}
+
+	report := reports[security]
+	report.Date = date
+	report.Kind = BoardMessage
+	return report
+}
+
+// ObjectiveReport tells the manager what the board expects of them this
+// season
+func ObjectiveReport(club *Club, date time.Time) Message {
+	return Message{
+		Date:    date,
+		Kind:    BoardMessage,
+		Subject: "Objective for the season",
+		Body:    fmt.Sprintf("The %s board expect you to %s this season.", club.Name, strings.ToLower(club.Objective.String())),
+	}
+}
@@ -0,0 +1,131 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+// TestSetObjectives verifies the strongest club is expected to win the title
+// and the weakest only to stay up
+func TestSetObjectives(t *testing.T) {
+	clubs := testClubs(20, 0, 0, 0)
+	division := &Division{ID: 1, Name: "Premier League", Tier: 1, Rules: PremierLeagueRules()}
+	SetObjectives(clubs, []*Division{division})
+
+	want := map[int]Objective{0: TitleObjective, 3: TopFourObjective, 4: MidTableObjective, 13: MidTableObjective, 14: SurvivalObjective, 19: SurvivalObjective}
+	for rank, objective := range want {
+		if got := clubs[rank].Club.Objective; got != objective {
+			t.Errorf("Expected the club ranked %d by strength to %s, got %s", rank+1, objective, got)
+		}
+	}
+}
+
+// TestJudgeResult verifies results move the board's confidence and, once the
+// table has settled, so does how far the club is from its objective
+func TestJudgeResult(t *testing.T) {
+	clubs := testClubs(20, 0, 0, 0)
+	table := testTable(PremierLeagueRules(), 3, clubs)
+	us, them := clubs[9].Club, clubs[0].Club
+	us.Objective = MidTableObjective
+
+	us.JudgeResult(Result{Home: us, Away: them, HomeGoals: 2, AwayGoals: 0}, table)
+	if us.Confidence != NeutralConfidence+winConfidence {
+		t.Errorf("Expected a win to lift confidence to %d, got %d", NeutralConfidence+winConfidence, us.Confidence)
+	}
+	us.JudgeResult(Result{Home: them, Away: us, HomeGoals: 1, AwayGoals: 0}, table)
+	if us.Confidence != NeutralConfidence {
+		t.Errorf("Expected a defeat to take it back to %d, got %d", NeutralConfidence, us.Confidence)
+	}
+
+	// Tenth is on course for mid-table, but not for the title
+	for i := range table.Positions {
+		table.Positions[i].Played = settledMatches
+	}
+	us.JudgeResult(Result{Home: us, Away: them, HomeGoals: 1, AwayGoals: 1}, table)
+	if us.Confidence != NeutralConfidence+onCourseConfidence {
+		t.Errorf("Expected a draw on course to give %d, got %d", NeutralConfidence+onCourseConfidence, us.Confidence)
+	}
+	us.Objective, us.Confidence = TitleObjective, NeutralConfidence
+	us.JudgeResult(Result{Home: us, Away: them, HomeGoals: 1, AwayGoals: 1}, table)
+	if us.Confidence != NeutralConfidence-maxAdrift {
+		t.Errorf("Expected a draw nine places off the title to cost %d, got %d", maxAdrift, NeutralConfidence-us.Confidence)
+	}
+}
+
+// TestReviewSeason verifies the board rewards meeting the objective and
+// punishes each place short of it
+func TestReviewSeason(t *testing.T) {
+	clubs := testClubs(20, 0, 0, 0)
+	table := testTable(PremierLeagueRules(), 38, clubs)
+	survivors, relegated := clubs[16].Club, clubs[19].Club
+	survivors.Objective, relegated.Objective = SurvivalObjective, SurvivalObjective
+
+	survivors.ReviewSeason(table)
+	if survivors.Confidence != NeutralConfidence+metObjectiveConfidence {
+		t.Errorf("Expected staying up to lift confidence to %d, got %d", NeutralConfidence+metObjectiveConfidence, survivors.Confidence)
+	}
+	relegated.ReviewSeason(table)
+	if relegated.Confidence != NeutralConfidence-3*missedPlaceConfidence {
+		t.Errorf("Expected finishing bottom to cost %d, got %d", 3*missedPlaceConfidence, NeutralConfidence-relegated.Confidence)
+	}
+}
+
+// TestJudgeResultsSacks verifies a board out of confidence sacks its manager
+// and the job is open until filled or for three weeks
+func TestJudgeResultsSacks(t *testing.T) {
+	clubs := testClubs(20, 0, 0, 0)
+	table := testTable(PremierLeagueRules(), settledMatches, clubs)
+	us, them := clubs[0].Club, clubs[19].Club
+	us.Objective, us.Confidence = TitleObjective, pressureConfidence
+	them.Objective = SurvivalObjective
+	date := time.Date(2025, time.October, 4, 0, 0, 0, 0, time.UTC)
+
+	results := []Result{{Home: us, Away: them, HomeGoals: 0, AwayGoals: 1}}
+	if judged := JudgeResults(clubs, results, map[int64]*LeagueTable{1: table}, date); len(judged) != 2 {
+		t.Fatalf("Expected both boards to judge the result, got %d", len(judged))
+	}
+	if us.JobSecurity() != JobUnderPressure || them.JobSecurity() != JobSecure {
+		t.Errorf("Expected a defeat to put us under pressure, got %s", us.JobSecurity())
+	}
+
+	us.Confidence = 2
+	JudgeResults(clubs, results, map[int64]*LeagueTable{1: table}, date)
+	if !us.Vacant(date) || us.Confidence != NeutralConfidence {
+		t.Errorf("Expected a board out of confidence to sack its manager, got %+v", us)
+	}
+	if us.Vacant(date.AddDate(0, 0, vacancyDays)) {
+		t.Error("Expected the job to be filled after three weeks")
+	}
+	us.Appoint()
+	if us.Vacant(date) {
+		t.Error("Expected an appointment to fill the job")
+	}
+}
+
+// TestVacancies verifies a sacked manager is offered open jobs and the
+// shakiest jobs they could get, and can't walk into a much bigger club
+func TestVacancies(t *testing.T) {
+	clubs := testClubs(20, 0, 0, 0)
+	former := clubs[15].Club
+	date := time.Date(2025, time.October, 4, 0, 0, 0, 0, time.UTC)
+	clubs[2].Club.Sack(date.AddDate(0, 0, -3))
+	clubs[18].Club.Confidence = 20
+	clubs[12].Club.Confidence = 25
+
+	vacancies := Vacancies(clubs, former, date)
+	if len(vacancies) != minVacancies {
+		t.Fatalf("Expected %d vacancies, got %d", minVacancies, len(vacancies))
+	}
+	for i, want := range []int64{3, 19, 13} {
+		if vacancies[i].Club.ID != want {
+			t.Errorf("Expected vacancy %d to be club %d, got %d", i+1, want, vacancies[i].Club.ID)
+		}
+	}
+
+	if err := Apply(clubs[2].Club, former, clubs); err == nil {
+		t.Error("Expected a title contender to turn down a manager sacked by a struggler")
+	}
+	if err := Apply(clubs[11].Club, former, clubs); err != nil {
+		t.Errorf("Expected a club a few places up to consider them, got %v", err)
+	}
+}
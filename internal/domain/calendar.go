@@ -0,0 +1,57 @@
+package domain
+
+import "time"
+
+// DateLayout is how calendar dates are stored
+const DateLayout = "2006-01-02"
+
+// Cup ties are played midweek, between the league's Saturday matchdays
+const (
+	domesticCupWeekdayOffset = 3 // Tuesday
+	continentalWeekdayOffset = 4 // Wednesday
+)
+
+// PreseasonStart returns the day a season's calendar opens, 1 July
+func PreseasonStart(startYear int) time.Time {
+	return time.Date(startYear, time.July, 1, 0, 0, 0, 0, time.UTC)
+}
+
+// SeasonStartYear returns the year the season the day falls in began. A
+// season's calendar runs from 1 July to the end of the following June.
+func SeasonStartYear(day time.Time) int {
+	if day.Month() >= time.July {
+		return day.Year()
+	}
+	return day.Year() - 1
+}
+
+// OpeningDay returns the day the league season kicks off, the first Saturday
+// on or after 9 August
+func OpeningDay(startYear int) time.Time {
+	day := time.Date(startYear, time.August, 9, 0, 0, 0, 0, time.UTC)
+	return day.AddDate(0, 0, (int(time.Saturday)-int(day.Weekday())+7)%7)
+}
+
+// MatchDate returns the day a fixture in the given gameweek is played. League
+// fixtures are on the gameweek's Saturday; domestic cup ties follow on the
+// Tuesday and continental ties on the Wednesday.
+func MatchDate(startYear, gameweek int, cupName string) time.Time {
+	days := (gameweek - 1) * 7
+	switch cupName {
+	case "":
+	case DomesticCupName:
+		days += domesticCupWeekdayOffset
+	default:
+		days += continentalWeekdayOffset
+	}
+	return OpeningDay(startYear).AddDate(0, 0, days)
+}
+
+// ParseDate reads a stored calendar date, returning the zero time if it has none
+func ParseDate(value string) time.Time {
+	date, err := time.Parse(DateLayout, value)
+	if err != nil {
+		return time.Time{}
+	}
+	return date
+}
@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+// TestOpeningDay verifies the league kicks off on the first Saturday on or
+// after 9 August
+func TestOpeningDay(t *testing.T) {
+	tests := []struct {
+		year int
+		want string
+	}{
+		{year: 2025, want: "2025-08-09"},
+		{year: 2026, want: "2026-08-15"},
+		{year: 2027, want: "2027-08-14"},
+	}
+
+	for _, tt := range tests {
+		got := OpeningDay(tt.year)
+		if got.Format(DateLayout) != tt.want || got.Weekday() != time.Saturday {
+			t.Errorf("OpeningDay(%d) = %s, want %s", tt.year, got.Format(DateLayout), tt.want)
+		}
+	}
+}
+
+// TestMatchDate verifies league fixtures are played on Saturdays with cup
+// ties in midweek
+func TestMatchDate(t *testing.T) {
+	tests := []struct {
+		name     string
+		gameweek int
+		cup      string
+		want     string
+		weekday  time.Weekday
+	}{
+		{name: "opening league gameweek", gameweek: 1, want: "2025-08-09", weekday: time.Saturday},
+		{name: "later league gameweek", gameweek: 20, want: "2025-12-20", weekday: time.Saturday},
+		{name: "domestic cup", gameweek: 20, cup: DomesticCupName, want: "2025-12-23", weekday: time.Tuesday},
+		{name: "continental cup", gameweek: 20, cup: ContinentalCupName, want: "2025-12-24", weekday: time.Wednesday},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MatchDate(2025, tt.gameweek, tt.cup)
+			if got.Format(DateLayout) != tt.want || got.Weekday() != tt.weekday {
+				t.Errorf("Expected %s on a %s, got %s", tt.want, tt.weekday, got.Format("Mon 2006-01-02"))
+			}
+		})
+	}
+}
+
+// TestParseDate verifies stored dates read back, and a missing one is zero
+func TestParseDate(t *testing.T) {
+	if got := ParseDate("2026-01-31"); !got.Equal(time.Date(2026, time.January, 31, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected 31 January 2026, got %s", got)
+	}
+	if got := ParseDate(""); !got.IsZero() {
+		t.Errorf("Expected no date, got %s", got)
+	}
+}
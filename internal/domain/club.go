@@ -2,6 +2,7 @@ package domain
 
 import (
 	"fmt"
+	"time"
 )
 
 // Club represents a football club with permanent attributes
@@ -11,6 +12,20 @@ type Club struct {
 	Strength   int // out of 20
 	Background string
 	Foreground string
+	DivisionID int64
+
+	Balance        int64 // Money in the bank, negative when overdrawn
+	TransferBudget int64 // What the board will let the manager spend on fees
+	Academy        int   // How good the club's youth setup is, out of 20
+
+	Training    TrainingPlan
+	Formation   string // The formation the club played its last match in
+	Familiarity int    // Matches running the club has played Formation
+	Cohesion    int    // How well the players know each other, out of 100
+
+	Objective   Objective // What the board expects of the manager this season
+	Confidence  int       // The board's faith in the manager, out of 100
+	VacantSince time.Time // The day the board last sacked a manager, zero if never
 }
 
 // ClubWithPlayers is a view model for when you need club + players together
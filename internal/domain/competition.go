@@ -0,0 +1,286 @@
+package domain
+
+import "sort"
+
+// Tiebreaker separates clubs level on points
+type Tiebreaker int
+
+const (
+	GoalDifferenceTiebreaker Tiebreaker = iota
+	GoalsScoredTiebreaker
+	AwayGoalsTiebreaker
+	WinsTiebreaker
+	HeadToHeadPointsTiebreaker
+	HeadToHeadGoalDifferenceTiebreaker
+	HeadToHeadGoalsScoredTiebreaker
+	HeadToHeadAwayGoalsTiebreaker
+)
+
+func (t Tiebreaker) String() string {
+	switch t {
+	case GoalDifferenceTiebreaker:
+		return "Goal difference"
+	case GoalsScoredTiebreaker:
+		return "Goals scored"
+	case AwayGoalsTiebreaker:
+		return "Away goals"
+	case WinsTiebreaker:
+		return "Wins"
+	case HeadToHeadPointsTiebreaker:
+		return "Head-to-head points"
+	case HeadToHeadGoalDifferenceTiebreaker:
+		return "Head-to-head goal difference"
+	case HeadToHeadGoalsScoredTiebreaker:
+		return "Head-to-head goals scored"
+	case HeadToHeadAwayGoalsTiebreaker:
+		return "Head-to-head away goals"
+	default:
+		return "Unknown"
+	}
+}
+
+// CompetitionRules describes how a league awards points and orders its table
+type CompetitionRules struct {
+	Name          string
+	PointsForWin  int
+	PointsForDraw int
+	PointsForLoss int
+	Tiebreakers   []Tiebreaker // Applied in order to clubs level on points
+
+	// Clubs still level after every tiebreaker play off when the tie decides
+	// the title or who goes down
+	TitlePlayOff      bool
+	RelegationPlayOff bool
+
+	ChampionsLeaguePlaces  int
+	EuropaLeaguePlaces     int
+	PromotionPlaces        int // Promoted automatically from the top of the table
+	PromotionPlayOffPlaces int // The clubs below them play off for one more place
+	RelegationPlaces       int
+	KnockoutStagePlaces    int // Go through from a cup group to the knockout rounds
+
+	Deductions map[int64]int // Points deducted by club ID
+
+	Derbies []Derby // Kept on separate gameweeks when fixtures are generated
+}
+
+// PremierLeagueRules orders clubs on goal difference and goals scored before
+// head-to-head, with play-offs for ties on the title or relegation
+func PremierLeagueRules() *CompetitionRules {
+	return &CompetitionRules{
+		Name:          "Premier League",
+		PointsForWin:  3,
+		PointsForDraw: 1,
+		PointsForLoss: 0,
+		Tiebreakers: []Tiebreaker{
+			GoalDifferenceTiebreaker,
+			GoalsScoredTiebreaker,
+			HeadToHeadPointsTiebreaker,
+			HeadToHeadAwayGoalsTiebreaker,
+		},
+		TitlePlayOff:          true,
+		RelegationPlayOff:     true,
+		ChampionsLeaguePlaces: 4,
+		EuropaLeaguePlaces:    2,
+		RelegationPlaces:      3,
+		Derbies: []Derby{
+			{"Arsenal", "Tottenham Hotspur"},
+			{"Liverpool", "Everton"},
+			{"Manchester City", "Manchester United"},
+			{"Newcastle United", "Sunderland"},
+			{"Aston Villa", "Wolverhampton Wanderers"},
+			{"Crystal Palace", "Brighton & Hove Albion"},
+			{"Chelsea", "Fulham"},
+		},
+	}
+}
+
+// ChampionshipRules promotes the top two automatically and the winner of a
+// play-off between the next four
+func ChampionshipRules() *CompetitionRules {
+	return &CompetitionRules{
+		Name:          "Championship",
+		PointsForWin:  3,
+		PointsForDraw: 1,
+		PointsForLoss: 0,
+		Tiebreakers: []Tiebreaker{
+			GoalDifferenceTiebreaker,
+			GoalsScoredTiebreaker,
+			HeadToHeadPointsTiebreaker,
+			HeadToHeadGoalDifferenceTiebreaker,
+		},
+		PromotionPlaces:        2,
+		PromotionPlayOffPlaces: 4,
+		RelegationPlaces:       3,
+		Derbies: []Derby{
+			{"Sheffield United", "Sheffield Wednesday"},
+			{"Norwich City", "Ipswich Town"},
+			{"Southampton", "Portsmouth"},
+			{"Birmingham City", "West Bromwich Albion"},
+		},
+	}
+}
+
+// RulesFor returns a fresh copy of the rules of the league with the given
+// name, falling back to Premier League rules for leagues the game doesn't know
+func RulesFor(name string) *CompetitionRules {
+	for _, competition := range []func() *CompetitionRules{PremierLeagueRules, ChampionshipRules} {
+		if rules := competition(); rules.Name == name {
+			return rules
+		}
+	}
+	return PremierLeagueRules()
+}
+
+// PointsFor returns the points awarded for a match outcome
+func (r *CompetitionRules) PointsFor(outcome Outcome) int {
+	switch outcome {
+	case Win:
+		return r.PointsForWin
+	case Draw:
+		return r.PointsForDraw
+	default:
+		return r.PointsForLoss
+	}
+}
+
+// PlayOff records clubs that finished level on every tiebreaker where the
+// rules require a play-off to separate them, and the matches that did once
+// it's been played
+type PlayOff struct {
+	Reason  string
+	Clubs   []*Club
+	Matches []PlayOffMatch
+}
+
+// rank orders positions by points then the tiebreaker chain, returning any
+// ties the chain couldn't break
+func (r *CompetitionRules) rank(positions []LeaguePosition, results []Result, venue Venue) [][]LeaguePosition {
+	sort.SliceStable(positions, func(i, j int) bool {
+		return positions[i].Points > positions[j].Points
+	})
+
+	var unresolved [][]LeaguePosition
+	for start := 0; start < len(positions); {
+		end := start + 1
+		for end < len(positions) && positions[end].Points == positions[start].Points {
+			end++
+		}
+		unresolved = append(unresolved, r.breakTies(positions[start:end], results, venue, 0)...)
+		start = end
+	}
+
+	return unresolved
+}
+
+// breakTies sorts a group of clubs level on points using tiebreakers from
+// index onwards. Head-to-head records only count matches between the clubs
+// still level, so each split recomputes them for the smaller group.
+func (r *CompetitionRules) breakTies(group []LeaguePosition, results []Result, venue Venue, index int) [][]LeaguePosition {
+	if len(group) < 2 {
+		return nil
+	}
+	if index == len(r.Tiebreakers) {
+		// Alphabetical for a deterministic order until a play-off settles it
+		sort.SliceStable(group, func(i, j int) bool {
+			return group[i].Club.Name < group[j].Club.Name
+		})
+		return [][]LeaguePosition{group}
+	}
+
+	values := r.tiebreakerValues(r.Tiebreakers[index], group, results, venue)
+	sort.SliceStable(group, func(i, j int) bool {
+		return values[group[i].Club.ID] > values[group[j].Club.ID]
+	})
+
+	var unresolved [][]LeaguePosition
+	for start := 0; start < len(group); {
+		end := start + 1
+		for end < len(group) && values[group[end].Club.ID] == values[group[start].Club.ID] {
+			end++
+		}
+		unresolved = append(unresolved, r.breakTies(group[start:end], results, venue, index+1)...)
+		start = end
+	}
+
+	return unresolved
+}
+
+// tiebreakerValues scores each club in the group on a tiebreaker, higher is better
+func (r *CompetitionRules) tiebreakerValues(tiebreaker Tiebreaker, group []LeaguePosition, results []Result, venue Venue) map[int64]int {
+	values := make(map[int64]int, len(group))
+
+	switch tiebreaker {
+	case GoalDifferenceTiebreaker, GoalsScoredTiebreaker, AwayGoalsTiebreaker, WinsTiebreaker:
+		for _, position := range group {
+			switch tiebreaker {
+			case GoalDifferenceTiebreaker:
+				values[position.Club.ID] = position.GoalDifference
+			case GoalsScoredTiebreaker:
+				values[position.Club.ID] = position.GoalsFor
+			case AwayGoalsTiebreaker:
+				values[position.Club.ID] = position.AwayGoalsFor
+			case WinsTiebreaker:
+				values[position.Club.ID] = position.Won
+			}
+		}
+		return values
+	}
+
+	// Head-to-head tiebreakers use a mini-table of matches between the group
+	clubs := make([]*Club, len(group))
+	inGroup := make(map[int64]bool, len(group))
+	for i, position := range group {
+		clubs[i] = position.Club
+		inGroup[position.Club.ID] = true
+	}
+	var headToHead []Result
+	for _, result := range results {
+		if inGroup[result.Home.ID] && inGroup[result.Away.ID] {
+			headToHead = append(headToHead, result)
+		}
+	}
+
+	for _, position := range r.tally(clubs, headToHead, venue) {
+		switch tiebreaker {
+		case HeadToHeadPointsTiebreaker:
+			values[position.Club.ID] = position.Points
+		case HeadToHeadGoalDifferenceTiebreaker:
+			values[position.Club.ID] = position.GoalDifference
+		case HeadToHeadGoalsScoredTiebreaker:
+			values[position.Club.ID] = position.GoalsFor
+		case HeadToHeadAwayGoalsTiebreaker:
+			values[position.Club.ID] = position.AwayGoalsFor
+		}
+	}
+
+	return values
+}
+
+// tally totals each club's record from the results played at the venue
+func (r *CompetitionRules) tally(clubs []*Club, results []Result, venue Venue) []LeaguePosition {
+	positions := make([]LeaguePosition, 0, len(clubs))
+	for _, club := range clubs {
+		position := LeaguePosition{
+			Club: club,
+		}
+
+		for _, result := range results {
+			isHome := result.Home.ID == club.ID && venue != AwayVenue
+			isAway := result.Away.ID == club.ID && venue != HomeVenue
+
+			switch {
+			case isHome:
+				position.addResult(r, result.HomeGoals, result.AwayGoals)
+			case isAway:
+				position.addResult(r, result.AwayGoals, result.HomeGoals)
+				position.AwayGoalsFor += result.AwayGoals
+			}
+		}
+
+		position.GoalDifference = position.GoalsFor - position.GoalsAgainst
+		positions = append(positions, position)
+	}
+
+	return positions
+}
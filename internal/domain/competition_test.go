@@ -0,0 +1,178 @@
+package domain
+
+import "testing"
+
+// tableOrder returns the club names in table order
+func tableOrder(table *LeagueTable) string {
+	order := ""
+	for _, position := range table.Positions {
+		order += position.Club.Name
+	}
+	return order
+}
+
+// TestHeadToHeadTiebreaker verifies rules that rank head-to-head ahead of goal difference
+func TestHeadToHeadTiebreaker(t *testing.T) {
+	squads := testClubs(3, 0, 0, 0)
+	a, b, c := squads[0].Club, squads[1].Club, squads[2].Club
+	clubs := []*Club{a, b, c}
+
+	// A and B finish on 6 points; A has the better goal difference but lost to B
+	results := []Result{
+		{Gameweek: 1, Home: a, Away: c, HomeGoals: 5, AwayGoals: 0},
+		{Gameweek: 2, Home: b, Away: a, HomeGoals: 1, AwayGoals: 0},
+		{Gameweek: 3, Home: c, Away: b, HomeGoals: 0, AwayGoals: 1},
+		{Gameweek: 4, Home: a, Away: c, HomeGoals: 1, AwayGoals: 0},
+		{Gameweek: 5, Home: c, Away: b, HomeGoals: 1, AwayGoals: 0},
+	}
+
+	if got := tableOrder(NewLeagueTable(PremierLeagueRules(), clubs, results, AllVenues)); got != "ABC" {
+		t.Errorf("Expected goal difference to put A first, got %s", got)
+	}
+	headToHeadFirst := CompetitionRules{
+		PointsForWin:  3,
+		PointsForDraw: 1,
+		Tiebreakers:   []Tiebreaker{HeadToHeadPointsTiebreaker, HeadToHeadGoalDifferenceTiebreaker, GoalDifferenceTiebreaker},
+	}
+	if got := tableOrder(NewLeagueTable(&headToHeadFirst, clubs, results, AllVenues)); got != "BAC" {
+		t.Errorf("Expected head-to-head to put B first, got %s", got)
+	}
+}
+
+// TestHeadToHeadAwayGoals verifies away goals in matches between the tied clubs
+func TestHeadToHeadAwayGoals(t *testing.T) {
+	squads := testClubs(2, 0, 0, 0)
+	a, b := squads[0].Club, squads[1].Club
+	clubs := []*Club{a, b}
+
+	rules := CompetitionRules{
+		PointsForWin:  3,
+		PointsForDraw: 1,
+		Tiebreakers:   []Tiebreaker{HeadToHeadPointsTiebreaker, HeadToHeadAwayGoalsTiebreaker},
+	}
+	results := []Result{
+		{Gameweek: 1, Home: a, Away: b, HomeGoals: 1, AwayGoals: 2},
+		{Gameweek: 2, Home: b, Away: a, HomeGoals: 2, AwayGoals: 3},
+	}
+
+	// Level on head-to-head points and goals, A scored more away
+	if got := tableOrder(NewLeagueTable(&rules, clubs, results, AllVenues)); got != "AB" {
+		t.Errorf("Expected away goals to put A first, got %s", got)
+	}
+}
+
+// TestPointsRulesAndDeductions verifies custom points per result and deductions
+func TestPointsRulesAndDeductions(t *testing.T) {
+	squads := testClubs(3, 0, 0, 0)
+	a, b, c := squads[0].Club, squads[1].Club, squads[2].Club
+	clubs := []*Club{a, b, c}
+
+	rules := PremierLeagueRules()
+	rules.PointsForWin = 2
+	rules.Deductions = map[int64]int{a.ID: 3}
+
+	results := []Result{
+		{Gameweek: 1, Home: a, Away: b, HomeGoals: 2, AwayGoals: 0},
+		{Gameweek: 2, Home: b, Away: c, HomeGoals: 1, AwayGoals: 1},
+	}
+
+	table := NewLeagueTable(rules, clubs, results, AllVenues)
+	if got := tableOrder(table); got != "CBA" {
+		t.Errorf("Expected deduction to drop A to the bottom, got %s", got)
+	}
+	bottom := table.Positions[2]
+	if bottom.Points != -1 || bottom.Deducted != 3 {
+		t.Errorf("Expected A on -1 after a 3 point deduction, got %d (deducted %d)", bottom.Points, bottom.Deducted)
+	}
+
+	// Deductions don't apply to venue splits
+	home := NewLeagueTable(rules, clubs, results, HomeVenue)
+	if home.Positions[0].Club != a || home.Positions[0].Points != 2 {
+		t.Errorf("Expected A top of the home table on 2, got %s on %d", home.Positions[0].Club.Name, home.Positions[0].Points)
+	}
+}
+
+// TestPlayOffs verifies unbroken ties on the title or relegation line are flagged
+func TestPlayOffs(t *testing.T) {
+	squads := testClubs(6, 0, 0, 0)
+	a, b, c, d, e, f := squads[0].Club, squads[1].Club, squads[2].Club, squads[3].Club, squads[4].Club, squads[5].Club
+	clubs := []*Club{a, b, c, d, e, f}
+
+	rules := PremierLeagueRules()
+	rules.RelegationPlaces = 1
+
+	// A and B level on everything at the top, E and F level at the bottom
+	results := []Result{
+		{Gameweek: 1, Home: a, Away: e, HomeGoals: 1, AwayGoals: 0},
+		{Gameweek: 1, Home: b, Away: f, HomeGoals: 1, AwayGoals: 0},
+		{Gameweek: 1, Home: c, Away: d, HomeGoals: 0, AwayGoals: 0},
+	}
+
+	table := NewLeagueTable(rules, clubs, results, AllVenues)
+	if len(table.PlayOffs) != 2 {
+		t.Fatalf("Expected title and relegation play-offs, got %+v", table.PlayOffs)
+	}
+	if table.PlayOffs[0].Reason != "Title" || len(table.PlayOffs[0].Clubs) != 2 {
+		t.Errorf("Expected a two-club title play-off, got %+v", table.PlayOffs[0])
+	}
+	if table.PlayOffs[1].Reason != "Relegation" || len(table.PlayOffs[1].Clubs) != 2 {
+		t.Errorf("Expected a two-club relegation play-off, got %+v", table.PlayOffs[1])
+	}
+
+	// No play-offs once the rules turn them off
+	rules.TitlePlayOff, rules.RelegationPlayOff = false, false
+	if playOffs := NewLeagueTable(rules, clubs, results, AllVenues).PlayOffs; len(playOffs) != 0 {
+		t.Errorf("Expected no play-offs, got %+v", playOffs)
+	}
+
+	// Nor before any matches are played
+	if playOffs := NewLeagueTable(PremierLeagueRules(), clubs, nil, AllVenues).PlayOffs; len(playOffs) != 0 {
+		t.Errorf("Expected no play-offs before kick-off, got %+v", playOffs)
+	}
+}
+
+// TestSettlePlayOffs verifies play-offs reorder the table on their results,
+// deciding the champions and who goes down
+func TestSettlePlayOffs(t *testing.T) {
+	squads := testClubs(6, 0, 0, 0)
+	a, b, c, d, e, f := squads[0].Club, squads[1].Club, squads[2].Club, squads[3].Club, squads[4].Club, squads[5].Club
+	clubs := []*Club{a, b, c, d, e, f}
+
+	rules := PremierLeagueRules()
+	rules.RelegationPlaces = 1
+	results := []Result{
+		{Gameweek: 1, Home: a, Away: e, HomeGoals: 1, AwayGoals: 0},
+		{Gameweek: 1, Home: b, Away: f, HomeGoals: 1, AwayGoals: 0},
+		{Gameweek: 1, Home: c, Away: d, HomeGoals: 0, AwayGoals: 0},
+	}
+	table := NewLeagueTable(rules, clubs, results, AllVenues)
+
+	// The lower-placed club wins every decider on penalties
+	table.SettlePlayOffs(squads, func(home, away *ClubWithPlayers) PlayOffMatch {
+		return PlayOffMatch{Home: home.Club, Away: away.Club, HomeGoals: 1, AwayGoals: 1, Winner: away.Club}
+	})
+
+	if got := tableOrder(table); got != "BACDFE" {
+		t.Errorf("Expected B to win the title and E to go down, got %s", got)
+	}
+	for _, playOff := range table.PlayOffs {
+		if len(playOff.Matches) != 1 || playOff.Matches[0].Round != playOff.Reason {
+			t.Errorf("Expected one %s play-off match, got %+v", playOff.Reason, playOff.Matches)
+		}
+	}
+}
+
+// TestRulesFor verifies each division gets its own copy of the rules, so
+// changing one division's rules leaves the others alone
+func TestRulesFor(t *testing.T) {
+	rules := RulesFor("Championship")
+	if rules.Name != "Championship" || RulesFor("Serie A").Name != "Premier League" {
+		t.Errorf("Expected the named rules, falling back to the Premier League's, got %s", rules.Name)
+	}
+
+	rules.PromotionPlaces = 5
+	rules.Deductions = map[int64]int{1: 10}
+	if fresh := RulesFor("Championship"); fresh.PromotionPlaces != 2 || fresh.Deductions != nil {
+		t.Errorf("Expected fresh rules for every caller, got %+v", fresh)
+	}
+}
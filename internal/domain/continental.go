@@ -0,0 +1,292 @@
+package domain
+
+import (
+	"math/rand/v2"
+	"sort"
+)
+
+// ContinentalCupName is the cup the top flight's leading clubs qualify for,
+// played against the best clubs from the rest of the continent
+const ContinentalCupName = "Champions Cup"
+
+// The continental cup is drawn into groups of four, with the top two of each
+// going through to two-legged knockout rounds
+const (
+	ContinentalEntrants  = 16
+	ContinentalGroupSize = 4
+)
+
+// ContinentalGroupRules orders a group on head-to-head record before overall
+// goal difference
+func ContinentalGroupRules() *CompetitionRules {
+	return &CompetitionRules{
+		Name:          "Champions Cup group",
+		PointsForWin:  3,
+		PointsForDraw: 1,
+		PointsForLoss: 0,
+		Tiebreakers: []Tiebreaker{
+			HeadToHeadPointsTiebreaker,
+			HeadToHeadGoalDifferenceTiebreaker,
+			GoalDifferenceTiebreaker,
+			GoalsScoredTiebreaker,
+		},
+		KnockoutStagePlaces: 2,
+	}
+}
+
+// ContinentalStage is one stage of the continental cup and the gameweeks its
+// matches are played in
+type ContinentalStage struct {
+	Name       string
+	Legs       int
+	GroupStage bool
+	Gameweeks  []int
+}
+
+// ContinentalStages lays the continental cup out over a league season: six
+// group matchdays, two-legged quarter- and semi-finals, and a one-off final
+// the gameweek before the season ends. Its matches are played midweek, so
+// clubs still in it play twice in those gameweeks; any that would fall in a
+// domestic cup gameweek move to a neighbouring one.
+func ContinentalStages(seasonGameweeks int, cupGameweeks []int) []ContinentalStage {
+	slots := CupGameweeks(11, max(seasonGameweeks-1, 11))
+
+	taken := make(map[int]bool, len(cupGameweeks))
+	for _, gameweek := range cupGameweeks {
+		taken[gameweek] = true
+	}
+	for i, slot := range slots {
+		if !taken[slot] {
+			continue
+		}
+		switch {
+		case slot > 1 && !taken[slot-1] && (i == 0 || slot-1 > slots[i-1]):
+			slots[i] = slot - 1
+		case !taken[slot+1] && (i == len(slots)-1 || slot+1 < slots[i+1]):
+			slots[i] = slot + 1
+		}
+	}
+
+	return []ContinentalStage{
+		{Name: "Group stage", Legs: 1, GroupStage: true, Gameweeks: slots[0:6]},
+		{Name: "Quarter-finals", Legs: 2, Gameweeks: slots[6:8]},
+		{Name: "Semi-finals", Legs: 2, Gameweeks: slots[8:10]},
+		{Name: FinalRoundName, Legs: 1, Gameweeks: slots[10:11]},
+	}
+}
+
+// ContinentalQualifiers returns the clubs a final top-flight table sends
+// into next season's continental cup
+func ContinentalQualifiers(table *LeagueTable) []*Club {
+	places := min(table.Rules.ChampionsLeaguePlaces, len(table.Positions))
+	qualifiers := make([]*Club, places)
+	for i := range qualifiers {
+		qualifiers[i] = table.Positions[i].Club
+	}
+	return qualifiers
+}
+
+// StrongestClubs returns up to n of the clubs, strongest first
+func StrongestClubs(clubs []*ClubWithPlayers, n int) []*ClubWithPlayers {
+	ranked := make([]*ClubWithPlayers, len(clubs))
+	copy(ranked, clubs)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].Club.Strength > ranked[j].Club.Strength
+	})
+	return ranked[:min(n, len(ranked))]
+}
+
+// ContinentalField makes up the continental cup's entrants: the qualifiers
+// from the pyramid, then the strongest clubs from outside it
+func ContinentalField(qualifiers []*Club, clubs []*ClubWithPlayers) []*ClubWithPlayers {
+	qualified := make(map[int64]bool, len(qualifiers))
+	for _, club := range qualifiers {
+		qualified[club.ID] = true
+	}
+
+	var field, foreign []*ClubWithPlayers
+	for _, club := range clubs {
+		switch {
+		case qualified[club.Club.ID]:
+			field = append(field, club)
+		case club.Club.DivisionID == 0:
+			foreign = append(foreign, club)
+		}
+	}
+	field = field[:min(len(field), ContinentalEntrants)]
+	return append(field, StrongestClubs(foreign, ContinentalEntrants-len(field))...)
+}
+
+// DrawGroups draws the entrants into groups of four. Clubs are split into
+// pots by strength and each group takes one club from every pot.
+func DrawGroups(entrants []*ClubWithPlayers, rng *rand.Rand) []*CupGroup {
+	ranked := StrongestClubs(entrants, len(entrants))
+	groups := make([]*CupGroup, len(ranked)/ContinentalGroupSize)
+	for i := range groups {
+		groups[i] = &CupGroup{Name: string(rune('A' + i))}
+	}
+
+	for pot := 0; pot+len(groups) <= len(ranked); pot += len(groups) {
+		drawn := ranked[pot : pot+len(groups)]
+		rng.Shuffle(len(drawn), func(i, j int) {
+			drawn[i], drawn[j] = drawn[j], drawn[i]
+		})
+		for i, club := range drawn {
+			groups[i].Clubs = append(groups[i].Clubs, club.Club)
+		}
+	}
+	return groups
+}
+
+// GroupStageFixtures schedules each group as a double round-robin, one round
+// per matchday
+func GroupStageFixtures(groups []*CupGroup, clubs []*ClubWithPlayers, matchdays []int, rng *rand.Rand) []*Fixture {
+	squads := make(map[int64]*ClubWithPlayers, len(clubs))
+	for _, club := range clubs {
+		squads[club.Club.ID] = club
+	}
+
+	var fixtures []*Fixture
+	for _, group := range groups {
+		members := make([]*ClubWithPlayers, 0, len(group.Clubs))
+		for _, club := range group.Clubs {
+			members = append(members, squads[club.ID])
+		}
+		for _, fixture := range GenerateFixtures(members, nil, rng) {
+			fixture.Gameweek = matchdays[min(fixture.Gameweek, len(matchdays))-1]
+			fixture.Leg = 1
+			fixtures = append(fixtures, fixture)
+		}
+	}
+	return fixtures
+}
+
+// TallyGroups works out each group's table from its played group stage matches
+func (c *Cup) TallyGroups() {
+	var results []Result
+	for _, round := range c.Rounds {
+		if !round.GroupStage {
+			continue
+		}
+		for _, tie := range round.Ties {
+			if tie.Played {
+				results = append(results, Result{
+					Gameweek:  tie.Gameweek,
+					Home:      tie.Home,
+					Away:      tie.Away,
+					HomeGoals: tie.HomeGoals,
+					AwayGoals: tie.AwayGoals,
+				})
+			}
+		}
+	}
+
+	for _, group := range c.Groups {
+		members := make(map[int64]bool, len(group.Clubs))
+		for _, club := range group.Clubs {
+			members[club.ID] = true
+		}
+		var groupResults []Result
+		for _, result := range results {
+			if members[result.Home.ID] && members[result.Away.ID] {
+				groupResults = append(groupResults, result)
+			}
+		}
+		group.Table = NewLeagueTable(ContinentalGroupRules(), group.Clubs, groupResults, AllVenues)
+	}
+}
+
+// DrawKnockoutRound draws the next knockout round of a cup with a group
+// stage. The first knockout round pairs each group winner with a runner-up
+// from another group, who hosts the first leg; after that the draw is open.
+func (c *Cup) DrawKnockoutRound(clubs []*ClubWithPlayers, rng *rand.Rand) []*Fixture {
+	squads := make(map[int64]*ClubWithPlayers, len(clubs))
+	for _, club := range clubs {
+		squads[club.Club.ID] = club
+	}
+
+	var pairs [][2]*ClubWithPlayers
+	if last := c.Rounds[len(c.Rounds)-1]; last.GroupStage {
+		pairs = c.drawGroupWinners(squads, rng)
+	} else {
+		remaining := c.Remaining(clubs)
+		rng.Shuffle(len(remaining), func(i, j int) {
+			remaining[i], remaining[j] = remaining[j], remaining[i]
+		})
+		for i := 0; i+1 < len(remaining); i += 2 {
+			pairs = append(pairs, [2]*ClubWithPlayers{remaining[i], remaining[i+1]})
+		}
+	}
+
+	fixtures := make([]*Fixture, len(pairs))
+	for i, pair := range pairs {
+		fixtures[i] = &Fixture{HomeTeam: pair[0], AwayTeam: pair[1], Leg: 1}
+	}
+	return fixtures
+}
+
+// drawGroupWinners pairs group winners with runners-up from other groups,
+// redrawing until nobody meets a club from their own group
+func (c *Cup) drawGroupWinners(squads map[int64]*ClubWithPlayers, rng *rand.Rand) [][2]*ClubWithPlayers {
+	winners := make([]*Club, len(c.Groups))
+	runnersUp := make([]*Club, len(c.Groups))
+	for i, group := range c.Groups {
+		winners[i] = group.Table.Positions[0].Club
+		runnersUp[i] = group.Table.Positions[1].Club
+	}
+
+	order := rng.Perm(len(runnersUp))
+	for attempt := 0; attempt < scheduleAttempts && len(order) > 1; attempt++ {
+		clash := false
+		for i, j := range order {
+			if i == j {
+				clash = true
+				break
+			}
+		}
+		if !clash {
+			break
+		}
+		order = rng.Perm(len(runnersUp))
+	}
+
+	pairs := make([][2]*ClubWithPlayers, len(winners))
+	for i, j := range order {
+		pairs[i] = [2]*ClubWithPlayers{squads[runnersUp[j].ID], squads[winners[i].ID]}
+	}
+	return pairs
+}
+
+// ScheduleLegs puts a round's ties into its gameweeks. A two-legged round
+// gets a return fixture for each tie, with home and away reversed.
+func ScheduleLegs(fixtures []*Fixture, gameweeks []int) []*Fixture {
+	scheduled := make([]*Fixture, 0, len(fixtures)*len(gameweeks))
+	for _, fixture := range fixtures {
+		fixture.Gameweek = gameweeks[0]
+		fixture.Leg = 1
+		scheduled = append(scheduled, fixture)
+	}
+	if len(gameweeks) > 1 {
+		for _, fixture := range fixtures {
+			scheduled = append(scheduled, &Fixture{
+				Gameweek: gameweeks[1],
+				HomeTeam: fixture.AwayTeam,
+				AwayTeam: fixture.HomeTeam,
+				Leg:      2,
+			})
+		}
+	}
+	return scheduled
+}
+
+// ClubsInPyramid returns the clubs playing in one of the pyramid's
+// divisions, leaving out those only met in continental competition
+func ClubsInPyramid(clubs []*ClubWithPlayers) []*ClubWithPlayers {
+	var pyramid []*ClubWithPlayers
+	for _, club := range clubs {
+		if club.Club.DivisionID != 0 {
+			pyramid = append(pyramid, club)
+		}
+	}
+	return pyramid
+}
@@ -0,0 +1,207 @@
+package domain
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+// TestContinentalStages verifies the stages fit inside the season in order,
+// clear of the domestic cup's gameweeks
+func TestContinentalStages(t *testing.T) {
+	cupGameweeks := CupGameweeks(6, 38)
+	stages := ContinentalStages(38, cupGameweeks)
+
+	if len(stages) != 4 || !stages[0].GroupStage || len(stages[0].Gameweeks) != 6 {
+		t.Fatalf("Expected a six-matchday group stage then three knockout rounds, got %+v", stages)
+	}
+	if stages[3].Name != FinalRoundName || stages[3].Legs != 1 {
+		t.Errorf("Expected a one-off final, got %+v", stages[3])
+	}
+
+	taken := make(map[int]bool)
+	for _, gameweek := range cupGameweeks {
+		taken[gameweek] = true
+	}
+	previous := 0
+	for _, stage := range stages {
+		if len(stage.Gameweeks) != max(stage.Legs, 1) && !stage.GroupStage {
+			t.Errorf("Expected %s to have a gameweek per leg, got %v", stage.Name, stage.Gameweeks)
+		}
+		for _, gameweek := range stage.Gameweeks {
+			if gameweek <= previous || gameweek >= 38 {
+				t.Errorf("Expected gameweeks in order before the last, got %d after %d", gameweek, previous)
+			}
+			if taken[gameweek] {
+				t.Errorf("Expected %s to avoid domestic cup gameweek %d", stage.Name, gameweek)
+			}
+			previous = gameweek
+		}
+	}
+}
+
+// TestContinentalField verifies the qualifiers are topped up with the
+// strongest clubs from outside the pyramid
+func TestContinentalField(t *testing.T) {
+	clubs := testClubs(24, 0, 0, 0)
+	for _, club := range clubs[8:] {
+		club.Club.DivisionID = 0
+	}
+	qualifiers := []*Club{clubs[2].Club, clubs[5].Club}
+
+	field := ContinentalField(qualifiers, clubs)
+
+	if len(field) != ContinentalEntrants {
+		t.Fatalf("Expected %d entrants, got %d", ContinentalEntrants, len(field))
+	}
+	for _, club := range field[2:] {
+		if club.Club.DivisionID != 0 {
+			t.Errorf("Expected only foreign clubs after the qualifiers, got club %d", club.Club.ID)
+		}
+		if club.Club.ID > 22 {
+			t.Errorf("Expected the strongest foreign clubs, got club %d", club.Club.ID)
+		}
+	}
+}
+
+// TestDrawGroups verifies each group takes one club from every pot
+func TestDrawGroups(t *testing.T) {
+	clubs := testClubs(16, 0, 0, 0)
+	groups := DrawGroups(clubs, rand.New(rand.NewPCG(1, 2)))
+
+	if len(groups) != 4 {
+		t.Fatalf("Expected 4 groups, got %d", len(groups))
+	}
+	for _, group := range groups {
+		if len(group.Clubs) != ContinentalGroupSize {
+			t.Fatalf("Expected group %s to have %d clubs, got %d", group.Name, ContinentalGroupSize, len(group.Clubs))
+		}
+		for pot, club := range group.Clubs {
+			if got := int(club.ID-1) / 4; got != pot {
+				t.Errorf("Expected group %s's club %d from pot %d, got pot %d", group.Name, club.ID, pot, got)
+			}
+		}
+	}
+}
+
+// TestGroupStage verifies a played group stage sends the top two of each
+// group through, winners against runners-up from other groups
+func TestGroupStage(t *testing.T) {
+	clubs := testClubs(16, 0, 0, 0)
+	rng := rand.New(rand.NewPCG(1, 2))
+
+	groups := DrawGroups(clubs, rng)
+	round := &CupRound{Name: "Group stage", GroupStage: true, Legs: 1}
+	for _, fixture := range GroupStageFixtures(groups, clubs, []int{3, 6, 10, 13, 16, 20}, rng) {
+		// The stronger side always wins
+		tie := &CupTie{Home: fixture.HomeTeam.Club, Away: fixture.AwayTeam.Club, Played: true, HomeGoals: 1}
+		if tie.Home.Strength < tie.Away.Strength {
+			tie.HomeGoals, tie.AwayGoals = 0, 1
+		}
+		round.Ties = append(round.Ties, tie)
+	}
+	if len(round.Ties) != 48 {
+		t.Fatalf("Expected 48 group matches, got %d", len(round.Ties))
+	}
+
+	cup := &Cup{Groups: groups, Rounds: []*CupRound{round}}
+	for _, club := range clubs {
+		cup.Entrants = append(cup.Entrants, club.Club)
+	}
+	cup.TallyGroups()
+
+	remaining := cup.Remaining(clubs)
+	if len(remaining) != 8 {
+		t.Fatalf("Expected 8 clubs through, got %d", len(remaining))
+	}
+	for _, club := range remaining {
+		if club.Club.ID > 8 {
+			t.Errorf("Expected only the top two pots through, got club %d", club.Club.ID)
+		}
+	}
+
+	groupOf := make(map[int64]string)
+	for _, group := range groups {
+		for _, club := range group.Clubs {
+			groupOf[club.ID] = group.Name
+		}
+	}
+	for _, fixture := range cup.DrawKnockoutRound(clubs, rng) {
+		home, away := fixture.HomeTeam.Club, fixture.AwayTeam.Club
+		if home.ID <= 4 || away.ID > 4 {
+			t.Errorf("Expected a runner-up at home to a group winner, got %d vs %d", home.ID, away.ID)
+		}
+		if groupOf[home.ID] == groupOf[away.ID] {
+			t.Errorf("Expected clubs from different groups, got %d vs %d", home.ID, away.ID)
+		}
+	}
+}
+
+// TestTwoLeggedTie verifies ties are decided on aggregate, then penalties
+func TestTwoLeggedTie(t *testing.T) {
+	clubs := testClubs(2, 0, 0, 0)
+	a, b := clubs[0].Club, clubs[1].Club
+
+	tests := []struct {
+		name   string
+		first  *CupTie
+		second *CupTie
+		want   *Club
+	}{
+		{
+			name:   "second leg not played",
+			first:  &CupTie{Home: a, Away: b, Leg: 1, Played: true, HomeGoals: 2},
+			second: &CupTie{Home: b, Away: a, Leg: 2},
+		},
+		{
+			name:   "won on aggregate",
+			first:  &CupTie{Home: a, Away: b, Leg: 1, Played: true, HomeGoals: 2},
+			second: &CupTie{Home: b, Away: a, Leg: 2, Played: true, HomeGoals: 1},
+			want:   a,
+		},
+		{
+			name:   "won on penalties",
+			first:  &CupTie{Home: a, Away: b, Leg: 1, Played: true, HomeGoals: 1},
+			second: &CupTie{Home: b, Away: a, Leg: 2, Played: true, HomeGoals: 1, HomePenalties: 5, AwayPenalties: 4},
+			want:   b,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			round := &CupRound{Legs: 2, Ties: []*CupTie{tt.first, tt.second}}
+			pairings := round.Pairings()
+			if len(pairings) != 1 {
+				t.Fatalf("Expected the legs paired up, got %d pairings", len(pairings))
+			}
+			if got := pairings[0].Winner(); got != tt.want {
+				t.Errorf("Expected winner %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+// TestSecondLegAggregate verifies a second leg is level or won over both legs
+func TestSecondLegAggregate(t *testing.T) {
+	clubs := testClubs(2, 0, 0, 0)
+	home := NewMatchParticipant(clubs[1].Club, nil)
+	away := NewMatchParticipant(clubs[0].Club, nil)
+	match := &Match{
+		Home:     home,
+		Away:     away,
+		Knockout: true,
+		FirstLeg: &Result{Home: away.Club, Away: home.Club, HomeGoals: 2, AwayGoals: 1},
+	}
+
+	home.Score = 1
+	if !match.IsLevel() {
+		t.Error("Expected 1-0 after losing 2-1 away to be level on aggregate")
+	}
+
+	home.Score = 2
+	if got := match.GetWinner(); got != home.Club {
+		t.Errorf("Expected the home side through on aggregate, got %v", got)
+	}
+	if h, a := match.Aggregate(); h != 3 || a != 2 {
+		t.Errorf("Expected 3-2 on aggregate, got %d-%d", h, a)
+	}
+}
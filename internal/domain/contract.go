@@ -0,0 +1,363 @@
+package domain
+
+import (
+	"math/rand/v2"
+	"sort"
+	"strings"
+	"time"
+)
+
+// SquadRole is the part a club promises a player in its squad. Players who
+// are promised more than their standing warrants will take less money, and
+// those offered less than they expect won't sign.
+type SquadRole int
+
+const (
+	RoleProspect SquadRole = iota
+	RoleBackup
+	RoleRotation
+	RoleFirstTeam
+	RoleKeyPlayer
+)
+
+var squadRoleNames = map[SquadRole]string{
+	RoleProspect:  "Prospect",
+	RoleBackup:    "Backup",
+	RoleRotation:  "Rotation",
+	RoleFirstTeam: "First team",
+	RoleKeyPlayer: "Key player",
+}
+
+func (r SquadRole) String() string {
+	return squadRoleNames[r]
+}
+
+// Contracts run for between one and five seasons
+const (
+	MinContractYears = 1
+	MaxContractYears = 5
+)
+
+// retainedPlayers is how many of its best players an AI club keeps on when
+// their contracts run out
+const retainedPlayers = 18
+
+// retirementAge is the age past which AI clubs let players go rather than
+// renew their contracts
+const retirementAge = 33
+
+// Contract is the terms a player is registered with their club on
+type Contract struct {
+	Wage          int64 // Weekly
+	Ends          int   // The year the contract runs out, at the end of June
+	ReleaseClause int64 // The fee that lets any club buy the player, 0 for none
+	Role          SquadRole
+}
+
+// Expired reports whether the contract ran out before the season starting
+// in the year began
+func (c Contract) Expired(startYear int) bool {
+	return c.Ends <= startYear
+}
+
+// InFinalYear reports whether the contract runs out at the end of the season
+// starting in the year
+func (c Contract) InFinalYear(startYear int) bool {
+	return c.Ends == startYear+1
+}
+
+// ExpectedRole is the part a player expects to play from where they rank in
+// the squad: the best three are key players, the rest of the best eleven
+// first-team players, and the next five rotation players. Below that, those
+// young enough on the day see themselves as prospects.
+func ExpectedRole(player Player, squad []Player, today time.Time) SquadRole {
+	better := 0
+	for _, teammate := range squad {
+		if teammate.ID != player.ID && teammate.Quality > player.Quality {
+			better++
+		}
+	}
+
+	switch age := player.AgeOn(today); {
+	case better < 3:
+		return RoleKeyPlayer
+	case better < 11:
+		return RoleFirstTeam
+	case better < 16:
+		return RoleRotation
+	case age > 0 && age <= loanAge:
+		return RoleProspect
+	}
+	return RoleBackup
+}
+
+// ContractYears is the longest contract a club will give a player of the
+// age, shortening as they get older
+func ContractYears(age int) int {
+	switch {
+	case age <= 27:
+		return MaxContractYears
+	case age <= 30:
+		return 3
+	case age <= 32:
+		return 2
+	}
+	return MinContractYears
+}
+
+// NewContract draws up the terms a player signs on joining a squad on the
+// day: the higher of their old wage and the market rate, the role their
+// quality earns them there, and as many years as their age allows
+func NewContract(player Player, squad []Player, today time.Time) Contract {
+	return Contract{
+		Wage: max(player.Contract.Wage, player.MarketWage()),
+		Ends: SeasonStartYear(today) + ContractYears(player.AgeOn(today)),
+		Role: ExpectedRole(player, squad, today),
+	}
+}
+
+// InitialContract gives a player with no contract one already part-way
+// through: up to as many years as their age allows left to run, and a
+// release clause of twice their value for one player in four
+func InitialContract(player Player, squad []Player, today time.Time, rng *rand.Rand) Contract {
+	contract := NewContract(player, squad, today)
+	contract.Ends = SeasonStartYear(today) + 1 + rng.IntN(ContractYears(player.AgeOn(today)))
+	if rng.IntN(4) == 0 {
+		contract.ReleaseClause = RoundFee(2 * player.Valuation(today))
+	}
+	return contract
+}
+
+// RoundWage rounds a weekly wage to the nearest £100
+func RoundWage(wage int64) int64 {
+	return max((wage+50)/100*100, 100)
+}
+
+// ContractDemand is the weekly wage a player wants to sign the contract on
+// offer. They start from a tenth more than they earn now, or the market rate
+// if that's more. Each step of role promised above what they expect takes a
+// tenth off, as does a release clause. Older players take less for a longer
+// deal; younger ones want more to commit.
+func ContractDemand(player Player, expected SquadRole, offer Contract, today time.Time) int64 {
+	demand := float64(max(player.Contract.Wage+player.Contract.Wage/10, player.MarketWage()))
+
+	factor := 1.0
+	if offer.Role > expected {
+		factor -= 0.1 * float64(offer.Role-expected)
+	}
+	if offer.ReleaseClause > 0 {
+		factor -= 0.1
+	}
+	years := float64(offer.Ends - SeasonStartYear(today) - 1)
+	switch age := player.AgeOn(today); {
+	case age >= 30:
+		factor -= 0.05 * years
+	case age > 0 && age <= 23:
+		factor += 0.05 * years
+	}
+
+	return RoundWage(int64(demand * factor))
+}
+
+// ContractTalks is a club's attempt to agree new terms with one of its
+// players, one offer at a time. The player names their price for each offer
+// and gives up after MaxBids offers they won't take.
+type ContractTalks struct {
+	Player   Player
+	Club     *Club
+	Expected SquadRole
+	Demand   int64 // What the player asked for in reply to the latest offer, 0 before one
+	Offers   int
+	Status   NegotiationStatus
+	Reply    string    // The player's answer to the latest offer
+	Agreed   *Contract // The terms signed, once talks succeed
+	today    time.Time
+}
+
+// NewContractTalks opens talks on the day with one of the club's players.
+// Players on loan are under contract to their own club.
+func NewContractTalks(player Player, club *ClubWithPlayers, today time.Time) *ContractTalks {
+	t := &ContractTalks{
+		Player:   player,
+		Club:     club.Club,
+		Expected: ExpectedRole(player, club.Players, today),
+		today:    today,
+	}
+	if player.LoanedFrom != 0 {
+		t.Reply = player.Name + " is only on loan at " + club.Club.Name
+		t.Status = NegotiationCollapsed
+	}
+	return t
+}
+
+// StartingOffer is the contract a club opens talks with: the player's
+// current wage, the role they expect and as long as the club will give them
+func (t *ContractTalks) StartingOffer() Contract {
+	return Contract{
+		Wage: t.Player.Contract.Wage,
+		Ends: SeasonStartYear(t.today) + t.MaxYears(),
+		Role: t.Expected,
+	}
+}
+
+// Years is how many seasons the offer would keep the player for, counting
+// this one
+func (t *ContractTalks) Years(offer Contract) int {
+	return offer.Ends - SeasonStartYear(t.today)
+}
+
+// MaxYears is the longest the club will keep the player for at their age
+func (t *ContractTalks) MaxYears() int {
+	return ContractYears(t.Player.AgeOn(t.today))
+}
+
+// Offer puts terms to the player. They sign when the wage meets their
+// demand for the terms, name their price when it's close, and turn down a
+// role below the one they expect out of hand.
+func (t *ContractTalks) Offer(offer Contract) BidResponse {
+	if t.Status != NegotiationOpen {
+		return BidRejected
+	}
+	t.Offers++
+
+	response := BidRejected
+	demand := ContractDemand(t.Player, t.Expected, offer, t.today)
+	switch {
+	case offer.Role < t.Expected:
+		t.Reply = t.Player.Name + " won't accept less than a " + strings.ToLower(t.Expected.String()) + " role"
+	case offer.Wage >= demand:
+		t.Status = NegotiationAgreed
+		t.Agreed = &offer
+		t.Reply = t.Player.Name + " has signed"
+		return BidAccepted
+	case float64(offer.Wage) >= float64(demand)*derisoryBidShare:
+		t.Demand = demand
+		t.Reply = t.Player.Name + " wants more money"
+		response = BidCountered
+	default:
+		t.Reply = t.Player.Name + " is insulted by the offer"
+	}
+
+	if t.Offers >= MaxBids {
+		t.Status = NegotiationCollapsed
+		t.Reply = t.Player.Name + " has broken off talks"
+		return BidRejected
+	}
+	return response
+}
+
+// Renewal is a player staying on at their club on new terms
+type Renewal struct {
+	Player   Player
+	Club     *Club
+	Contract Contract
+}
+
+// ContractExpiries deals with the contracts that have run out by the start
+// of the season. AI clubs renew the players they still need, paying what each
+// asks, and everyone else leaves on a free: to an AI club in the pyramid that
+// needs them, or abroad. The user's club renews nobody automatically, though
+// no club is left with fewer than MinimumSquadSize players: the best of those
+// leaving stay on one-year deals to make up the numbers. Squads are updated as
+// players move, and the renewals and free transfers returned.
+func ContractExpiries(clubs []*ClubWithPlayers, userClubID int64, startYear int, date time.Time, rng *rand.Rand) ([]Renewal, []*Transfer) {
+	var renewals []Renewal
+	type departure struct {
+		player Player
+		club   *ClubWithPlayers
+	}
+	var departures []departure
+
+	renew := func(player Player, club *ClubWithPlayers, years int) {
+		terms := NewContract(player, club.Players, date)
+		terms.Ends = startYear + years
+		terms.Wage = ContractDemand(player, terms.Role, terms, date)
+		renewals = append(renewals, Renewal{Player: player, Club: club.Club, Contract: terms})
+	}
+
+	for _, club := range clubs {
+		kept := make(map[int64]bool)
+		if club.Club.ID != userClubID {
+			for _, player := range bestPlayers(club.Players, retainedPlayers) {
+				kept[player.ID] = player.AgeOn(date) <= retirementAge
+			}
+		}
+
+		var leaving []Player
+		for _, player := range club.Players {
+			if player.LoanedFrom != 0 || !player.Contract.Expired(startYear) {
+				continue
+			}
+			if kept[player.ID] {
+				renew(player, club, ContractYears(player.AgeOn(date)))
+				continue
+			}
+			leaving = append(leaving, player)
+		}
+
+		leaving = bestPlayers(leaving, len(leaving))
+		shortfall := MinimumSquadSize - (len(club.Players) - len(leaving))
+		for i, player := range leaving {
+			if i < shortfall {
+				renew(player, club, MinContractYears)
+				continue
+			}
+			departures = append(departures, departure{player, club})
+		}
+	}
+
+	// Clubs short in a line have first call on free agents, best players first
+	sort.SliceStable(departures, func(i, j int) bool {
+		return departures[i].player.Quality > departures[j].player.Quality
+	})
+
+	var transfers []*Transfer
+	for _, leaving := range departures {
+		destination := freeAgentDestination(leaving.player, leaving.club, clubs, userClubID, rng)
+		if destination == nil {
+			continue
+		}
+		contract := NewContract(leaving.player, destination.Players, date)
+		transfer := &Transfer{
+			Player:   leaving.player,
+			From:     leaving.club.Club,
+			To:       destination.Club,
+			Date:     date,
+			Contract: &contract,
+		}
+		moveBetweenSquads(transfer, leaving.club, destination)
+		transfers = append(transfers, transfer)
+	}
+
+	return renewals, transfers
+}
+
+// freeAgentDestination picks where a player out of contract signs: an AI
+// club in the pyramid with room that's short or weak in their line, or failing
+// that any club abroad with room
+func freeAgentDestination(player Player, from *ClubWithPlayers, clubs []*ClubWithPlayers, userClubID int64, rng *rand.Rand) *ClubWithPlayers {
+	var suitors, abroad []*ClubWithPlayers
+	for _, club := range clubs {
+		if club == from || club.Club.ID == userClubID || len(club.Players) >= MaximumSquadSize {
+			continue
+		}
+		if club.Club.DivisionID == 0 {
+			abroad = append(abroad, club)
+			continue
+		}
+		for _, need := range SquadNeeds(club.Players) {
+			if need.Line == positionLines[player.Position] && player.Quality >= need.MinQuality {
+				suitors = append(suitors, club)
+				break
+			}
+		}
+	}
+
+	switch {
+	case len(suitors) > 0:
+		return suitors[rng.IntN(len(suitors))]
+	case len(abroad) > 0:
+		return abroad[rng.IntN(len(abroad))]
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package domain
+
+import (
+	"math/rand/v2"
+	"testing"
+	"time"
+)
+
+// TestExpectedRole verifies players expect a bigger role the higher they
+// rank in the squad
+func TestExpectedRole(t *testing.T) {
+	squad := testClubs(1, 20, 2, 0)[0].Players
+	for i := range squad {
+		squad[i].Quality = 20 - i
+	}
+	squad[19].DateOfBirth = bornAged(19)
+
+	tests := []struct {
+		rank int
+		want SquadRole
+	}{
+		{rank: 0, want: RoleKeyPlayer},
+		{rank: 5, want: RoleFirstTeam},
+		{rank: 12, want: RoleRotation},
+		{rank: 17, want: RoleBackup},
+		{rank: 19, want: RoleProspect},
+	}
+	for _, tt := range tests {
+		if got := ExpectedRole(squad[tt.rank], squad, testSeasonStart); got != tt.want {
+			t.Errorf("Expected the player ranked %d to expect %v, got %v", tt.rank+1, tt.want, got)
+		}
+	}
+}
+
+// TestContractDemand verifies a bigger role and a release clause bring a
+// player's wage demand down
+func TestContractDemand(t *testing.T) {
+	player := Player{Quality: 10, DateOfBirth: bornAged(26), Contract: Contract{Wage: 40_000}}
+	offer := Contract{Ends: 2027, Role: RoleFirstTeam}
+
+	base := ContractDemand(player, RoleFirstTeam, offer, testSeasonStart)
+	if base != 44_000 {
+		t.Errorf("Expected a tenth more than the current wage, got %d", base)
+	}
+
+	offer.Role = RoleKeyPlayer
+	offer.ReleaseClause = 10_000_000
+	if got := ContractDemand(player, RoleFirstTeam, offer, testSeasonStart); got != 35_200 {
+		t.Errorf("Expected a fifth off for a bigger role and a release clause, got %d", got)
+	}
+}
+
+// TestContractTalks verifies a player holds out for their demand, then signs
+func TestContractTalks(t *testing.T) {
+	club := testClubs(1, 20, 2, 10)[0]
+	player := club.Players[5]
+	player.Contract = Contract{Wage: 35_000, Ends: 2026}
+
+	talks := NewContractTalks(player, club, testSeasonStart)
+	offer := talks.StartingOffer()
+
+	if talks.Offer(offer) != BidCountered || talks.Demand <= offer.Wage {
+		t.Fatalf("Expected the player to want more than their current wage, got %+v", talks)
+	}
+
+	offer.Role = RoleProspect
+	if talks.Offer(offer) != BidRejected {
+		t.Errorf("Expected a smaller role than expected turned down, got %q", talks.Reply)
+	}
+
+	offer.Role = talks.Expected
+	offer.Wage = talks.Demand
+	if talks.Offer(offer) != BidAccepted || talks.Agreed == nil || talks.Agreed.Wage != offer.Wage {
+		t.Errorf("Expected the player to sign on their demand, got %+v", talks)
+	}
+}
+
+// TestContractExpiries verifies AI clubs keep the players they need, let
+// the rest go on a free, and leave the user's club to its own decisions
+func TestContractExpiries(t *testing.T) {
+	clubs := testClubs(3, 20, 2, 10)
+	user, ai, abroad := clubs[0], clubs[1], clubs[2]
+	user.Players = user.Players[:18]
+	abroad.Players = abroad.Players[:18]
+	abroad.Club.DivisionID = 0
+	for _, club := range clubs {
+		for i := range club.Players {
+			club.Players[i].Contract = Contract{Wage: 10_000, Ends: 2028}
+		}
+	}
+
+	user.Players[3].Contract.Ends = 2025
+	ai.Players[3].Contract.Ends = 2025
+	ai.Players[3].Quality = 15
+	ai.Players[4].Contract.Ends = 2025
+	ai.Players[4].DateOfBirth = bornAged(35)
+
+	date := time.Date(2025, time.July, 1, 0, 0, 0, 0, time.UTC)
+	renewals, transfers := ContractExpiries(clubs, user.Club.ID, 2025, date, rand.New(rand.NewPCG(1, 2)))
+
+	if len(renewals) != 1 || renewals[0].Player.ID != 203 || renewals[0].Contract.Ends <= 2025 {
+		t.Errorf("Expected the AI club to renew its best player, got %+v", renewals)
+	}
+
+	left := make(map[int64]*Club)
+	for _, transfer := range transfers {
+		if transfer.Fee != 0 || transfer.Contract == nil {
+			t.Errorf("Expected a free transfer on a new contract, got %+v", transfer)
+		}
+		left[transfer.Player.ID] = transfer.To
+	}
+	if len(left) != 2 || left[103] == nil || left[204] == nil {
+		t.Errorf("Expected the user's player and the veteran to leave, got %v", left)
+	}
+	if len(user.Players) != 17 || len(ai.Players) != 19 {
+		t.Errorf("Expected the squads updated, got %d and %d players", len(user.Players), len(ai.Players))
+	}
+}
+
+// TestContractExpiriesKeepSquadSize verifies a club down to the minimum
+// squad keeps its players out of contract on one-year deals
+func TestContractExpiriesKeepSquadSize(t *testing.T) {
+	user := testClubs(1, MinimumSquadSize, 2, 10)[0]
+	for i := range user.Players {
+		user.Players[i].Contract = Contract{Wage: 10_000, Ends: 2028}
+	}
+	user.Players[3].Contract.Ends = 2025
+
+	date := time.Date(2025, time.July, 1, 0, 0, 0, 0, time.UTC)
+	renewals, transfers := ContractExpiries([]*ClubWithPlayers{user}, user.Club.ID, 2025, date, rand.New(rand.NewPCG(1, 2)))
+
+	if len(transfers) != 0 || len(renewals) != 1 || renewals[0].Contract.Ends != 2026 {
+		t.Errorf("Expected the player kept on a one-year deal, got %+v and %+v", renewals, transfers)
+	}
+}
+
+// TestReleaseClause verifies a release clause caps the seller's asking price
+func TestReleaseClause(t *testing.T) {
+	seller := testClubs(1, 20, 2, 15)[0]
+	buyer := testClubs(2, 20, 2, 10)[1]
+	player := seller.Players[5]
+	player.Contract.ReleaseClause = 1_000_000
+
+	n := NewNegotiation(player, seller, buyer, testSeasonStart)
+	if n.Asking != 1_000_000 {
+		t.Errorf("Expected the release clause as the asking price, got %d", n.Asking)
+	}
+	if n.Bid(1_000_000) != BidAccepted {
+		t.Error("Expected a bid meeting the release clause accepted")
+	}
+}
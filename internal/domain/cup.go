@@ -0,0 +1,380 @@
+package domain
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"sort"
+	"time"
+)
+
+// DomesticCupName is the knockout cup every club in the pyramid enters
+const DomesticCupName = "FA Cup"
+
+// FinalRoundName is the name given to the last round of any cup
+const FinalRoundName = "Final"
+
+// Cup is a knockout competition played alongside the league. Each round is
+// drawn once the one before it is complete.
+type Cup struct {
+	ID       int64
+	Name     string
+	Entrants []*Club     // Clubs entered ahead of the draw, for cups entered by qualifying
+	Groups   []*CupGroup // Set once a group stage has been drawn
+	Rounds   []*CupRound // Rounds drawn so far this season, earliest first
+}
+
+// CupGroup is one group of a cup's group stage, with its own table
+type CupGroup struct {
+	Name  string
+	Clubs []*Club
+	Table *LeagueTable
+}
+
+// CupRound is one round of a cup. A knockout round is played in a single
+// gameweek, or two for a round played over two legs; a group stage spreads
+// every group's matches over several.
+type CupRound struct {
+	ID         int64
+	CupName    string
+	Number     int // 1-based
+	Name       string
+	Gameweek   int // When the round, or its first leg, is played
+	Legs       int
+	GroupStage bool
+	Ties       []*CupTie
+}
+
+// CupTie is one match of a cup round and, once played, its result
+type CupTie struct {
+	FixtureID     int64
+	Gameweek      int
+	Date          time.Time
+	Leg           int
+	Home          *Club
+	Away          *Club
+	Played        bool
+	HomeGoals     int
+	AwayGoals     int
+	HomePenalties int // Only set when the tie went to a shootout
+	AwayPenalties int
+}
+
+// Winner returns the club going through, or nil until the tie is played
+func (t *CupTie) Winner() *Club {
+	if !t.Played {
+		return nil
+	}
+
+	switch {
+	case t.HomeGoals > t.AwayGoals:
+		return t.Home
+	case t.AwayGoals > t.HomeGoals:
+		return t.Away
+	case t.HomePenalties > t.AwayPenalties:
+		return t.Home
+	case t.AwayPenalties > t.HomePenalties:
+		return t.Away
+	}
+	return nil
+}
+
+// Loser returns the club knocked out, or nil until the tie is played
+func (t *CupTie) Loser() *Club {
+	switch t.Winner() {
+	case nil:
+		return nil
+	case t.Home:
+		return t.Away
+	default:
+		return t.Home
+	}
+}
+
+// WentToPenalties reports whether the tie was settled by a shootout
+func (t *CupTie) WentToPenalties() bool {
+	return t.Played && t.HomePenalties+t.AwayPenalties > 0
+}
+
+// TwoLeggedTie pairs the legs of a knockout tie played home and away. The
+// second leg goes to extra time and penalties if the aggregate is level.
+type TwoLeggedTie struct {
+	First  *CupTie
+	Second *CupTie
+}
+
+// Aggregate returns the goals over both legs for the first leg's home and
+// away sides
+func (t TwoLeggedTie) Aggregate() (home, away int) {
+	return t.First.HomeGoals + t.Second.AwayGoals, t.First.AwayGoals + t.Second.HomeGoals
+}
+
+// Winner returns the club going through, or nil until both legs are played
+func (t TwoLeggedTie) Winner() *Club {
+	if !t.First.Played || !t.Second.Played {
+		return nil
+	}
+
+	home, away := t.Aggregate()
+	switch {
+	case home > away:
+		return t.First.Home
+	case away > home:
+		return t.First.Away
+	case t.Second.HomePenalties > t.Second.AwayPenalties:
+		return t.Second.Home
+	case t.Second.AwayPenalties > t.Second.HomePenalties:
+		return t.Second.Away
+	}
+	return nil
+}
+
+// Loser returns the club knocked out, or nil until both legs are played
+func (t TwoLeggedTie) Loser() *Club {
+	switch t.Winner() {
+	case nil:
+		return nil
+	case t.First.Home:
+		return t.First.Away
+	default:
+		return t.First.Home
+	}
+}
+
+// Pairings matches up the two legs of each tie in a two-legged round
+func (r *CupRound) Pairings() []TwoLeggedTie {
+	var pairings []TwoLeggedTie
+	for _, first := range r.Ties {
+		if first.Leg != 1 {
+			continue
+		}
+		for _, second := range r.Ties {
+			if second.Leg == 2 && second.Home.ID == first.Away.ID && second.Away.ID == first.Home.ID {
+				pairings = append(pairings, TwoLeggedTie{First: first, Second: second})
+				break
+			}
+		}
+	}
+	return pairings
+}
+
+// Losers returns the clubs knocked out of a knockout round so far. Nobody is
+// knocked out by a single match of a group stage.
+func (r *CupRound) Losers() []*Club {
+	var losers []*Club
+	switch {
+	case r.GroupStage:
+	case r.Legs == 2:
+		for _, pairing := range r.Pairings() {
+			if loser := pairing.Loser(); loser != nil {
+				losers = append(losers, loser)
+			}
+		}
+	default:
+		for _, tie := range r.Ties {
+			if loser := tie.Loser(); loser != nil {
+				losers = append(losers, loser)
+			}
+		}
+	}
+	return losers
+}
+
+// IsComplete reports whether every tie in the round has been played
+func (r *CupRound) IsComplete() bool {
+	for _, tie := range r.Ties {
+		if !tie.Played {
+			return false
+		}
+	}
+	return true
+}
+
+// IsFinal reports whether this is the round that decides the cup
+func (r *CupRound) IsFinal() bool {
+	return r.Name == FinalRoundName
+}
+
+// Champion returns the club that won the final, or nil until it has been played
+func (c *Cup) Champion() *Club {
+	if len(c.Rounds) == 0 {
+		return nil
+	}
+	final := c.Rounds[len(c.Rounds)-1]
+	if !final.IsFinal() || len(final.Ties) != 1 {
+		return nil
+	}
+	return final.Ties[0].Winner()
+}
+
+// KnockedOutIn returns the round a club went out in, or nil while it is
+// still in the cup
+func (c *Cup) KnockedOutIn(clubID int64) *CupRound {
+	for _, round := range c.Rounds {
+		for _, loser := range c.losers(round) {
+			if loser.ID == clubID {
+				return round
+			}
+		}
+	}
+	return nil
+}
+
+// losers returns the clubs a round knocked out. A group stage knocks out
+// everyone who didn't qualify from their group, once it is complete.
+func (c *Cup) losers(round *CupRound) []*Club {
+	if !round.GroupStage {
+		return round.Losers()
+	}
+	if !round.IsComplete() {
+		return nil
+	}
+
+	var losers []*Club
+	for _, group := range c.Groups {
+		if group.Table == nil {
+			continue
+		}
+		for i, position := range group.Table.Positions {
+			if group.Table.Zone(i) != KnockoutStageZone {
+				losers = append(losers, position.Club)
+			}
+		}
+	}
+	return losers
+}
+
+// Remaining returns the clubs still in the cup, in the order given. A cup
+// entered by qualifying only counts its entrants.
+func (c *Cup) Remaining(clubs []*ClubWithPlayers) []*ClubWithPlayers {
+	eliminated := make(map[int64]bool)
+	for _, round := range c.Rounds {
+		for _, loser := range c.losers(round) {
+			eliminated[loser.ID] = true
+		}
+	}
+
+	var entered map[int64]bool
+	if len(c.Entrants) > 0 {
+		entered = make(map[int64]bool, len(c.Entrants))
+		for _, club := range c.Entrants {
+			entered[club.ID] = true
+		}
+	}
+
+	remaining := make([]*ClubWithPlayers, 0, len(clubs))
+	for _, club := range clubs {
+		if entered != nil && !entered[club.Club.ID] {
+			continue
+		}
+		if !eliminated[club.Club.ID] {
+			remaining = append(remaining, club)
+		}
+	}
+	return remaining
+}
+
+// NextRoundDue reports whether the next round can be drawn: either none has
+// been yet, or the latest is complete and more than one club is left
+func (c *Cup) NextRoundDue(clubs []*ClubWithPlayers) bool {
+	if len(c.Rounds) > 0 && !c.Rounds[len(c.Rounds)-1].IsComplete() {
+		return false
+	}
+	return len(c.Remaining(clubs)) > 1
+}
+
+// CupRounds returns how many rounds it takes to find a winner from the clubs entered
+func CupRounds(clubs int) int {
+	rounds := 0
+	for clubs > 1 {
+		clubs = cupRoundSurvivors(clubs)
+		rounds++
+	}
+	return rounds
+}
+
+// cupRoundSurvivors returns how many clubs go through a round. A round of
+// any size other than a power of two is cut down to the power of two below,
+// with the rest of the clubs given byes.
+func cupRoundSurvivors(clubs int) int {
+	survivors := 1
+	for survivors*2 < clubs {
+		survivors *= 2
+	}
+	return survivors
+}
+
+// CupGameweeks spreads a cup's rounds evenly through a league season, with
+// the final played in the last gameweek
+func CupGameweeks(rounds, seasonGameweeks int) []int {
+	gameweeks := make([]int, rounds)
+	for i := range gameweeks {
+		gameweeks[i] = max((i+1)*seasonGameweeks/rounds, i+1)
+	}
+	return gameweeks
+}
+
+// CupRoundName names a round by how many clubs are left in it
+func CupRoundName(number, clubs int) string {
+	switch {
+	case clubs <= 2:
+		return FinalRoundName
+	case clubs <= 4:
+		return "Semi-finals"
+	case clubs <= 8:
+		return "Quarter-finals"
+	default:
+		return fmt.Sprintf("Round %d", number)
+	}
+}
+
+// DrawCupRound draws the ties for the next round from the clubs still in the
+// cup. Clubs are ranked by division, then strength: when the numbers need
+// evening up the best-ranked sit the round out, and of those playing the top
+// half are seeded and kept apart, each drawn against an unseeded club.
+func DrawCupRound(clubs []*ClubWithPlayers, divisions []*Division, rng *rand.Rand) []*Fixture {
+	tiers := make(map[int64]int, len(divisions))
+	for _, division := range divisions {
+		tiers[division.ID] = division.Tier
+	}
+	tierOf := func(club *Club) int {
+		if tier, ok := tiers[club.DivisionID]; ok {
+			return tier
+		}
+		return len(divisions) + 1 // Outside the pyramid ranks last
+	}
+
+	ranked := make([]*ClubWithPlayers, len(clubs))
+	copy(ranked, clubs)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		a, b := ranked[i].Club, ranked[j].Club
+		if tierOf(a) != tierOf(b) {
+			return tierOf(a) < tierOf(b)
+		}
+		return a.Strength > b.Strength
+	})
+
+	ties := len(ranked) - cupRoundSurvivors(len(ranked))
+	playing := ranked[len(ranked)-2*ties:]
+
+	seeded := make([]*ClubWithPlayers, ties)
+	unseeded := make([]*ClubWithPlayers, ties)
+	copy(seeded, playing[:ties])
+	copy(unseeded, playing[ties:])
+	rng.Shuffle(len(seeded), func(i, j int) {
+		seeded[i], seeded[j] = seeded[j], seeded[i]
+	})
+	rng.Shuffle(len(unseeded), func(i, j int) {
+		unseeded[i], unseeded[j] = unseeded[j], unseeded[i]
+	})
+
+	fixtures := make([]*Fixture, ties)
+	for i := range fixtures {
+		// Whichever club comes out of the hat first is at home
+		home, away := seeded[i], unseeded[i]
+		if rng.IntN(2) == 0 {
+			home, away = away, home
+		}
+		fixtures[i] = &Fixture{HomeTeam: home, AwayTeam: away}
+	}
+	return fixtures
+}
@@ -0,0 +1,119 @@
+package domain
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+// TestCupRounds verifies how many rounds it takes to find a winner
+func TestCupRounds(t *testing.T) {
+	tests := []struct {
+		clubs int
+		want  int
+	}{
+		{2, 1},
+		{3, 2},
+		{8, 3},
+		{44, 6},
+	}
+
+	for _, tt := range tests {
+		if got := CupRounds(tt.clubs); got != tt.want {
+			t.Errorf("CupRounds(%d): expected %d, got %d", tt.clubs, tt.want, got)
+		}
+	}
+}
+
+// TestCupGameweeks verifies rounds are spread through the season, the final last
+func TestCupGameweeks(t *testing.T) {
+	gameweeks := CupGameweeks(6, 38)
+
+	if len(gameweeks) != 6 {
+		t.Fatalf("Expected 6 gameweeks, got %d", len(gameweeks))
+	}
+	if gameweeks[5] != 38 {
+		t.Errorf("Expected the final in gameweek 38, got %d", gameweeks[5])
+	}
+	for i := 1; i < len(gameweeks); i++ {
+		if gameweeks[i] <= gameweeks[i-1] {
+			t.Errorf("Expected rounds in order, got %v", gameweeks)
+		}
+	}
+}
+
+// TestDrawCupRound verifies byes go to the top clubs and seeds are kept apart
+func TestDrawCupRound(t *testing.T) {
+	// Split evenly across two divisions
+	clubs := testClubs(44, 0, 0, 0)
+	divisions := []*Division{{ID: 1, Tier: 1}, {ID: 2, Tier: 2}}
+	for _, club := range clubs[22:] {
+		club.Club.DivisionID = 2
+	}
+	rng := rand.New(rand.NewPCG(1, 2))
+
+	fixtures := DrawCupRound(clubs, divisions, rng)
+
+	// 44 clubs go down to 32, so 12 ties and 20 byes
+	if len(fixtures) != 12 {
+		t.Fatalf("Expected 12 ties, got %d", len(fixtures))
+	}
+
+	drawn := make(map[int64]bool)
+	for _, fixture := range fixtures {
+		home, away := fixture.HomeTeam.Club, fixture.AwayTeam.Club
+		for _, club := range []*Club{home, away} {
+			if drawn[club.ID] {
+				t.Errorf("Club %d drawn twice", club.ID)
+			}
+			drawn[club.ID] = true
+			if club.ID <= 20 {
+				t.Errorf("Club %d should have had a bye", club.ID)
+			}
+		}
+
+		// Clubs 21-32 are seeded, 33-44 unseeded
+		if (home.ID <= 32) == (away.ID <= 32) {
+			t.Errorf("Expected a seeded club against an unseeded one, got %d vs %d", home.ID, away.ID)
+		}
+	}
+}
+
+// TestCupProgress verifies losers drop out and the final's winner takes the cup
+func TestCupProgress(t *testing.T) {
+	clubs := testClubs(4, 0, 0, 0)
+	a, b, c, d := clubs[0].Club, clubs[1].Club, clubs[2].Club, clubs[3].Club
+
+	cup := &Cup{Rounds: []*CupRound{{
+		Name: CupRoundName(1, 4),
+		Ties: []*CupTie{
+			{Home: a, Away: b, Played: true, HomeGoals: 2, AwayGoals: 1},
+			{Home: c, Away: d, Played: true, HomeGoals: 1, AwayGoals: 1, HomePenalties: 3, AwayPenalties: 4},
+		},
+	}}}
+
+	remaining := cup.Remaining(clubs)
+	if len(remaining) != 2 || remaining[0].Club != a || remaining[1].Club != d {
+		t.Fatalf("Expected clubs %d and %d left, got %v", a.ID, d.ID, remaining)
+	}
+	if !cup.NextRoundDue(clubs) {
+		t.Error("Expected the final to be due")
+	}
+	if round := cup.KnockedOutIn(c.ID); round == nil || round.Name != "Semi-finals" {
+		t.Errorf("Expected club %d out in the semi-finals, got %v", c.ID, round)
+	}
+	if cup.Champion() != nil {
+		t.Error("Expected no champion before the final")
+	}
+
+	cup.Rounds = append(cup.Rounds, &CupRound{
+		Name: CupRoundName(2, 2),
+		Ties: []*CupTie{{Home: d, Away: a, Played: true, HomeGoals: 0, AwayGoals: 3}},
+	})
+
+	if champion := cup.Champion(); champion != a {
+		t.Errorf("Expected club %d to win the cup, got %v", a.ID, champion)
+	}
+	if cup.NextRoundDue(clubs) {
+		t.Error("Expected no more rounds after the final")
+	}
+}
@@ -0,0 +1,132 @@
+package domain
+
+import (
+	"math/rand/v2"
+	"time"
+)
+
+// MaxQuality is the best a player can ever be
+const MaxQuality = 20
+
+// progressPerQuality is how many development points make a point of quality
+const progressPerQuality = 100
+
+// Players grow until peakAge, hold steady, then decline from declineAge
+const (
+	peakAge    = 23
+	declineAge = 31
+)
+
+// monthlyMinutes is the most game time in a month that counts towards a
+// player's development, about five full matches
+const monthlyMinutes = 450
+
+// DateOfBirth picks a birthday for a player who is the age at the start of
+// the season, on 1 July
+func DateOfBirth(age, startYear int, rng *rand.Rand) time.Time {
+	latest := time.Date(startYear-age, time.July, 1, 0, 0, 0, 0, time.UTC)
+	return latest.AddDate(0, 0, -rng.IntN(365))
+}
+
+// AgeOn is how old the player is on the day, 0 if their date of birth isn't
+// known
+func (p Player) AgeOn(day time.Time) int {
+	if p.DateOfBirth.IsZero() {
+		return 0
+	}
+	age := day.Year() - p.DateOfBirth.Year()
+	if day.Month() < p.DateOfBirth.Month() || (day.Month() == p.DateOfBirth.Month() && day.Day() < p.DateOfBirth.Day()) {
+		age--
+	}
+	return age
+}
+
+// InitialPotential is the best a player can become, judged on the day. The
+// younger they are, the more room they have to grow; players in their late
+// twenties have reached theirs.
+func InitialPotential(player Player, today time.Time, rng *rand.Rand) int {
+	headroom := max(0, 28-player.AgeOn(today))
+	if headroom == 0 {
+		return player.Quality
+	}
+	return min(MaxQuality, player.Quality+rng.IntN(headroom/2+1))
+}
+
+// DevelopmentPoints is how far a player moves towards their next point of
+// quality in the month to the day. Young players grow whether they play or
+// not, but game time speeds them up; players in their prime only improve by
+// playing; and veterans decline faster every year past 30.
+func DevelopmentPoints(player Player, minutes int, today time.Time) int {
+	minutes = min(minutes, monthlyMinutes)
+	age := player.AgeOn(today)
+	switch {
+	case age >= declineAge:
+		return -3 * (age - declineAge + 1)
+	case player.Quality >= player.Potential:
+		return 0
+	case age <= peakAge:
+		return 4 + minutes/30
+	}
+	return minutes / 90
+}
+
+// Develop adds the month to the day's development to the player, turning
+// every hundred points into a point of quality either way. Players never grow
+// past their potential nor fall below a quality of 1.
+func (p *Player) Develop(minutes int, today time.Time) {
+	p.addProgress(DevelopmentPoints(*p, minutes, today))
+}
+
+// addProgress moves the player's development on by the points
+func (p *Player) addProgress(points int) {
+	p.Progress += points
+
+	for p.Progress >= progressPerQuality && p.Quality < p.Potential {
+		p.Quality++
+		p.Progress -= progressPerQuality
+	}
+	for p.Progress <= -progressPerQuality && p.Quality > 1 {
+		p.Quality--
+		p.Progress += progressPerQuality
+	}
+	if p.Quality >= p.Potential {
+		p.Progress = min(p.Progress, 0)
+	}
+}
+
+// Retirement is a player hanging up their boots
+type Retirement struct {
+	Player Player
+	Club   *Club
+}
+
+// retirementChance is how likely a player of the age is to retire at the end
+// of a season
+func retirementChance(age int) float64 {
+	switch {
+	case age >= 38:
+		return 1
+	case age >= 34:
+		return 0.2 + 0.15*float64(age-34)
+	}
+	return 0
+}
+
+// Retirements picks the players retiring over the summer, more likely the
+// older they are on the day and certain from 38, and takes them out of their
+// squads
+func Retirements(clubs []*ClubWithPlayers, today time.Time, rng *rand.Rand) []Retirement {
+	var retirements []Retirement
+	for _, club := range clubs {
+		var staying []Player
+		for _, player := range club.Players {
+			if rng.Float64() < retirementChance(player.AgeOn(today)) {
+				retirements = append(retirements, Retirement{Player: player, Club: club.Club})
+				continue
+			}
+			staying = append(staying, player)
+		}
+		club.Players = staying
+	}
+	return retirements
+}
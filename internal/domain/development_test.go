@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"math/rand/v2"
+	"testing"
+	"time"
+)
+
+// testSeasonStart is the day test players' ages are counted from
+var testSeasonStart = time.Date(2025, time.July, 1, 0, 0, 0, 0, time.UTC)
+
+// bornAged is the birthday of a player the age at testSeasonStart
+func bornAged(age int) time.Time {
+	return testSeasonStart.AddDate(-age, 0, 0)
+}
+
+// TestDateOfBirth verifies a player's birthday makes them the right age at
+// the start of the season
+func TestDateOfBirth(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2))
+	for range 100 {
+		player := Player{DateOfBirth: DateOfBirth(24, 2025, rng)}
+		if age := player.AgeOn(testSeasonStart); age != 24 {
+			t.Fatalf("Expected a player born %s to be 24 on %s, got %d", player.DateOfBirth.Format(DateLayout), testSeasonStart.Format(DateLayout), age)
+		}
+	}
+}
+
+// TestDevelop verifies young players grow faster with game time and stop at
+// their potential, and veterans decline
+func TestDevelop(t *testing.T) {
+	benched := Player{DateOfBirth: bornAged(19), Quality: 10, Potential: 12}
+	regular := benched
+	for range 12 {
+		benched.Develop(0, testSeasonStart)
+		regular.Develop(450, testSeasonStart)
+	}
+	if benched.Quality != 10 || benched.Progress != 48 {
+		t.Errorf("Expected a benched youngster to make slow progress, got quality %d and progress %d", benched.Quality, benched.Progress)
+	}
+	if regular.Quality != 12 || regular.Progress != 0 {
+		t.Errorf("Expected a regular to reach their potential and stop, got quality %d and progress %d", regular.Quality, regular.Progress)
+	}
+
+	veteran := Player{DateOfBirth: bornAged(34), Quality: 15, Potential: 15}
+	for range 12 {
+		veteran.Develop(450, testSeasonStart)
+	}
+	if veteran.Quality != 14 {
+		t.Errorf("Expected a veteran to lose a point of quality in a season, got %d", veteran.Quality)
+	}
+}
+
+// TestRetirements verifies nobody retires young and everyone has by 38,
+// counting their age from their birthday on the day
+func TestRetirements(t *testing.T) {
+	club := testClubs(1, 18, 2, 10)[0]
+	club.Players[0].DateOfBirth = bornAged(37)
+	club.Players[1].DateOfBirth = bornAged(38)
+
+	nextSeason := testSeasonStart.AddDate(1, 0, 0)
+	retirements := Retirements([]*ClubWithPlayers{club}, nextSeason, rand.New(rand.NewPCG(1, 2)))
+	if len(retirements) != 2 || len(club.Players) != 16 {
+		t.Errorf("Expected only the two oldest players to retire, got %+v", retirements)
+	}
+}
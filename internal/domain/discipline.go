@@ -0,0 +1,138 @@
+package domain
+
+import "fmt"
+
+// YellowCardBanThreshold is the number of accumulated yellow cards that
+// triggers a one-match ban (and every multiple thereafter)
+const YellowCardBanThreshold = 5
+
+// Ban lengths for dismissals
+const (
+	SecondYellowBanMatches = 1
+	StraightRedBanMatches  = 3
+)
+
+// DisciplineRecord is a single card entered in a player's discipline ledger
+type DisciplineRecord struct {
+	Player       *Player
+	Club         *Club
+	Card         EventType // YellowCardEvent or RedCardEvent
+	Minute       int
+	SecondYellow bool // Red card shown for a second booking in the same match
+}
+
+// Suspension bans a player from selection for a number of matches
+type Suspension struct {
+	PlayerID         int64
+	PlayerName       string
+	Reason           string
+	MatchesBanned    int
+	MatchesRemaining int
+}
+
+// SuspensionFor returns the ban triggered by a ledger entry, given the player's
+// running yellow card total including this card. Returns nil if no ban is due.
+func SuspensionFor(record DisciplineRecord, totalYellows int) *Suspension {
+	var reason string
+	var matches int
+
+	switch record.Card {
+	case RedCardEvent:
+		if record.SecondYellow {
+			reason = "Sent off (two yellow cards)"
+			matches = SecondYellowBanMatches
+		} else {
+			reason = "Sent off (straight red card)"
+			matches = StraightRedBanMatches
+		}
+	case YellowCardEvent:
+		if totalYellows == 0 || totalYellows%YellowCardBanThreshold != 0 {
+			return nil
+		}
+		reason = fmt.Sprintf("%d yellow cards", totalYellows)
+		matches = 1
+	default:
+		return nil
+	}
+
+	return &Suspension{
+		PlayerID:         record.Player.ID,
+		PlayerName:       record.Player.Name,
+		Reason:           reason,
+		MatchesBanned:    matches,
+		MatchesRemaining: matches,
+	}
+}
+
+// DisciplineRecords returns the cards from the match that belong in the ledger.
+// Bookings that led to a second-yellow dismissal are folded into the red card,
+// so they don't also count towards yellow card accumulation.
+func (m *Match) DisciplineRecords() []DisciplineRecord {
+	records := make([]DisciplineRecord, 0)
+
+	for i, event := range m.Events {
+		if event.For == nil || event.Player == nil || event.Player.Player == nil {
+			continue
+		}
+
+		switch event.Type {
+		case YellowCardEvent:
+			if m.wasSentOffAfter(event.Player, i) {
+				continue
+			}
+			records = append(records, DisciplineRecord{
+				Player: event.Player.Player,
+				Club:   event.For.Club,
+				Card:   YellowCardEvent,
+				Minute: event.Minute,
+			})
+		case RedCardEvent:
+			records = append(records, DisciplineRecord{
+				Player:       event.Player.Player,
+				Club:         event.For.Club,
+				Card:         RedCardEvent,
+				Minute:       event.Minute,
+				SecondYellow: m.countCardsBefore(event.Player, YellowCardEvent, i) > 0,
+			})
+		}
+	}
+
+	return records
+}
+
+// BookPlayer shows a yellow card to a player, sending them off if it is
+// their second booking of the match
+func (m *Match) BookPlayer(participant *MatchParticipant, player *MatchPlayerParticipant) {
+	m.AddEvent(NewEvent(YellowCardEvent, m.CurrentMinute, participant, player))
+
+	if m.countCardsBefore(player, YellowCardEvent, len(m.Events)) > 1 {
+		m.SendOff(participant, player)
+	}
+}
+
+// SendOff shows a red card to a player and removes them from the pitch
+func (m *Match) SendOff(participant *MatchParticipant, player *MatchPlayerParticipant) {
+	m.AddEvent(NewEvent(RedCardEvent, m.CurrentMinute, participant, player))
+	participant.RemoveFromPitch(player)
+}
+
+// countCardsBefore counts cards of the given type shown to a player in events[:end]
+func (m *Match) countCardsBefore(player *MatchPlayerParticipant, card EventType, end int) int {
+	count := 0
+	for _, event := range m.Events[:end] {
+		if event.Type == card && event.Player == player {
+			count++
+		}
+	}
+	return count
+}
+
+// wasSentOffAfter reports whether a player received a red card after events[index]
+func (m *Match) wasSentOffAfter(player *MatchPlayerParticipant, index int) bool {
+	for _, event := range m.Events[index+1:] {
+		if event.Type == RedCardEvent && event.Player == player {
+			return true
+		}
+	}
+	return false
+}
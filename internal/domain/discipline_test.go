@@ -0,0 +1,100 @@
+package domain
+
+import "testing"
+
+// TestSuspensionFor verifies ban lengths for reds and yellow card accumulation
+func TestSuspensionFor(t *testing.T) {
+	player := &Player{ID: 7, Name: "Saka"}
+
+	tests := []struct {
+		name         string
+		record       DisciplineRecord
+		totalYellows int
+		wantMatches  int // 0 = no ban
+	}{
+		{"first yellow", DisciplineRecord{Player: player, Card: YellowCardEvent}, 1, 0},
+		{"fourth yellow", DisciplineRecord{Player: player, Card: YellowCardEvent}, 4, 0},
+		{"fifth yellow", DisciplineRecord{Player: player, Card: YellowCardEvent}, 5, 1},
+		{"tenth yellow", DisciplineRecord{Player: player, Card: YellowCardEvent}, 10, 1},
+		{"second yellow red", DisciplineRecord{Player: player, Card: RedCardEvent, SecondYellow: true}, 0, SecondYellowBanMatches},
+		{"straight red", DisciplineRecord{Player: player, Card: RedCardEvent}, 0, StraightRedBanMatches},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			suspension := SuspensionFor(tt.record, tt.totalYellows)
+
+			if tt.wantMatches == 0 {
+				if suspension != nil {
+					t.Errorf("Expected no ban, got %d matches (%s)", suspension.MatchesBanned, suspension.Reason)
+				}
+				return
+			}
+
+			if suspension == nil {
+				t.Fatalf("Expected a %d match ban, got none", tt.wantMatches)
+			}
+			if suspension.MatchesBanned != tt.wantMatches || suspension.MatchesRemaining != tt.wantMatches {
+				t.Errorf("Expected %d match ban, got %d (remaining %d)", tt.wantMatches, suspension.MatchesBanned, suspension.MatchesRemaining)
+			}
+			if suspension.PlayerID != player.ID {
+				t.Errorf("Expected ban for player %d, got %d", player.ID, suspension.PlayerID)
+			}
+		})
+	}
+}
+
+// TestSecondYellowDismissal verifies a second booking sends the player off and
+// is recorded in the ledger as a single second-yellow red card
+func TestSecondYellowDismissal(t *testing.T) {
+	clubs := testClubs(2, 11, 1, 15)
+	match := NewMatchFromFixture(&Fixture{HomeTeam: clubs[0], AwayTeam: clubs[1]})
+
+	offender := match.Home.CurrentXI[5]
+	other := match.Home.CurrentXI[6]
+
+	match.CurrentMinute = 20
+	match.BookPlayer(match.Home, offender)
+	match.CurrentMinute = 30
+	match.BookPlayer(match.Home, other)
+	match.CurrentMinute = 60
+	match.BookPlayer(match.Home, offender)
+
+	if len(match.Home.CurrentXI) != 10 {
+		t.Errorf("Expected 10 players after dismissal, got %d", len(match.Home.CurrentXI))
+	}
+
+	records := match.DisciplineRecords()
+	if len(records) != 2 {
+		t.Fatalf("Expected 2 ledger entries (one yellow, one red), got %d", len(records))
+	}
+
+	if records[0].Card != YellowCardEvent || records[0].Player != other.Player {
+		t.Errorf("Expected first entry to be the other player's yellow card")
+	}
+	if records[1].Card != RedCardEvent || !records[1].SecondYellow || records[1].Minute != 60 {
+		t.Errorf("Expected second entry to be a second-yellow red card at 60', got %+v", records[1])
+	}
+}
+
+// TestSuspendedPlayersNotSelected verifies banned players are left out of the matchday squad
+func TestSuspendedPlayersNotSelected(t *testing.T) {
+	club := testClubs(1, 14, 2, 15)[0]
+	club.Players[0].SuspendedMatches = 1
+	club.Players[12].SuspendedMatches = 2
+
+	participant := NewMatchParticipant(club.Club, club.Players)
+
+	if len(participant.CurrentXI) != 11 {
+		t.Errorf("Expected a full XI, got %d", len(participant.CurrentXI))
+	}
+	if len(participant.Bench) != 1 {
+		t.Errorf("Expected 1 bench player, got %d", len(participant.Bench))
+	}
+
+	for _, p := range append(participant.CurrentXI, participant.Bench...) {
+		if p.Player.SuspendedMatches > 0 {
+			t.Errorf("Suspended player %d was selected", p.Player.ID)
+		}
+	}
+}
@@ -0,0 +1,202 @@
+package domain
+
+import "sort"
+
+// Division is one level of the league pyramid
+type Division struct {
+	ID    int64
+	Name  string
+	Tier  int // 1 is the top flight
+	Rules *CompetitionRules
+}
+
+// Movement is a club changing division at the end of a season
+type Movement struct {
+	Club   *Club
+	From   *Division
+	To     *Division
+	Reason string
+}
+
+// IsPromotion reports whether the club is going up the pyramid
+func (m Movement) IsPromotion() bool {
+	return m.To.Tier < m.From.Tier
+}
+
+// Movements works out promotion and relegation between each pair of adjacent
+// divisions from their final tables. Clubs in the automatic promotion places
+// go up along with any play-off winner, and the clubs in the relegation places
+// of the division above come down to replace them.
+func Movements(divisions []*Division, tables map[int64]*LeagueTable, playOffs map[int64]*PromotionPlayOff) []Movement {
+	ordered := make([]*Division, len(divisions))
+	copy(ordered, divisions)
+	sort.Slice(ordered, func(i, j int) bool {
+		return ordered[i].Tier < ordered[j].Tier
+	})
+
+	var movements []Movement
+	for i := 0; i+1 < len(ordered); i++ {
+		upper, lower := ordered[i], ordered[i+1]
+		upperTable, lowerTable := tables[upper.ID], tables[lower.ID]
+		if upperTable == nil || lowerTable == nil {
+			continue
+		}
+
+		for index := range lowerTable.Positions {
+			if lowerTable.Zone(index) != PromotionZone {
+				continue
+			}
+			reason := "Promoted"
+			if index == 0 {
+				reason = "Champions"
+			}
+			movements = append(movements, Movement{Club: lowerTable.Positions[index].Club, From: lower, To: upper, Reason: reason})
+		}
+		if playOff := playOffs[lower.ID]; playOff != nil && playOff.Winner != nil {
+			movements = append(movements, Movement{Club: playOff.Winner, From: lower, To: upper, Reason: "Play-off winners"})
+		}
+
+		for index := range upperTable.Positions {
+			if upperTable.Zone(index) == RelegationZone {
+				movements = append(movements, Movement{Club: upperTable.Positions[index].Club, From: upper, To: lower, Reason: "Relegated"})
+			}
+		}
+	}
+
+	return movements
+}
+
+// ApplyMovements moves each club into its new division
+func ApplyMovements(clubs []*ClubWithPlayers, movements []Movement) {
+	for _, movement := range movements {
+		for _, club := range clubs {
+			if club.Club.ID == movement.Club.ID {
+				club.Club.DivisionID = movement.To.ID
+			}
+		}
+	}
+}
+
+// ClubsInDivision returns the clubs currently playing in a division
+func ClubsInDivision(clubs []*ClubWithPlayers, divisionID int64) []*ClubWithPlayers {
+	var members []*ClubWithPlayers
+	for _, club := range clubs {
+		if club.Club.DivisionID == divisionID {
+			members = append(members, club)
+		}
+	}
+	return members
+}
+
+// PlayOffMatch is one game of a play-off
+type PlayOffMatch struct {
+	Round     string
+	Home      *Club
+	Away      *Club
+	HomeGoals int
+	AwayGoals int
+	Winner    *Club // Who went through, for a one-off decider that had to produce one
+}
+
+// PromotionPlayOff is the mini-tournament for the last promotion place
+type PromotionPlayOff struct {
+	Matches []PlayOffMatch
+	Winner  *Club
+}
+
+// MatchPlayer plays one match between two squads and returns the score
+type MatchPlayer func(home, away *ClubWithPlayers) (homeGoals, awayGoals int)
+
+// DeciderPlayer plays a one-off match between two squads on a neutral
+// ground that has to produce a winner, going to extra time and penalties if
+// need be
+type DeciderPlayer func(home, away *ClubWithPlayers) PlayOffMatch
+
+// PlayPromotionPlayOff runs the play-off between the clubs just below the
+// automatic promotion places. The highest seed meets the lowest over two legs,
+// finishing at home, and the last two meet in a one-off final. Ties level on
+// aggregate go to the club that finished higher.
+func PlayPromotionPlayOff(table *LeagueTable, squads []*ClubWithPlayers, play MatchPlayer) *PromotionPlayOff {
+	first := table.Rules.PromotionPlaces
+	last := min(first+table.Rules.PromotionPlayOffPlaces, len(table.Positions))
+	if last-first < 2 {
+		return nil
+	}
+
+	squadByClub := make(map[int64]*ClubWithPlayers, len(squads))
+	for _, squad := range squads {
+		squadByClub[squad.Club.ID] = squad
+	}
+
+	// Seeds stay in finishing order, so the higher-placed side of a tie is always first
+	seeds := make([]*ClubWithPlayers, 0, last-first)
+	for _, position := range table.Positions[first:last] {
+		if squad, ok := squadByClub[position.Club.ID]; ok {
+			seeds = append(seeds, squad)
+		}
+	}
+	if len(seeds) < 2 {
+		return nil
+	}
+
+	playOff := &PromotionPlayOff{}
+	for len(seeds) > 1 {
+		if len(seeds) == 2 {
+			homeGoals, awayGoals := play(seeds[0], seeds[1])
+			playOff.Matches = append(playOff.Matches, PlayOffMatch{
+				Round:     "Final",
+				Home:      seeds[0].Club,
+				Away:      seeds[1].Club,
+				HomeGoals: homeGoals,
+				AwayGoals: awayGoals,
+			})
+			if awayGoals > homeGoals {
+				seeds = seeds[1:]
+			} else {
+				seeds = seeds[:1]
+			}
+			continue
+		}
+
+		round := playOffRoundName(len(seeds))
+
+		// An odd seed out at the top goes through without playing
+		var winners []*ClubWithPlayers
+		if len(seeds)%2 == 1 {
+			winners = append(winners, seeds[0])
+			seeds = seeds[1:]
+		}
+		for i := 0; i < len(seeds)/2; i++ {
+			higher, lower := seeds[i], seeds[len(seeds)-1-i]
+			firstHome, firstAway := play(lower, higher)
+			secondHome, secondAway := play(higher, lower)
+			playOff.Matches = append(playOff.Matches,
+				PlayOffMatch{Round: round, Home: lower.Club, Away: higher.Club, HomeGoals: firstHome, AwayGoals: firstAway},
+				PlayOffMatch{Round: round, Home: higher.Club, Away: lower.Club, HomeGoals: secondHome, AwayGoals: secondAway},
+			)
+
+			if firstHome+secondAway > firstAway+secondHome {
+				winners = append(winners, lower)
+			} else {
+				winners = append(winners, higher)
+			}
+		}
+
+		// Keep the survivors in seeding order for the next round
+		sort.SliceStable(winners, func(a, b int) bool {
+			return table.indexOf(winners[a].Club) < table.indexOf(winners[b].Club)
+		})
+		seeds = winners
+	}
+
+	playOff.Winner = seeds[0].Club
+	return playOff
+}
+
+// playOffRoundName names a two-legged round by how many clubs are left in it
+func playOffRoundName(clubs int) string {
+	if clubs <= 4 {
+		return "Semi-final"
+	}
+	return "Quarter-final"
+}
@@ -0,0 +1,116 @@
+package domain
+
+import "testing"
+
+// TestChampionshipZones verifies automatic promotion, the play-off places and the drop
+func TestChampionshipZones(t *testing.T) {
+	table := &LeagueTable{Rules: ChampionshipRules(), Positions: make([]LeaguePosition, 24)}
+
+	tests := []struct {
+		index int
+		want  LeagueZone
+	}{
+		{0, PromotionZone},
+		{1, PromotionZone},
+		{2, PromotionPlayOffZone},
+		{5, PromotionPlayOffZone},
+		{6, NoZone},
+		{20, NoZone},
+		{21, RelegationZone},
+	}
+
+	for _, tt := range tests {
+		if got := table.Zone(tt.index); got != tt.want {
+			t.Errorf("Position %d: expected zone %v, got %v", tt.index+1, tt.want, got)
+		}
+	}
+}
+
+// TestMovements verifies clubs swap between adjacent divisions
+func TestMovements(t *testing.T) {
+	upperRules := PremierLeagueRules()
+	upperRules.ChampionsLeaguePlaces = 0
+	upperRules.EuropaLeaguePlaces = 0
+	upperRules.RelegationPlaces = 2
+	lowerRules := ChampionshipRules()
+	lowerRules.PromotionPlaces = 1
+	lowerRules.PromotionPlayOffPlaces = 2
+
+	upper := &Division{ID: 1, Tier: 1, Rules: upperRules}
+	lower := &Division{ID: 2, Tier: 2, Rules: lowerRules}
+
+	clubs := testClubs(8, 0, 0, 0)
+	for _, club := range clubs[4:] {
+		club.Club.DivisionID = lower.ID
+	}
+	tables := map[int64]*LeagueTable{
+		upper.ID: testTable(upperRules, 0, clubs[:4]),
+		lower.ID: testTable(lowerRules, 0, clubs[4:]),
+	}
+	playOffs := map[int64]*PromotionPlayOff{lower.ID: {Winner: clubs[6].Club}}
+
+	// Divisions are ordered by tier whatever order they arrive in
+	movements := Movements([]*Division{lower, upper}, tables, playOffs)
+
+	want := map[int64]int64{5: upper.ID, 7: upper.ID, 3: lower.ID, 4: lower.ID}
+	if len(movements) != len(want) {
+		t.Fatalf("Expected %d movements, got %d: %+v", len(want), len(movements), movements)
+	}
+	for _, movement := range movements {
+		if to, ok := want[movement.Club.ID]; !ok || to != movement.To.ID {
+			t.Errorf("Unexpected movement of club %d to division %d", movement.Club.ID, movement.To.ID)
+		}
+	}
+
+	ApplyMovements(clubs, movements)
+	if got := len(ClubsInDivision(clubs, upper.ID)); got != 4 {
+		t.Errorf("Expected 4 clubs in the top division after movements, got %d", got)
+	}
+	if clubs[2].Club.DivisionID != lower.ID || clubs[4].Club.DivisionID != upper.ID {
+		t.Errorf("Expected club 3 down and club 5 up, got divisions %d and %d", clubs[2].Club.DivisionID, clubs[4].Club.DivisionID)
+	}
+}
+
+// TestPlayPromotionPlayOff verifies two-legged semi-finals and a one-off final
+func TestPlayPromotionPlayOff(t *testing.T) {
+	squads := testClubs(8, 0, 0, 0)
+	third, fourth, sixth := squads[2].Club, squads[3].Club, squads[5].Club
+	table := testTable(ChampionshipRules(), 0, squads)
+
+	// The home side always wins 1-0, so every tie is level on aggregate and
+	// goes to the higher seed, who then hosts and wins the final
+	homeWins := func(home, away *ClubWithPlayers) (int, int) {
+		return 1, 0
+	}
+
+	playOff := PlayPromotionPlayOff(table, squads, homeWins)
+	if playOff == nil {
+		t.Fatal("Expected a play-off")
+	}
+	if len(playOff.Matches) != 5 {
+		t.Fatalf("Expected 4 semi-final legs and a final, got %d matches", len(playOff.Matches))
+	}
+	if first := playOff.Matches[0]; first.Home != sixth || first.Away != third {
+		t.Errorf("Expected 6th to host 3rd in the first leg, got %d vs %d", first.Home.ID, first.Away.ID)
+	}
+	final := playOff.Matches[4]
+	if final.Round != "Final" || final.Home != third || final.Away != fourth {
+		t.Errorf("Expected 3rd vs 4th in the final, got %s %d vs %d", final.Round, final.Home.ID, final.Away.ID)
+	}
+	if playOff.Winner != third {
+		t.Errorf("Expected 3rd to win the play-off, got %d", playOff.Winner.ID)
+	}
+
+	// Away wins still leave the semi-finals level, but the final goes to the visitors
+	awayWins := func(home, away *ClubWithPlayers) (int, int) {
+		return 0, 2
+	}
+	playOff = PlayPromotionPlayOff(table, squads, awayWins)
+	if playOff.Winner != fourth {
+		t.Errorf("Expected 4th to win the final away, got %d", playOff.Winner.ID)
+	}
+
+	if PlayPromotionPlayOff(testTable(PremierLeagueRules(), 0, squads), squads, homeWins) != nil {
+		t.Error("Expected no play-off in a division without play-off places")
+	}
+}
@@ -19,6 +19,9 @@ const (
 	FreeKickEvent
 	PossessionChangedEvent
 	PossessionRetainedEvent
+	PenaltyScoredEvent // Shootout kicks, which don't count as goals
+	PenaltySavedEvent
+	PenaltyMissedEvent
 )
 
 // Event represents a key moment in the match
@@ -0,0 +1,34 @@
+package domain
+
+import "math"
+
+// FatigueRecoveryPerDay is how much carried-over fatigue a player shakes off
+// each day. A week is enough to recover from one match, but not from two.
+const FatigueRecoveryPerDay = 5
+
+// fatigueSelectionWeight is how far full fatigue marks a player down when
+// auto-picking a side, so tired regulars are rested after a midweek match
+const fatigueSelectionWeight = 0.5
+
+// Freshness returns the share of a player's quality they can be picked on,
+// from 1 when fully rested down to 1-fatigueSelectionWeight
+func (p Player) Freshness() float64 {
+	return 1 - fatigueSelectionWeight*float64(p.Fatigue)/100
+}
+
+// Fatigue returns the tiredness a player takes away from the match
+func (p *MatchPlayerParticipant) Fatigue() int {
+	return 100 - int(math.Round(p.Stamina))
+}
+
+// Rest lets the player recover for the given number of days, shaking off
+// fatigue and running down any injury until they're fit again
+func (p *Player) Rest(days int) {
+	p.Fatigue = max(p.Fatigue-days*FatigueRecoveryPerDay, 0)
+	if p.Injury != nil {
+		p.Injury.DaysRemaining -= days
+		if p.Injury.DaysRemaining <= 0 {
+			p.Injury = nil
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package domain
+
+import "testing"
+
+func TestRestRecoversFatigueAndInjuries(t *testing.T) {
+	player := testClubs(1, 1, 1, 70)[0].Players[0]
+	player.Fatigue = 12
+	player.Injury = &Injury{PlayerID: player.ID, DaysOut: 10, DaysRemaining: 3}
+
+	player.Rest(2)
+	if player.Fatigue != 2 {
+		t.Errorf("expected fatigue 2 after two days, got %d", player.Fatigue)
+	}
+	if player.Injury == nil || player.Injury.DaysRemaining != 1 {
+		t.Fatalf("expected one day left on the injury, got %+v", player.Injury)
+	}
+
+	player.Rest(1)
+	if player.Fatigue != 0 {
+		t.Errorf("expected fatigue to stop at 0, got %d", player.Fatigue)
+	}
+	if player.Injury != nil {
+		t.Errorf("expected the player to be fit, still injured: %+v", player.Injury)
+	}
+}
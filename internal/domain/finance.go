@@ -0,0 +1,290 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// Finance categories group a club's income and spending in its accounts
+const (
+	OpeningBalance       = "Opening balance"
+	MatchdayIncome       = "Matchday"
+	TVMoney              = "TV money"
+	PrizeMoney           = "Prize money"
+	TransferFeesReceived = "Transfer fees received"
+	TransferFeesPaid     = "Transfer fees paid"
+	Wages                = "Wages"
+)
+
+// Transaction is a payment into or out of a club's accounts. Some payments
+// also move the transfer budget: fees paid come out of it, and the board lets
+// the manager reinvest part of any fee received.
+type Transaction struct {
+	Club        *Club
+	Category    string
+	Amount      int64 // Positive for money coming in, negative for money going out
+	Budget      int64 // How far the transfer budget moves with the payment
+	Date        time.Time
+	Description string
+}
+
+// Apply brings the club's balance and transfer budget up to date with the
+// payment. The budget never drops below zero.
+func (t Transaction) Apply() {
+	t.Club.Balance += t.Amount
+	t.Club.TransferBudget = max(t.Club.TransferBudget+t.Budget, 0)
+}
+
+// CategoryTotal is what a club has taken in or paid out under one heading
+type CategoryTotal struct {
+	Category string
+	Total    int64
+}
+
+// FinanceSummary is a club's accounts for the season so far
+type FinanceSummary struct {
+	Totals []CategoryTotal
+	Recent []Transaction // Latest first
+}
+
+// MarketWage is the weekly wage a player of their quality can command,
+// rounded to the nearest £100
+func (p Player) MarketWage() int64 {
+	return RoundWage(int64(2_500 * math.Pow(1.3, float64(p.Quality))))
+}
+
+// WageBill is what the club pays its players each week. Players out on loan
+// are paid by the club borrowing them.
+func (cwp *ClubWithPlayers) WageBill() int64 {
+	var bill int64
+	for _, player := range cwp.Players {
+		bill += player.Contract.Wage
+	}
+	return bill
+}
+
+// StartingBalance is what a club has in the bank when a career begins,
+// climbing steeply with its standing
+func StartingBalance(club *Club) int64 {
+	strength := int64(club.Strength)
+	return strength * strength * strength * 25_000
+}
+
+// BoardTransferBudget is what the board gives the manager to spend on fees
+// at the start of a season: twice the price of a player as good as the club,
+// but never more than a quarter of the money in the bank
+func BoardTransferBudget(club *Club) int64 {
+	if club.Balance <= 0 {
+		return 0
+	}
+	budget := 2 * marketValue(club.Strength, 0)
+	return RoundFee(min(budget, club.Balance/4))
+}
+
+// ticketPrice is the average price of a ticket in each tier of the pyramid
+func ticketPrice(tier int) int64 {
+	if tier == 1 {
+		return 45
+	}
+	return 25
+}
+
+// Attendance is how many supporters come to a club's home matches
+func Attendance(club *Club) int64 {
+	return 5_000 + 3_000*int64(club.Strength)
+}
+
+// MatchdayTakings is what the home club earns on the gate for a match
+func MatchdayTakings(home, away *Club, division *Division, date time.Time) Transaction {
+	return Transaction{
+		Club:        home,
+		Category:    MatchdayIncome,
+		Amount:      Attendance(home) * ticketPrice(division.Tier),
+		Date:        date,
+		Description: "Home gate against " + away.Name,
+	}
+}
+
+// tvShare is what each club in a tier is paid for the season's broadcasting
+// rights, in ten monthly instalments from August to May
+func tvShare(tier int) int64 {
+	if tier == 1 {
+		return 60_000_000
+	}
+	return 9_000_000
+}
+
+// MonthlyAccounts settles a club's month on the first of the next: a
+// month's wages go out and, during the season, an instalment of TV money
+// comes in
+func MonthlyAccounts(club *ClubWithPlayers, division *Division, date time.Time) []Transaction {
+	month := date.AddDate(0, -1, 0).Format("January")
+	transactions := []Transaction{{
+		Club:        club.Club,
+		Category:    Wages,
+		Amount:      -club.WageBill() * 52 / 12,
+		Date:        date,
+		Description: month + " wages",
+	}}
+
+	if date.Month() >= time.August || date.Month() <= time.May {
+		transactions = append(transactions, Transaction{
+			Club:        club.Club,
+			Category:    TVMoney,
+			Amount:      tvShare(division.Tier) / 10,
+			Date:        date,
+			Description: division.Name + " TV instalment",
+		})
+	}
+	return transactions
+}
+
+// MeritPayments pays each club in a division for where it finished, a share
+// for every club it finished above and one more besides
+func MeritPayments(division *Division, table *LeagueTable, date time.Time) []Transaction {
+	share := int64(250_000)
+	if division.Tier == 1 {
+		share = 2_000_000
+	}
+
+	transactions := make([]Transaction, len(table.Positions))
+	for i, position := range table.Positions {
+		transactions[i] = Transaction{
+			Club:        position.Club,
+			Category:    PrizeMoney,
+			Amount:      share * int64(len(table.Positions)-i),
+			Date:        date,
+			Description: fmt.Sprintf("Finished %d of %d in the %s", i+1, len(table.Positions), division.Name),
+		}
+	}
+	return transactions
+}
+
+// CupPrizeMoney pays each club in a cup for every round it got through, with
+// a bonus for the winner. The continental cup pays ten times as much.
+func CupPrizeMoney(cup *Cup, date time.Time) []Transaction {
+	perRound, winnerBonus := int64(500_000), int64(2_000_000)
+	if cup.Name == ContinentalCupName {
+		perRound, winnerBonus = 5_000_000, 20_000_000
+	}
+
+	clubs := make(map[int64]*Club)
+	rounds := make(map[int64]int64)
+	var order []int64
+	for _, round := range cup.Rounds {
+		if !round.IsComplete() {
+			continue
+		}
+		knockedOut := make(map[int64]bool)
+		for _, loser := range cup.losers(round) {
+			knockedOut[loser.ID] = true
+		}
+		for _, tie := range round.Ties {
+			for _, club := range []*Club{tie.Home, tie.Away} {
+				if _, ok := clubs[club.ID]; !ok {
+					clubs[club.ID] = club
+					order = append(order, club.ID)
+				}
+			}
+		}
+
+		// A club plays several ties in a group stage or a two-legged round,
+		// but only gets through it once
+		through := make(map[int64]bool)
+		for _, tie := range round.Ties {
+			for _, club := range []*Club{tie.Home, tie.Away} {
+				if !knockedOut[club.ID] && !through[club.ID] {
+					through[club.ID] = true
+					rounds[club.ID]++
+				}
+			}
+		}
+	}
+
+	champion := cup.Champion()
+	var transactions []Transaction
+	for _, id := range order {
+		amount := perRound * rounds[id]
+		description := cup.Name + " prize money"
+		if champion != nil && champion.ID == id {
+			amount += winnerBonus
+			description = cup.Name + " winners"
+		}
+		if amount == 0 {
+			continue
+		}
+		transactions = append(transactions, Transaction{
+			Club:        clubs[id],
+			Category:    PrizeMoney,
+			Amount:      amount,
+			Date:        date,
+			Description: description,
+		})
+	}
+	return transactions
+}
+
+// TransferPayments moves a transfer fee between the clubs. The buyer's
+// budget pays for it, and the seller's board lets half of it go back into
+// the transfer budget. Loans are free.
+func TransferPayments(transfer *Transfer) []Transaction {
+	if transfer.Loan || transfer.Fee == 0 {
+		return nil
+	}
+	return []Transaction{
+		{
+			Club:        transfer.To,
+			Category:    TransferFeesPaid,
+			Amount:      -transfer.Fee,
+			Budget:      -transfer.Fee,
+			Date:        transfer.Date,
+			Description: "Signed " + transfer.Player.Name + " from " + transfer.From.Name,
+		},
+		{
+			Club:        transfer.From,
+			Category:    TransferFeesReceived,
+			Amount:      transfer.Fee,
+			Budget:      transfer.Fee / 2,
+			Date:        transfer.Date,
+			Description: "Sold " + transfer.Player.Name + " to " + transfer.To.Name,
+		},
+	}
+}
+
+// BoardStance is how the board feels about the state of the club's finances
+type BoardStance int
+
+const (
+	BoardSatisfied BoardStance = iota
+	BoardConcerned             // Overdrawn: the board freezes the transfer budget
+	BoardEmbargo               // Overdrawn by more than a quarter's wages: no signings at all
+)
+
+// embargoWeeks is how many weeks' wages a club can be overdrawn by before
+// the board stops it signing anyone
+const embargoWeeks = 13
+
+// BoardStance judges the club's finances. The board is concerned once the
+// club is overdrawn and places it under a transfer embargo once the overdraft
+// passes a quarter's wages.
+func (cwp *ClubWithPlayers) BoardStance() BoardStance {
+	switch {
+	case cwp.Club.Balance < -embargoWeeks*cwp.WageBill():
+		return BoardEmbargo
+	case cwp.Club.Balance < 0:
+		return BoardConcerned
+	}
+	return BoardSatisfied
+}
+
+// String describes the board's stance to the manager
+func (s BoardStance) String() string {
+	switch s {
+	case BoardConcerned:
+		return "Concerned: the transfer budget is frozen until the club is back in the black"
+	case BoardEmbargo:
+		return "Furious: the club is under a transfer embargo"
+	}
+	return "Satisfied with the club's finances"
+}
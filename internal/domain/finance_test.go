@@ -0,0 +1,137 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+// TestTransferPayments verifies the buyer pays the fee out of its budget and
+// the seller gets half of it back to spend
+func TestTransferPayments(t *testing.T) {
+	seller := &Club{ID: 1, Name: "Seller", Balance: 10_000_000, TransferBudget: 1_000_000}
+	buyer := &Club{ID: 2, Name: "Buyer", Balance: 10_000_000, TransferBudget: 5_000_000}
+	transfer := &Transfer{Player: Player{Name: "Smith"}, From: seller, To: buyer, Fee: 4_000_000}
+
+	for _, transaction := range TransferPayments(transfer) {
+		transaction.Apply()
+	}
+
+	if buyer.Balance != 6_000_000 || buyer.TransferBudget != 1_000_000 {
+		t.Errorf("Expected the buyer to pay £4m from its budget, got balance %d and budget %d", buyer.Balance, buyer.TransferBudget)
+	}
+	if seller.Balance != 14_000_000 || seller.TransferBudget != 3_000_000 {
+		t.Errorf("Expected the seller to bank £4m and reinvest £2m, got balance %d and budget %d", seller.Balance, seller.TransferBudget)
+	}
+
+	loan := &Transfer{From: seller, To: buyer, Loan: true}
+	if payments := TransferPayments(loan); len(payments) != 0 {
+		t.Errorf("Expected loans to be free, got %+v", payments)
+	}
+}
+
+// TestMonthlyAccounts verifies wages go out every month and TV money only
+// comes in during the season
+func TestMonthlyAccounts(t *testing.T) {
+	club := &ClubWithPlayers{
+		Club:    &Club{ID: 1},
+		Players: []Player{{Contract: Contract{Wage: 10_000}}, {Contract: Contract{Wage: 20_000}}},
+	}
+	division := &Division{Name: "Premier League", Tier: 1}
+
+	october := MonthlyAccounts(club, division, time.Date(2025, time.October, 1, 0, 0, 0, 0, time.UTC))
+	if len(october) != 2 {
+		t.Fatalf("Expected wages and TV money in October, got %+v", october)
+	}
+	if october[0].Category != Wages || october[0].Amount != -130_000 {
+		t.Errorf("Expected a month of a £30k weekly wage bill paid, got %+v", october[0])
+	}
+	if october[1].Category != TVMoney || october[1].Amount != 6_000_000 {
+		t.Errorf("Expected a tenth of the top flight's TV money, got %+v", october[1])
+	}
+
+	july := MonthlyAccounts(club, division, time.Date(2026, time.July, 1, 0, 0, 0, 0, time.UTC))
+	if len(july) != 1 || july[0].Category != Wages {
+		t.Errorf("Expected only wages over the summer, got %+v", july)
+	}
+}
+
+// TestMeritPayments verifies clubs are paid more the higher they finish
+func TestMeritPayments(t *testing.T) {
+	table := &LeagueTable{Positions: []LeaguePosition{
+		{Club: &Club{ID: 1}},
+		{Club: &Club{ID: 2}},
+		{Club: &Club{ID: 3}},
+	}}
+
+	payments := MeritPayments(&Division{Name: "Championship", Tier: 2}, table, time.Time{})
+	want := []int64{750_000, 500_000, 250_000}
+	for i, payment := range payments {
+		if payment.Club.ID != int64(i+1) || payment.Amount != want[i] {
+			t.Errorf("Expected %d for finishing %d, got %+v", want[i], i+1, payment)
+		}
+	}
+}
+
+// TestCupPrizeMoney verifies clubs are paid for each round they got through
+// and the winner gets a bonus
+func TestCupPrizeMoney(t *testing.T) {
+	a, b, c, d := &Club{ID: 1}, &Club{ID: 2}, &Club{ID: 3}, &Club{ID: 4}
+	cup := &Cup{Name: DomesticCupName, Rounds: []*CupRound{
+		{Number: 1, Name: "Semi-finals", Legs: 1, Ties: []*CupTie{
+			{Leg: 1, Home: a, Away: b, Played: true, HomeGoals: 2},
+			{Leg: 1, Home: c, Away: d, Played: true, AwayGoals: 1},
+		}},
+		{Number: 2, Name: FinalRoundName, Legs: 1, Ties: []*CupTie{
+			{Leg: 1, Home: a, Away: d, Played: true, HomeGoals: 1},
+		}},
+	}}
+
+	paid := make(map[int64]int64)
+	for _, payment := range CupPrizeMoney(cup, time.Time{}) {
+		paid[payment.Club.ID] += payment.Amount
+	}
+
+	want := map[int64]int64{1: 3_000_000, 4: 500_000}
+	if len(paid) != len(want) {
+		t.Errorf("Expected only the clubs who won a round paid, got %v", paid)
+	}
+	for id, amount := range want {
+		if paid[id] != amount {
+			t.Errorf("Expected club %d to get %d, got %d", id, amount, paid[id])
+		}
+	}
+}
+
+// TestBoardStance verifies the board freezes spending when the club is
+// overdrawn and embargoes it when the overdraft runs deep
+func TestBoardStance(t *testing.T) {
+	buyer := testClubs(2, 20, 2, 10)[1]
+	for i := range buyer.Players {
+		buyer.Players[i].Contract.Wage = 5_000
+	}
+
+	tests := []struct {
+		balance int64
+		want    BoardStance
+	}{
+		{balance: 1_000_000, want: BoardSatisfied},
+		{balance: -1_000_000, want: BoardConcerned},
+		{balance: -20_000_000, want: BoardEmbargo},
+	}
+	for _, tt := range tests {
+		buyer.Club.Balance = tt.balance
+		if got := buyer.BoardStance(); got != tt.want {
+			t.Errorf("Expected stance %v with a balance of %d, got %v", tt.want, tt.balance, got)
+		}
+	}
+
+	if budget := BoardTransferBudget(&Club{Strength: 12, Balance: -1}); budget != 0 {
+		t.Errorf("Expected no budget while overdrawn, got %d", budget)
+	}
+
+	seller := testClubs(1, 20, 2, 10)[0]
+	n := NewNegotiation(seller.Players[5], seller, buyer, testSeasonStart)
+	if n.Status != NegotiationCollapsed || n.Refusal == "" {
+		t.Errorf("Expected talks refused under an embargo, got %+v", n)
+	}
+}
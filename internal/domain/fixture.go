@@ -1,9 +1,22 @@
 package domain
 
+import "time"
+
 type Fixture struct {
-	ID       int
-	Gameweek int
-	HomeTeam *ClubWithPlayers
-	AwayTeam *ClubWithPlayers
-	Result   *Match
+	ID         int
+	DivisionID int64
+	Gameweek   int
+	Date       time.Time // The day it is played on
+	HomeTeam   *ClubWithPlayers
+	AwayTeam   *ClubWithPlayers
+	Result     *Match
+	CupRound   *CupRound // Set for cup ties, which are played outside any division
+	Leg        int       // Which leg of a two-legged cup tie this is
+	FirstLeg   *Result   // The first leg's result, once played, for a second leg
+}
+
+// IsKnockout reports whether the fixture has to produce a winner on the day:
+// a one-off cup tie, or the second leg of a two-legged one
+func (f *Fixture) IsKnockout() bool {
+	return f.CupRound != nil && !f.CupRound.GroupStage && f.Leg >= f.CupRound.Legs
 }
@@ -7,6 +7,42 @@ const (
 	FourThreeThree
 )
 
+// Formations lists every formation a manager can pick
+var Formations = []Formation{FourFourTwo, FourThreeThree}
+
+// formationSlots maps each formation to its position codes, goalkeeper first
+var formationSlots = map[Formation][]string{
+	FourFourTwo:    {"GK", "RB", "CB", "CB", "LB", "RM", "CM", "CM", "LM", "ST", "ST"},
+	FourThreeThree: {"GK", "RB", "CB", "CB", "LB", "CM", "CM", "CM", "RW", "ST", "LW"},
+}
+
+// String returns the formation in its usual "4-4-2" notation
+func (f Formation) String() string {
+	switch f {
+	case FourFourTwo:
+		return "4-4-2"
+	case FourThreeThree:
+		return "4-3-3"
+	default:
+		return "Unknown"
+	}
+}
+
+// Slots returns the position code for each slot in the formation, goalkeeper first
+func (f Formation) Slots() []string {
+	return formationSlots[f]
+}
+
+// Next returns the formation after this one, wrapping around
+func (f Formation) Next() Formation {
+	for i, formation := range Formations {
+		if formation == f {
+			return Formations[(i+1)%len(Formations)]
+		}
+	}
+	return Formations[0]
+}
+
 type FormationData struct {
 	Formation Formation
 	Positions []Position
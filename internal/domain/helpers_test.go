@@ -0,0 +1,70 @@
+package domain
+
+// testClubs creates a division of n clubs named A, B, C..., strongest first,
+// each with a squad of size players of the given quality, all aged 25: keepers
+// goalkeepers, then defenders, midfielders and forwards up to their lines'
+// quotas, with any more in midfield. Club i+1 has ID i+1 and its players IDs
+// from (i+1)*100 up.
+func testClubs(n, size, keepers, quality int) []*ClubWithPlayers {
+	var outfield []string
+	for line, position := range []string{"CB", "CM", "ST"} {
+		for range lineQuotas[line+1] {
+			outfield = append(outfield, position)
+		}
+	}
+	positions := make([]string, size)
+	for j := range positions {
+		positions[j] = "CM"
+		if j < keepers {
+			positions[j] = "GK"
+		} else if j-keepers < len(outfield) {
+			positions[j] = outfield[j-keepers]
+		}
+	}
+
+	clubs := make([]*ClubWithPlayers, n)
+	for i := range clubs {
+		id := int64(i + 1)
+		club := &ClubWithPlayers{
+			Club: &Club{
+				ID:         id,
+				Name:       string(rune('A' + i)),
+				Strength:   n - i,
+				DivisionID: 1,
+				Confidence: NeutralConfidence,
+			},
+			Players: testSquad(quality, positions...),
+		}
+		for j := range club.Players {
+			club.Players[j].ID = id*100 + int64(j)
+		}
+		clubs[i] = club
+	}
+	return clubs
+}
+
+// testSquad creates a squad with a player of the given quality, aged 25, in
+// each of the positions, with IDs from 1 up
+func testSquad(quality int, positions ...string) []Player {
+	players := make([]Player, len(positions))
+	for i, position := range positions {
+		players[i] = Player{
+			ID:          int64(i + 1),
+			Name:        "Player",
+			Position:    position,
+			Quality:     quality,
+			DateOfBirth: bornAged(25),
+		}
+	}
+	return players
+}
+
+// testTable creates a table with the clubs in the order given, each having
+// played the given number of matches
+func testTable(rules *CompetitionRules, played int, clubs []*ClubWithPlayers) *LeagueTable {
+	table := &LeagueTable{Rules: rules, Positions: make([]LeaguePosition, len(clubs))}
+	for i, club := range clubs {
+		table.Positions[i] = LeaguePosition{Club: club.Club, Played: played, Points: len(clubs) - i}
+	}
+	return table
+}
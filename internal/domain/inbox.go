@@ -0,0 +1,344 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"time"
+)
+
+// MessageKind is what a message in the manager's inbox is about
+type MessageKind int
+
+const (
+	RoundUpMessage MessageKind = iota
+	InjuryMessage
+	TransferOfferMessage
+	BoardMessage
+	ComplaintMessage
+)
+
+var messageKindNames = map[MessageKind]string{
+	RoundUpMessage:       "Round-up",
+	InjuryMessage:        "Medical",
+	TransferOfferMessage: "Transfer offer",
+	BoardMessage:         "Board",
+	ComplaintMessage:     "Player",
+}
+
+func (k MessageKind) String() string {
+	return messageKindNames[k]
+}
+
+// MessageStatus is whether a message is waiting on the manager's answer,
+// and what the answer was
+type MessageStatus int
+
+const (
+	MessageInfo    MessageStatus = iota // Nothing to answer
+	MessagePending                      // Waiting on an answer
+	MessageAccepted
+	MessageRejected
+	MessageExpired // Went unanswered, or could no longer go ahead
+)
+
+var messageStatusNames = map[MessageStatus]string{
+	MessagePending:  "Awaiting your answer",
+	MessageAccepted: "Accepted",
+	MessageRejected: "Rejected",
+	MessageExpired:  "Expired",
+}
+
+func (s MessageStatus) String() string {
+	return messageStatusNames[s]
+}
+
+// Message is a dated item in the manager's inbox. Transfer offers and
+// player complaints wait on an answer; everything else is for information.
+type Message struct {
+	ID       int64
+	Date     time.Time
+	Kind     MessageKind
+	Subject  string
+	Body     string
+	Read     bool
+	Status   MessageStatus
+	PlayerID int64 // The player the message is about, 0 if none
+	ClubID   int64 // The club making a transfer offer, 0 otherwise
+	Fee      int64 // The fee offered in a transfer offer
+}
+
+// Pending reports whether the message is waiting on the manager's answer
+func (m Message) Pending() bool {
+	return m.Status == MessagePending
+}
+
+// UnreadCount is how many of the messages haven't been read
+func UnreadCount(messages []Message) int {
+	unread := 0
+	for _, message := range messages {
+		if !message.Read {
+			unread++
+		}
+	}
+	return unread
+}
+
+// InjuryReport tells the manager one of their players has been injured
+func InjuryReport(injury Injury, date time.Time) Message {
+	return Message{
+		Date:     date,
+		Kind:     InjuryMessage,
+		Subject:  injury.PlayerName + " injured",
+		Body:     fmt.Sprintf("The medical team report that %s is injured (%s) and expect them to be out for around %d days.", injury.PlayerName, strings.ToLower(injury.Type.String()), injury.DaysOut),
+		PlayerID: injury.PlayerID,
+	}
+}
+
+// BoardReport tells the manager the board's stance on the club's finances
+// has changed
+func BoardReport(stance BoardStance, date time.Time) Message {
+	subjects := map[BoardStance]string{
+		BoardSatisfied: "Finances back in order",
+		BoardConcerned: "Board concerned by overdraft",
+		BoardEmbargo:   "Transfer embargo imposed",
+	}
+	return Message{
+		Date:    date,
+		Kind:    BoardMessage,
+		Subject: subjects[stance],
+		Body:    "The board are " + strings.ToLower(stance.String()) + ".",
+	}
+}
+
+// RoundUp sums up the club's results over the past week and where it stands
+// in the division, or returns nil if it hasn't played
+func RoundUp(clubID int64, division *Division, results []Result, table *LeagueTable, date time.Time) *Message {
+	var lines []string
+	for _, result := range results {
+		opponent, scored, conceded, venue := result.Away, result.HomeGoals, result.AwayGoals, "at home to"
+		if result.Away.ID == clubID {
+			opponent, scored, conceded, venue = result.Home, result.AwayGoals, result.HomeGoals, "away at"
+		} else if result.Home.ID != clubID {
+			continue
+		}
+
+		outcome := "Drew"
+		switch {
+		case scored > conceded:
+			outcome = "Won"
+		case scored < conceded:
+			outcome = "Lost"
+		}
+		lines = append(lines, fmt.Sprintf("%s %d–%d %s %s", outcome, scored, conceded, venue, opponent.Name))
+	}
+	if len(lines) == 0 {
+		return nil
+	}
+
+	if table != nil && division != nil {
+		for i, position := range table.Positions {
+			if position.Club.ID == clubID {
+				lines = append(lines, "", fmt.Sprintf("You're %s in the %s with %s from %s.",
+					ordinal(i+1), division.Name, count(position.Points, "point"), count(position.Played, "match")))
+			}
+		}
+	}
+
+	return &Message{
+		Date:    date,
+		Kind:    RoundUpMessage,
+		Subject: "Week in review",
+		Body:    strings.Join(lines, "\n"),
+	}
+}
+
+// ordinal formats a league position as 1st, 2nd, 3rd, 4th...
+func ordinal(n int) string {
+	suffix := "th"
+	switch {
+	case n%100 >= 11 && n%100 <= 13:
+	case n%10 == 1:
+		suffix = "st"
+	case n%10 == 2:
+		suffix = "nd"
+	case n%10 == 3:
+		suffix = "rd"
+	}
+	return fmt.Sprintf("%d%s", n, suffix)
+}
+
+// count formats a number of things, such as "1 point" or "4 points"
+func count(n int, noun string) string {
+	if n == 1 {
+		return "1 " + noun
+	}
+	if strings.HasSuffix(noun, "ch") {
+		return fmt.Sprintf("%d %ses", n, noun)
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
+
+// Each day the window is open there's a dailyOfferChance of an AI club
+// bidding for one of the user's players, offering between its value and
+// offerPremium on top. An offer stands for offerDays before it lapses.
+const (
+	dailyOfferChance = 0.08
+	offerPremium     = 0.3
+	offerDays        = 7
+)
+
+// TransferOffers sees whether an AI club bids for one of the user's players
+// today, given the offers already waiting on an answer and the season's
+// deals so far. Clubs only bid for players who would get into their side
+// and whose value they can afford.
+func TransferOffers(user *ClubWithPlayers, clubs []*ClubWithPlayers, messages []Message, transfers []Transfer, date time.Time, rng *rand.Rand) []Message {
+	if rng.Float64() >= dailyOfferChance || len(user.Players) <= MinimumSquadSize {
+		return nil
+	}
+
+	wanted := make(map[int64]bool)
+	for _, message := range messages {
+		if message.Kind == TransferOfferMessage && message.Pending() {
+			wanted[message.PlayerID] = true
+		}
+	}
+	for _, transfer := range transfers {
+		wanted[transfer.Player.ID] = true
+	}
+
+	var available []Player
+	for _, player := range user.Players {
+		if player.LoanedFrom == 0 && !wanted[player.ID] {
+			available = append(available, player)
+		}
+	}
+	if len(available) == 0 {
+		return nil
+	}
+	player := available[rng.IntN(len(available))]
+	fee := RoundFee(int64(float64(player.Valuation(date)) * (1 + offerPremium*rng.Float64())))
+
+	var buyers []*ClubWithPlayers
+	for _, club := range ClubsInPyramid(clubs) {
+		if club.Club.ID == user.Club.ID || club.Club.TransferBudget < fee || len(club.Players) >= MaximumSquadSize || club.BoardStance() == BoardEmbargo {
+			continue
+		}
+		if averageQuality(bestPlayers(club.Players, StartingXISize)) <= float64(player.Quality) {
+			buyers = append(buyers, club)
+		}
+	}
+	if len(buyers) == 0 {
+		return nil
+	}
+	buyer := buyers[rng.IntN(len(buyers))].Club
+
+	return []Message{{
+		Date:     date,
+		Kind:     TransferOfferMessage,
+		Subject:  "Offer for " + player.Name,
+		Body:     fmt.Sprintf("%s have made an offer for %s. It stands for a week.", buyer.Name, player.Name),
+		Status:   MessagePending,
+		PlayerID: player.ID,
+		ClubID:   buyer.ID,
+		Fee:      fee,
+	}}
+}
+
+// Lapsed reports whether a transfer offer has gone unanswered too long, or
+// the transfer window has shut on it
+func (m Message) Lapsed(startYear int, today time.Time) bool {
+	if m.Kind != TransferOfferMessage || !m.Pending() {
+		return false
+	}
+	return today.Sub(m.Date) >= offerDays*24*time.Hour || OpenTransferWindow(startYear, today) == nil
+}
+
+// AcceptOffer returns the transfer a transfer offer agrees, dated the day,
+// once the seller has accepted it. It fails if the deal can't go through,
+// because the buyer can no longer afford it or a squad can't take the change.
+func AcceptOffer(offer Message, seller, buyer *ClubWithPlayers, date time.Time) (*Transfer, error) {
+	var player *Player
+	for i := range seller.Players {
+		if seller.Players[i].ID == offer.PlayerID {
+			player = &seller.Players[i]
+		}
+	}
+	if player == nil {
+		return nil, errors.New("the player is no longer at the club")
+	}
+
+	negotiation := NewNegotiation(*player, seller, buyer, date)
+	switch {
+	case negotiation.Refusal != "":
+		return nil, errors.New(negotiation.Refusal)
+	case buyer.Club.TransferBudget < offer.Fee:
+		return nil, fmt.Errorf("%s can no longer afford the fee", buyer.Club.Name)
+	}
+
+	contract := NewContract(*player, buyer.Players, date)
+	return &Transfer{
+		Player:   *player,
+		From:     seller.Club,
+		To:       buyer.Club,
+		Fee:      offer.Fee,
+		Date:     date,
+		Contract: &contract,
+	}, nil
+}
+
+// Players complain once their morale falls below complaintMorale, no more
+// than once every complaintDays. Brushing a complaint off costs the player
+// rebuffMorale.
+const (
+	complaintMorale = 20
+	complaintDays   = 28
+	rebuffMorale    = 5
+)
+
+// Complaints collects complaints from the squad's unhappiest players, given
+// the messages already in the inbox. A player who could be promised a bigger
+// role asks for one.
+func Complaints(squad *ClubWithPlayers, messages []Message, startYear int, date time.Time) []Message {
+	recent := make(map[int64]bool)
+	for _, message := range messages {
+		if message.Kind == ComplaintMessage && date.Sub(message.Date) < complaintDays*24*time.Hour {
+			recent[message.PlayerID] = true
+		}
+	}
+
+	var complaints []Message
+	for _, player := range squad.Players {
+		if player.Morale >= complaintMorale || recent[player.ID] {
+			continue
+		}
+
+		reason := "are unhappy with how little football they're getting"
+		switch {
+		case player.Contract.InFinalYear(startYear):
+			reason = "are worried about their future with their contract running out"
+		case float64(player.Contract.Wage) < underpaidShare*float64(player.MarketWage()):
+			reason = "feel they're paid well below what they're worth"
+		}
+		complaint := Message{
+			Date:     date,
+			Kind:     ComplaintMessage,
+			Subject:  player.Name + " is unhappy",
+			Body:     player.Name + " has come to see you. They " + reason + ".",
+			PlayerID: player.ID,
+		}
+		if player.Contract.Role < RoleKeyPlayer {
+			complaint.Body += " They want to be promised a bigger part in the side."
+			complaint.Status = MessagePending
+		}
+		complaints = append(complaints, complaint)
+	}
+	return complaints
+}
+
+// RebuffComplaint tells the player to earn their place, which they don't
+// take well
+func (p *Player) RebuffComplaint() {
+	p.addMorale(-rebuffMorale)
+}
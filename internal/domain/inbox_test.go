@@ -0,0 +1,132 @@
+package domain
+
+import (
+	"math/rand/v2"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestRoundUp verifies the week's results are told from the club's side and
+// a week without matches has no round-up
+func TestRoundUp(t *testing.T) {
+	us, them := &Club{ID: 1, Name: "Arsenal"}, &Club{ID: 2, Name: "Chelsea"}
+	division := &Division{Name: "Premier League"}
+	table := &LeagueTable{Positions: []LeaguePosition{
+		{Club: them, Played: 2, Points: 4},
+		{Club: us, Played: 2, Points: 3},
+	}}
+	results := []Result{
+		{Home: us, Away: them, HomeGoals: 2, AwayGoals: 1},
+		{Home: them, Away: us, HomeGoals: 3, AwayGoals: 0},
+	}
+	date := time.Date(2025, time.September, 1, 0, 0, 0, 0, time.UTC)
+
+	message := RoundUp(us.ID, division, results, table, date)
+	if message == nil {
+		t.Fatal("Expected a round-up of the week's results")
+	}
+	for _, want := range []string{"Won 2–1 at home to Chelsea", "Lost 0–3 away at Chelsea", "You're 2nd in the Premier League"} {
+		if !strings.Contains(message.Body, want) {
+			t.Errorf("Expected the round-up to say %q, got %q", want, message.Body)
+		}
+	}
+
+	if RoundUp(us.ID, division, nil, table, date) != nil {
+		t.Error("Expected no round-up for a week without matches")
+	}
+}
+
+// TestTransferOffers verifies offers only come from clubs that can afford
+// the player and would play them
+func TestTransferOffers(t *testing.T) {
+	user := testClubs(1, 20, 2, 12)[0]
+	rich := testClubs(2, 18, 2, 10)[1]
+	rich.Club.TransferBudget = 100_000_000
+	strong := testClubs(3, 18, 2, 16)[2]
+	strong.Club.TransferBudget = 100_000_000
+	poor := testClubs(4, 18, 2, 10)[3]
+	clubs := []*ClubWithPlayers{user, rich, strong, poor}
+	date := time.Date(2025, time.July, 15, 0, 0, 0, 0, time.UTC)
+
+	rng := rand.New(rand.NewPCG(1, 2))
+	var offers []Message
+	for range 500 {
+		offers = append(offers, TransferOffers(user, clubs, nil, nil, date, rng)...)
+	}
+	if len(offers) == 0 {
+		t.Fatal("Expected some offers over 500 days")
+	}
+	for _, offer := range offers {
+		if offer.ClubID != rich.Club.ID || !offer.Pending() || offer.Fee < user.Players[0].Valuation(date) {
+			t.Errorf("Expected only the rich, weaker club to bid at least the player's value, got %+v", offer)
+		}
+	}
+}
+
+// TestAcceptOffer verifies an accepted offer moves the player for the fee
+// and fails once the buyer can no longer pay it
+func TestAcceptOffer(t *testing.T) {
+	seller := testClubs(1, 20, 2, 12)[0]
+	buyer := testClubs(2, 18, 2, 10)[1]
+	buyer.Club.TransferBudget = 5_000_000
+	offer := Message{Kind: TransferOfferMessage, Status: MessagePending, PlayerID: 105, ClubID: 2, Fee: 4_000_000}
+	date := time.Date(2025, time.July, 15, 0, 0, 0, 0, time.UTC)
+
+	transfer, err := AcceptOffer(offer, seller, buyer, date)
+	if err != nil {
+		t.Fatalf("Expected the deal to go through, got %v", err)
+	}
+	if transfer.Player.ID != 105 || transfer.To != buyer.Club || transfer.Fee != offer.Fee || transfer.Contract == nil {
+		t.Errorf("Expected the player to join the buyer for the fee, got %+v", transfer)
+	}
+
+	buyer.Club.TransferBudget = 1_000_000
+	if _, err := AcceptOffer(offer, seller, buyer, date); err == nil {
+		t.Error("Expected the deal to fall through once the buyer can't afford it")
+	}
+}
+
+// TestOfferLapses verifies an offer lapses after a week or when the window
+// shuts
+func TestOfferLapses(t *testing.T) {
+	offer := Message{Kind: TransferOfferMessage, Status: MessagePending, Date: time.Date(2025, time.July, 1, 0, 0, 0, 0, time.UTC)}
+
+	if offer.Lapsed(2025, offer.Date.AddDate(0, 0, 6)) {
+		t.Error("Expected an offer to stand for a week")
+	}
+	if !offer.Lapsed(2025, offer.Date.AddDate(0, 0, 7)) {
+		t.Error("Expected an offer to lapse after a week")
+	}
+
+	late := Message{Kind: TransferOfferMessage, Status: MessagePending, Date: time.Date(2025, time.September, 1, 0, 0, 0, 0, time.UTC)}
+	if !late.Lapsed(2025, late.Date.AddDate(0, 0, 1)) {
+		t.Error("Expected an offer to lapse when the window shuts")
+	}
+}
+
+// TestComplaints verifies unhappy players complain, no more than once a
+// month, and only ask for a role they could be promised
+func TestComplaints(t *testing.T) {
+	squad := testClubs(1, 3, 0, 10)[0]
+	for i := range squad.Players {
+		squad.Players[i].Morale = NeutralMorale
+		squad.Players[i].Contract = Contract{Wage: squad.Players[i].MarketWage(), Ends: 2028, Role: RoleRotation}
+	}
+	squad.Players[1].Morale = 10
+	squad.Players[2].Morale = 10
+	squad.Players[2].Contract.Role = RoleKeyPlayer
+	date := time.Date(2025, time.October, 6, 0, 0, 0, 0, time.UTC)
+
+	complaints := Complaints(squad, nil, 2025, date)
+	if len(complaints) != 2 {
+		t.Fatalf("Expected the two unhappy players to complain, got %d", len(complaints))
+	}
+	if !complaints[0].Pending() || complaints[1].Pending() {
+		t.Errorf("Expected only the player who could be promised more to ask for it, got %+v", complaints)
+	}
+
+	if again := Complaints(squad, complaints, 2025, date.AddDate(0, 0, 7)); len(again) != 0 {
+		t.Errorf("Expected no repeat complaints within a month, got %d", len(again))
+	}
+}
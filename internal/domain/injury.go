@@ -0,0 +1,124 @@
+package domain
+
+import (
+	"fmt"
+	"math/rand/v2"
+)
+
+// InjuryType represents the kind of injury a player has picked up
+type InjuryType int
+
+const (
+	KnockInjury InjuryType = iota
+	CalfStrainInjury
+	HamstringStrainInjury
+	GroinStrainInjury
+	AnkleSprainInjury
+	KneeLigamentInjury
+	BrokenLegInjury
+)
+
+// injuryProfile describes how common an injury is and how long it keeps a player out
+type injuryProfile struct {
+	Name    string
+	Weight  int // Relative likelihood
+	MinDays int
+	MaxDays int
+}
+
+var injuryProfiles = map[InjuryType]injuryProfile{
+	KnockInjury:           {Name: "Knock", Weight: 40, MinDays: 3, MaxDays: 7},
+	CalfStrainInjury:      {Name: "Calf strain", Weight: 15, MinDays: 7, MaxDays: 21},
+	HamstringStrainInjury: {Name: "Hamstring strain", Weight: 20, MinDays: 14, MaxDays: 35},
+	GroinStrainInjury:     {Name: "Groin strain", Weight: 10, MinDays: 10, MaxDays: 28},
+	AnkleSprainInjury:     {Name: "Ankle sprain", Weight: 10, MinDays: 14, MaxDays: 42},
+	KneeLigamentInjury:    {Name: "Knee ligament damage", Weight: 4, MinDays: 60, MaxDays: 180},
+	BrokenLegInjury:       {Name: "Broken leg", Weight: 1, MinDays: 120, MaxDays: 240},
+}
+
+func (t InjuryType) String() string {
+	if profile, ok := injuryProfiles[t]; ok {
+		return profile.Name
+	}
+	return fmt.Sprintf("Injury %d", int(t))
+}
+
+// Injury keeps a player out of selection until it has healed
+type Injury struct {
+	PlayerID      int64
+	PlayerName    string
+	Type          InjuryType
+	DaysOut       int // Expected time out when the injury occurred
+	DaysRemaining int
+}
+
+// NewRandomInjury rolls the type and length of a fresh injury.
+// Fatigued players (low stamina) tend to suffer longer layoffs.
+func NewRandomInjury(player *Player, stamina float64) *Injury {
+	totalWeight := 0
+	for t := KnockInjury; t <= BrokenLegInjury; t++ {
+		totalWeight += injuryProfiles[t].Weight
+	}
+
+	injuryType := KnockInjury
+	roll := rand.IntN(totalWeight)
+	for t := KnockInjury; t <= BrokenLegInjury; t++ {
+		roll -= injuryProfiles[t].Weight
+		if roll < 0 {
+			injuryType = t
+			break
+		}
+	}
+
+	profile := injuryProfiles[injuryType]
+	days := profile.MinDays + rand.IntN(profile.MaxDays-profile.MinDays+1)
+	days = int(float64(days) * (1 + fatigue(stamina)/2))
+
+	return &Injury{
+		PlayerID:      player.ID,
+		PlayerName:    player.Name,
+		Type:          injuryType,
+		DaysOut:       days,
+		DaysRemaining: days,
+	}
+}
+
+// InjuryChance returns the probability that a player picks up an injury in a
+// challenge, rising as they tire and doubling if the challenge was a foul
+func InjuryChance(baseChance float64, stamina float64, fouled bool) float64 {
+	chance := baseChance * (1 + fatigue(stamina)*2)
+	if fouled {
+		chance *= 2
+	}
+	return chance
+}
+
+// fatigue converts stamina (0-100) into a 0-1 tiredness factor
+func fatigue(stamina float64) float64 {
+	return max(0, min(1, (100-stamina)/100))
+}
+
+// InjurePlayer records an injury to a fielded player and replaces them with
+// the best available substitute, leaving the team a player short if the bench
+// is empty
+func (m *Match) InjurePlayer(participant *MatchParticipant, player *MatchPlayerParticipant) {
+	player.Injury = NewRandomInjury(player.Player, player.Stamina)
+	m.AddEvent(NewEvent(InjuryEvent, m.CurrentMinute, participant, player))
+
+	if replacement := participant.BestSubstitute(); replacement != nil {
+		participant.MakeSubstitution(replacement, player)
+	} else {
+		participant.RemoveFromPitch(player)
+	}
+}
+
+// MatchInjuries returns the injuries picked up during the match
+func (m *Match) MatchInjuries() []*Injury {
+	injuries := make([]*Injury, 0)
+	for _, event := range m.Events {
+		if event.Type == InjuryEvent && event.Player != nil && event.Player.Injury != nil {
+			injuries = append(injuries, event.Player.Injury)
+		}
+	}
+	return injuries
+}
@@ -0,0 +1,75 @@
+package domain
+
+import "testing"
+
+// TestInjuryChanceRisesWithFatigue verifies tired and fouled players are more likely to be injured
+func TestInjuryChanceRisesWithFatigue(t *testing.T) {
+	fresh := InjuryChance(0.01, 100, false)
+	tired := InjuryChance(0.01, 40, false)
+	fouled := InjuryChance(0.01, 100, true)
+
+	if fresh != 0.01 {
+		t.Errorf("Expected base chance for a fresh player, got %.4f", fresh)
+	}
+	if tired <= fresh {
+		t.Errorf("Expected tired player (%.4f) to be more injury prone than fresh player (%.4f)", tired, fresh)
+	}
+	if fouled != fresh*2 {
+		t.Errorf("Expected a foul to double injury chance, got %.4f", fouled)
+	}
+}
+
+// TestInjurePlayerBringsOnSubstitute verifies an injured player is replaced and ruled out
+func TestInjurePlayerBringsOnSubstitute(t *testing.T) {
+	club := testClubs(1, 13, 2, 10)[0]
+	for i := range club.Players {
+		club.Players[i].Quality += i % 5
+	}
+	match := NewMatchFromFixture(&Fixture{HomeTeam: club, AwayTeam: club})
+
+	injured := match.Home.CurrentXI[3]
+	best := match.Home.BestSubstitute()
+	match.InjurePlayer(match.Home, injured)
+
+	if match.Home.CurrentXI[3] != best {
+		t.Errorf("Expected best bench player to replace the injured player")
+	}
+	if best.Position == "" {
+		t.Errorf("Expected substitute to take the injured player's position")
+	}
+
+	injuries := match.MatchInjuries()
+	if len(injuries) != 1 || injuries[0].PlayerID != injured.Player.ID {
+		t.Fatalf("Expected one injury for player %d, got %+v", injured.Player.ID, injuries)
+	}
+	if injuries[0].DaysOut <= 0 || injuries[0].DaysRemaining != injuries[0].DaysOut {
+		t.Errorf("Expected a positive layoff, got %d days (%d remaining)", injuries[0].DaysOut, injuries[0].DaysRemaining)
+	}
+}
+
+// TestInjuredPlayerCannotComeOn verifies a player injured and taken off
+// isn't offered as a substitute and can't be brought back on
+func TestInjuredPlayerCannotComeOn(t *testing.T) {
+	club := testClubs(1, 13, 2, 10)[0]
+	for i := range club.Players {
+		club.Players[i].Quality += i % 5
+	}
+	match := NewMatchFromFixture(&Fixture{HomeTeam: club, AwayTeam: club})
+
+	injured := match.Home.CurrentXI[3]
+	match.InjurePlayer(match.Home, injured)
+	for _, player := range match.Home.AvailableSubstitutes() {
+		if player == injured {
+			t.Fatal("Expected the injured player to be left out of the substitutes")
+		}
+	}
+
+	starter := match.Home.CurrentXI[5]
+	match.Home.MakeSubstitution(injured, starter)
+	if match.Home.CurrentXI[5] != starter {
+		t.Error("Expected the injured player not to come back on")
+	}
+	if injuries := match.MatchInjuries(); len(injuries) != 1 {
+		t.Errorf("Expected the injury to be recorded once, got %d", len(injuries))
+	}
+}
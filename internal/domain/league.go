@@ -1,5 +1,80 @@
 package domain
 
+import "sort"
+
+// FormGuideLength is how many recent results the form guide shows
+const FormGuideLength = 5
+
+// Venue selects which matches count towards a table
+type Venue int
+
+const (
+	AllVenues Venue = iota
+	HomeVenue
+	AwayVenue
+)
+
+// Venues lists every venue a table can be split by
+var Venues = []Venue{AllVenues, HomeVenue, AwayVenue}
+
+// Next returns the venue after this one, wrapping around
+func (v Venue) Next() Venue {
+	return Venues[(int(v)+1)%len(Venues)]
+}
+
+func (v Venue) String() string {
+	switch v {
+	case HomeVenue:
+		return "Home"
+	case AwayVenue:
+		return "Away"
+	default:
+		return "Overall"
+	}
+}
+
+// Outcome is a single result from one club's point of view
+type Outcome int
+
+const (
+	Win Outcome = iota
+	Draw
+	Loss
+)
+
+func (o Outcome) String() string {
+	switch o {
+	case Win:
+		return "W"
+	case Draw:
+		return "D"
+	default:
+		return "L"
+	}
+}
+
+// LeagueZone marks the parts of the table that qualify for Europe, go up or go down
+type LeagueZone int
+
+const (
+	NoZone LeagueZone = iota
+	ChampionsLeagueZone
+	EuropaLeagueZone
+	PromotionZone
+	PromotionPlayOffZone
+	RelegationZone
+	KnockoutStageZone
+)
+
+// Result is the final score of a played fixture
+type Result struct {
+	Gameweek  int
+	Home      *Club
+	Away      *Club
+	HomeGoals int
+	AwayGoals int
+}
+
 type LeaguePosition struct {
 	Club           *Club
 	Played         int
@@ -9,30 +84,168 @@ type LeaguePosition struct {
 	GoalsFor       int
 	GoalsAgainst   int
 	GoalDifference int
+	AwayGoalsFor   int
+	Deducted       int // Points deducted, already taken off Points
 	Points         int
+	Form           []Outcome // Last FormGuideLength results, oldest first
 }
 
 type LeagueTable struct {
+	Rules     *CompetitionRules
 	Positions []LeaguePosition
+	PlayOffs  []PlayOff // Ties that would need a play-off if the season ended now
 }
 
-type ByLeagueStanding []LeaguePosition
+// NewLeagueTable builds the standings from played results under the
+// competition's rules, counting only the matches each club played at the
+// given venue. Points deductions and play-offs only apply to the overall table.
+func NewLeagueTable(rules *CompetitionRules, clubs []*Club, results []Result, venue Venue) *LeagueTable {
+	// Play results in gameweek order so the form guide ends with the latest
+	ordered := make([]Result, len(results))
+	copy(ordered, results)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].Gameweek < ordered[j].Gameweek
+	})
 
-func (s ByLeagueStanding) Len() int      { return len(s) }
-func (s ByLeagueStanding) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
-func (s ByLeagueStanding) Less(i, j int) bool {
-	// Points (descending - more points = better)
-	if s[i].Points != s[j].Points {
-		return s[i].Points > s[j].Points
+	positions := rules.tally(clubs, ordered, venue)
+	if venue == AllVenues {
+		for i := range positions {
+			positions[i].Deducted = rules.Deductions[positions[i].Club.ID]
+			positions[i].Points -= positions[i].Deducted
+		}
 	}
-	// Goal difference (descending)
-	if s[i].GoalDifference != s[j].GoalDifference {
-		return s[i].GoalDifference > s[j].GoalDifference
+
+	table := &LeagueTable{
+		Rules:     rules,
+		Positions: positions,
+	}
+
+	unresolved := rules.rank(positions, ordered, venue)
+	if venue == AllVenues {
+		table.PlayOffs = table.playOffsFor(unresolved)
 	}
-	// Goals scored (descending)
-	if s[i].GoalsFor != s[j].GoalsFor {
-		return s[i].GoalsFor > s[j].GoalsFor
+
+	return table
+}
+
+// playOffsFor picks out the unbroken ties that decide the title or relegation
+func (t *LeagueTable) playOffsFor(ties [][]LeaguePosition) []PlayOff {
+	relegationLine := len(t.Positions) - t.Rules.RelegationPlaces
+
+	var playOffs []PlayOff
+	for _, tie := range ties {
+		// Nothing to settle before a ball has been kicked
+		if tie[0].Played == 0 {
+			continue
+		}
+
+		first, last := t.indexOf(tie[0].Club), t.indexOf(tie[len(tie)-1].Club)
+		clubs := make([]*Club, len(tie))
+		for i, position := range tie {
+			clubs[i] = position.Club
+		}
+
+		switch {
+		case t.Rules.TitlePlayOff && first == 0:
+			playOffs = append(playOffs, PlayOff{Reason: "Title", Clubs: clubs})
+		case t.Rules.RelegationPlayOff && first < relegationLine && last >= relegationLine:
+			playOffs = append(playOffs, PlayOff{Reason: "Relegation", Clubs: clubs})
+		}
+	}
+
+	return playOffs
+}
+
+// SettlePlayOffs plays off each tie on the title or relegation line and
+// reorders the table on the results. Each club works its way up the tie past
+// every club it beats in a one-off decider, so two clubs need only the one
+// match.
+func (t *LeagueTable) SettlePlayOffs(squads []*ClubWithPlayers, play DeciderPlayer) {
+	squadByClub := make(map[int64]*ClubWithPlayers, len(squads))
+	for _, squad := range squads {
+		squadByClub[squad.Club.ID] = squad
+	}
+
+	for i := range t.PlayOffs {
+		playOff := &t.PlayOffs[i]
+		first := t.indexOf(playOff.Clubs[0])
+		tie := t.Positions[first : first+len(playOff.Clubs)]
+
+		for j := 1; j < len(tie); j++ {
+			for k := j; k > 0; k-- {
+				higher, lower := squadByClub[tie[k-1].Club.ID], squadByClub[tie[k].Club.ID]
+				if higher == nil || lower == nil {
+					break
+				}
+				match := play(higher, lower)
+				match.Round = playOff.Reason
+				playOff.Matches = append(playOff.Matches, match)
+				if match.Winner == nil || match.Winner.ID != lower.Club.ID {
+					break
+				}
+				tie[k-1], tie[k] = tie[k], tie[k-1]
+			}
+		}
+
+		for j, position := range tie {
+			playOff.Clubs[j] = position.Club
+		}
+	}
+}
+
+// indexOf returns the club's 0-based table position, or -1 if it isn't in the table
+func (t *LeagueTable) indexOf(club *Club) int {
+	for i, position := range t.Positions {
+		if position.Club.ID == club.ID {
+			return i
+		}
+	}
+	return -1
+}
+
+// addResult records one match from the club's point of view
+func (p *LeaguePosition) addResult(rules *CompetitionRules, scored, conceded int) {
+	p.Played++
+	p.GoalsFor += scored
+	p.GoalsAgainst += conceded
+
+	var outcome Outcome
+	switch {
+	case scored > conceded:
+		p.Won++
+		outcome = Win
+	case scored == conceded:
+		p.Drawn++
+		outcome = Draw
+	default:
+		p.Lost++
+		outcome = Loss
+	}
+
+	p.Points += rules.PointsFor(outcome)
+
+	p.Form = append(p.Form, outcome)
+	if len(p.Form) > FormGuideLength {
+		p.Form = p.Form[1:]
+	}
+}
+
+// Zone returns the zone for a 0-based table position
+func (t *LeagueTable) Zone(index int) LeagueZone {
+	switch {
+	case index < t.Rules.ChampionsLeaguePlaces:
+		return ChampionsLeagueZone
+	case index < t.Rules.ChampionsLeaguePlaces+t.Rules.EuropaLeaguePlaces:
+		return EuropaLeagueZone
+	case index < t.Rules.KnockoutStagePlaces:
+		return KnockoutStageZone
+	case index < t.Rules.PromotionPlaces:
+		return PromotionZone
+	case index < t.Rules.PromotionPlaces+t.Rules.PromotionPlayOffPlaces:
+		return PromotionPlayOffZone
+	case index >= len(t.Positions)-t.Rules.RelegationPlaces:
+		return RelegationZone
+	default:
+		return NoZone
 	}
-	// Alphabetical (ascending - for deterministic ordering)
-	return s[i].Club.Name < s[j].Club.Name
 }
@@ -0,0 +1,96 @@
+package domain
+
+import "testing"
+
+// TestNewLeagueTable verifies standings, venue splits and the form guide
+func TestNewLeagueTable(t *testing.T) {
+	arsenal := &Club{ID: 1, Name: "Arsenal"}
+	chelsea := &Club{ID: 2, Name: "Chelsea"}
+	spurs := &Club{ID: 3, Name: "Spurs"}
+	clubs := []*Club{arsenal, chelsea, spurs}
+
+	results := []Result{
+		{Gameweek: 3, Home: chelsea, Away: arsenal, HomeGoals: 2, AwayGoals: 2},
+		{Gameweek: 1, Home: arsenal, Away: chelsea, HomeGoals: 3, AwayGoals: 0},
+		{Gameweek: 2, Home: spurs, Away: arsenal, HomeGoals: 1, AwayGoals: 0},
+	}
+
+	overall := NewLeagueTable(PremierLeagueRules(), clubs, results, AllVenues)
+	leader := overall.Positions[0]
+	if leader.Club != arsenal || leader.Points != 4 || leader.Played != 3 || leader.GoalDifference != 2 {
+		t.Errorf("Expected Arsenal top on 4 points (+2), got %s on %d (%+d)", leader.Club.Name, leader.Points, leader.GoalDifference)
+	}
+	wantForm := []Outcome{Win, Loss, Draw}
+	for i, outcome := range wantForm {
+		if leader.Form[i] != outcome {
+			t.Errorf("Expected form %v, got %v", wantForm, leader.Form)
+			break
+		}
+	}
+
+	home := NewLeagueTable(PremierLeagueRules(), clubs, results, HomeVenue)
+	for _, position := range home.Positions {
+		if position.Club == arsenal && (position.Played != 1 || position.Points != 3) {
+			t.Errorf("Expected Arsenal to have 3 points from 1 home match, got %d from %d", position.Points, position.Played)
+		}
+	}
+
+	away := NewLeagueTable(PremierLeagueRules(), clubs, results, AwayVenue)
+	for _, position := range away.Positions {
+		if position.Club == arsenal && (position.Played != 2 || position.Points != 1) {
+			t.Errorf("Expected Arsenal to have 1 point from 2 away matches, got %d from %d", position.Points, position.Played)
+		}
+	}
+}
+
+// TestFormGuideLength verifies only the most recent results are kept
+func TestFormGuideLength(t *testing.T) {
+	arsenal := &Club{ID: 1, Name: "Arsenal"}
+	chelsea := &Club{ID: 2, Name: "Chelsea"}
+
+	results := make([]Result, 0, FormGuideLength+2)
+	for gw := 1; gw <= FormGuideLength+2; gw++ {
+		result := Result{Gameweek: gw, Home: arsenal, Away: chelsea, HomeGoals: 1}
+		if gw <= 2 {
+			result.HomeGoals = 0 // Early draws drop out of the guide
+		}
+		results = append(results, result)
+	}
+
+	table := NewLeagueTable(PremierLeagueRules(), []*Club{arsenal, chelsea}, results, AllVenues)
+	form := table.Positions[0].Form
+	if len(form) != FormGuideLength {
+		t.Fatalf("Expected %d results in the form guide, got %d", FormGuideLength, len(form))
+	}
+	for _, outcome := range form {
+		if outcome != Win {
+			t.Errorf("Expected only recent wins in the form guide, got %v", form)
+			break
+		}
+	}
+}
+
+// TestLeagueZones verifies European places and the relegation zone
+func TestLeagueZones(t *testing.T) {
+	table := &LeagueTable{Rules: PremierLeagueRules(), Positions: make([]LeaguePosition, 20)}
+
+	tests := []struct {
+		index int
+		want  LeagueZone
+	}{
+		{0, ChampionsLeagueZone},
+		{3, ChampionsLeagueZone},
+		{4, EuropaLeagueZone},
+		{5, EuropaLeagueZone},
+		{6, NoZone},
+		{16, NoZone},
+		{17, RelegationZone},
+		{19, RelegationZone},
+	}
+
+	for _, tt := range tests {
+		if got := table.Zone(tt.index); got != tt.want {
+			t.Errorf("Zone(%d) = %d, want %d", tt.index, got, tt.want)
+		}
+	}
+}
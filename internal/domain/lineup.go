@@ -0,0 +1,192 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Squad sizes for a matchday
+const (
+	StartingXISize = 11
+	BenchSize      = 7
+)
+
+// Lineup is a manager's team selection for a match.
+// Slots hold player IDs, with 0 marking an empty slot.
+type Lineup struct {
+	Formation       Formation
+	Starters        []int64 // One per formation slot, goalkeeper first
+	Bench           []int64
+	CaptainID       int64
+	SetPieceTakerID int64 // Takes the side's first shootout kick
+}
+
+// NewLineup creates an empty lineup for the given formation
+func NewLineup(formation Formation) *Lineup {
+	return &Lineup{
+		Formation: formation,
+		Starters:  make([]int64, StartingXISize),
+		Bench:     make([]int64, BenchSize),
+	}
+}
+
+// SlotCount returns the number of slots, starters followed by the bench
+func (l *Lineup) SlotCount() int {
+	return len(l.Starters) + len(l.Bench)
+}
+
+// PlayerAt returns the player ID in a slot (0-10 starters, then the bench)
+func (l *Lineup) PlayerAt(slot int) int64 {
+	if slot < len(l.Starters) {
+		return l.Starters[slot]
+	}
+	return l.Bench[slot-len(l.Starters)]
+}
+
+// SetPlayerAt puts a player into a slot
+func (l *Lineup) SetPlayerAt(slot int, playerID int64) {
+	if slot < len(l.Starters) {
+		l.Starters[slot] = playerID
+		return
+	}
+	l.Bench[slot-len(l.Starters)] = playerID
+}
+
+// Swap exchanges the players in two slots
+func (l *Lineup) Swap(a, b int) {
+	playerA, playerB := l.PlayerAt(a), l.PlayerAt(b)
+	l.SetPlayerAt(a, playerB)
+	l.SetPlayerAt(b, playerA)
+}
+
+// IsStarter reports whether the player is in the starting XI
+func (l *Lineup) IsStarter(playerID int64) bool {
+	for _, id := range l.Starters {
+		if id != 0 && id == playerID {
+			return true
+		}
+	}
+	return false
+}
+
+// Contains reports whether the player is in the starting XI or on the bench
+func (l *Lineup) Contains(playerID int64) bool {
+	for slot := 0; slot < l.SlotCount(); slot++ {
+		if id := l.PlayerAt(slot); id != 0 && id == playerID {
+			return true
+		}
+	}
+	return false
+}
+
+// DropUnavailable empties any slot whose player has left the squad or can't
+// be selected, so a saved lineup can be reused as next match's default
+func (l *Lineup) DropUnavailable(players []Player) {
+	squad := squadByID(players)
+	for slot := 0; slot < l.SlotCount(); slot++ {
+		player, ok := squad[l.PlayerAt(slot)]
+		if !ok || !player.IsAvailable() {
+			l.SetPlayerAt(slot, 0)
+		}
+	}
+
+	if !l.IsStarter(l.CaptainID) {
+		l.CaptainID = 0
+	}
+	if !l.IsStarter(l.SetPieceTakerID) {
+		l.SetPieceTakerID = 0
+	}
+}
+
+// Validate checks the lineup can be fielded from the squad, returning every problem found
+func (l *Lineup) Validate(players []Player) error {
+	var problems []error
+	squad := squadByID(players)
+	slots := l.Formation.Slots()
+
+	if len(l.Starters) != len(slots) {
+		problems = append(problems, fmt.Errorf("%s needs %d starters", l.Formation, len(slots)))
+	}
+	if len(l.Bench) > BenchSize {
+		problems = append(problems, fmt.Errorf("no more than %d substitutes allowed", BenchSize))
+	}
+
+	seen := make(map[int64]bool)
+	goalkeepers := 0
+	for slot := 0; slot < l.SlotCount(); slot++ {
+		playerID := l.PlayerAt(slot)
+		isStarter := slot < len(l.Starters)
+
+		if playerID == 0 {
+			if isStarter && slot < len(slots) {
+				problems = append(problems, fmt.Errorf("%s slot is empty", slots[slot]))
+			}
+			continue
+		}
+
+		player, ok := squad[playerID]
+		if !ok {
+			problems = append(problems, fmt.Errorf("player %d is not in the squad", playerID))
+			continue
+		}
+		if seen[playerID] {
+			problems = append(problems, fmt.Errorf("%s is selected twice", player.Name))
+		}
+		seen[playerID] = true
+
+		if player.Injury != nil {
+			problems = append(problems, fmt.Errorf("%s is injured", player.Name))
+		}
+		if player.SuspendedMatches > 0 {
+			problems = append(problems, fmt.Errorf("%s is suspended", player.Name))
+		}
+
+		if isStarter && player.Position == "GK" {
+			goalkeepers++
+			if slot != 0 {
+				problems = append(problems, fmt.Errorf("%s must start in goal", player.Name))
+			}
+		}
+	}
+
+	if goalkeepers != 1 {
+		problems = append(problems, errors.New("the starting XI needs exactly one goalkeeper"))
+	}
+	if l.CaptainID != 0 && !l.IsStarter(l.CaptainID) {
+		problems = append(problems, errors.New("the captain must be in the starting XI"))
+	}
+	if l.SetPieceTakerID != 0 && !l.IsStarter(l.SetPieceTakerID) {
+		problems = append(problems, errors.New("the set-piece taker must be in the starting XI"))
+	}
+
+	return errors.Join(problems...)
+}
+
+// bestStarter returns the highest quality player in the starting XI,
+// optionally ignoring goalkeepers
+func (l *Lineup) bestStarter(players []Player, outfieldOnly bool) int64 {
+	squad := squadByID(players)
+	var best *Player
+	for _, id := range l.Starters {
+		player, ok := squad[id]
+		if !ok || (outfieldOnly && player.Position == "GK") {
+			continue
+		}
+		if best == nil || player.Quality > best.Quality {
+			best = player
+		}
+	}
+	if best == nil {
+		return 0
+	}
+	return best.ID
+}
+
+// squadByID indexes a squad's players by ID
+func squadByID(players []Player) map[int64]*Player {
+	squad := make(map[int64]*Player, len(players))
+	for i := range players {
+		squad[players[i].ID] = &players[i]
+	}
+	return squad
+}
@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestLineupValidate verifies each selection rule is reported
+func TestLineupValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		edit    func(l *Lineup, players []Player)
+		wantErr string // "" = valid
+	}{
+		{"default", func(l *Lineup, players []Player) {}, ""},
+		{"empty slot", func(l *Lineup, players []Player) { l.Starters[4] = 0 }, "slot is empty"},
+		{"duplicate", func(l *Lineup, players []Player) { l.Bench[0] = l.Starters[3] }, "selected twice"},
+		{"injured", func(l *Lineup, players []Player) { players[2].Injury = &Injury{DaysRemaining: 7} }, "is injured"},
+		{"suspended", func(l *Lineup, players []Player) { players[2].SuspendedMatches = 1 }, "is suspended"},
+		{"no keeper", func(l *Lineup, players []Player) { l.Swap(0, StartingXISize) }, "exactly one goalkeeper"},
+		{"keeper outfield", func(l *Lineup, players []Player) { l.Swap(0, 5) }, "must start in goal"},
+		{"captain benched", func(l *Lineup, players []Player) { l.CaptainID = l.Bench[0] }, "captain must be in the starting XI"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			players := testClubs(1, 18, 1, 10)[0].Players
+			lineup := AutoPickLineup(FourThreeThree, players)
+			tt.edit(lineup, players)
+
+			err := lineup.Validate(players)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Expected valid lineup, got %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+// TestParticipantFromLineup verifies the match participant follows the chosen slots
+func TestParticipantFromLineup(t *testing.T) {
+	players := testClubs(1, 18, 1, 10)[0].Players
+	lineup := AutoPickLineup(FourFourTwo, players)
+	lineup.Swap(1, StartingXISize)
+
+	participant := NewMatchParticipantFromLineup(&Club{ID: 1, Name: "Arsenal"}, players, lineup)
+
+	if participant.Formation != "4-4-2" {
+		t.Errorf("Expected 4-4-2, got %s", participant.Formation)
+	}
+	if participant.CurrentXI[1].Player.ID != lineup.Starters[1] || participant.CurrentXI[1].Position != "RB" {
+		t.Errorf("Expected player %d at RB, got %d at %s", lineup.Starters[1], participant.CurrentXI[1].Player.ID, participant.CurrentXI[1].Position)
+	}
+	if len(participant.Bench) != BenchSize {
+		t.Errorf("Expected %d substitutes, got %d", BenchSize, len(participant.Bench))
+	}
+	if participant.Captain == nil || participant.Captain.Player.ID != lineup.CaptainID {
+		t.Errorf("Expected captain %d to be set", lineup.CaptainID)
+	}
+}
@@ -0,0 +1,269 @@
+package domain
+
+import (
+	"math/rand/v2"
+	"sort"
+	"time"
+)
+
+// Each day a window is open, every AI club has a chance of looking to sign
+// a player and a chance of sending a youngster out on loan
+const (
+	dailySigningChance = 0.05
+	dailyLoanChance    = 0.03
+)
+
+// loanAge is the oldest a player can be and still be sent out on loan to
+// get games
+const loanAge = 21
+
+// lineQuotas is how many players a squad wants in each line of the pitch:
+// two keepers, and enough outfielders to cover injuries and bans
+var lineQuotas = []int{2, 5, 5, 4}
+
+// lineStarters is how many players from each line usually start a match
+var lineStarters = []int{1, 4, 3, 3}
+
+// SquadNeed is a line of the pitch where a squad is short of players or of
+// quality. A signing has to be at least MinQuality to help.
+type SquadNeed struct {
+	Line       int // 0 for goalkeepers, then defence, midfield and attack
+	MinQuality int
+}
+
+// SquadNeeds works out where a squad most needs strengthening, most urgent
+// first. A line is short when it has too few players to cover for injuries
+// and bans, and weak when its regular starters lag behind the rest of the
+// side.
+func SquadNeeds(players []Player) []SquadNeed {
+	level := averageQuality(bestPlayers(players, 11))
+
+	lines := make([][]Player, len(lineQuotas))
+	for _, player := range players {
+		if line, ok := positionLines[player.Position]; ok {
+			lines[line] = append(lines[line], player)
+		}
+	}
+
+	var short []SquadNeed
+	type weakLine struct {
+		need SquadNeed
+		gap  float64
+	}
+	var weak []weakLine
+	for line, members := range lines {
+		if len(members) < lineQuotas[line] {
+			short = append(short, SquadNeed{Line: line, MinQuality: max(int(level)-3, 1)})
+			continue
+		}
+		starters := bestPlayers(members, lineStarters[line])
+		weakest := starters[len(starters)-1].Quality
+		if gap := level - float64(weakest); gap >= 1.5 {
+			weak = append(weak, weakLine{SquadNeed{Line: line, MinQuality: weakest + 1}, gap})
+		}
+	}
+
+	sort.SliceStable(weak, func(i, j int) bool {
+		return weak[i].gap > weak[j].gap
+	})
+	needs := short
+	for _, line := range weak {
+		needs = append(needs, line.need)
+	}
+	return needs
+}
+
+// bestPlayers returns up to n of the players, best first
+func bestPlayers(players []Player, n int) []Player {
+	ranked := make([]Player, len(players))
+	copy(ranked, players)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].Quality > ranked[j].Quality
+	})
+	return ranked[:min(n, len(ranked))]
+}
+
+// averageQuality returns the players' mean quality, or 0 for nobody
+func averageQuality(players []Player) float64 {
+	if len(players) == 0 {
+		return 0
+	}
+	total := 0
+	for _, player := range players {
+		total += player.Quality
+	}
+	return float64(total) / float64(len(players))
+}
+
+// MarketDay plays out a day of business between the AI clubs in the pyramid
+// while the transfer window is open, given the season's deals so far. Clubs
+// buy to fill their squad's most pressing need within their transfer budget,
+// and loan youngsters who aren't getting games to weaker clubs short
+// in their position. Nobody who has already moved this season moves again,
+// and the user's club is left alone. Squads are updated as deals go through,
+// and the day's deals returned.
+func MarketDay(clubs []*ClubWithPlayers, transfers []Transfer, userClubID int64, date time.Time, rng *rand.Rand) []*Transfer {
+	pyramid := ClubsInPyramid(clubs)
+
+	budgets := make(map[int64]int64, len(clubs))
+	for _, club := range clubs {
+		budgets[club.Club.ID] = club.Club.TransferBudget
+	}
+	moved := make(map[int64]bool, len(transfers))
+	for _, transfer := range transfers {
+		moved[transfer.Player.ID] = true
+	}
+
+	var deals []*Transfer
+	for _, i := range rng.Perm(len(pyramid)) {
+		club := pyramid[i]
+		if club.Club.ID == userClubID {
+			continue
+		}
+		if rng.Float64() < dailySigningChance {
+			if deal := signPlayer(club, clubs, budgets, moved, userClubID, date, rng); deal != nil {
+				deals = append(deals, deal)
+				moved[deal.Player.ID] = true
+			}
+		}
+		if rng.Float64() < dailyLoanChance {
+			if deal := loanPlayer(club, pyramid, moved, userClubID, date, rng); deal != nil {
+				deals = append(deals, deal)
+				moved[deal.Player.ID] = true
+			}
+		}
+	}
+	return deals
+}
+
+// signPlayer looks for an affordable player who fills the buyer's most
+// pressing need, favouring the cheapest few. Sellers only part with players
+// they have cover for. The buyer opens with the player's value and meets the
+// seller's counter-offer if it can.
+func signPlayer(buyer *ClubWithPlayers, clubs []*ClubWithPlayers, budgets map[int64]int64, moved map[int64]bool, userClubID int64, date time.Time, rng *rand.Rand) *Transfer {
+	needs := SquadNeeds(buyer.Players)
+	if len(needs) == 0 {
+		return nil
+	}
+	need := needs[0]
+	budget := budgets[buyer.Club.ID]
+
+	type candidate struct {
+		entry  MarketEntry
+		asking int64
+	}
+	var candidates []candidate
+	for _, seller := range clubs {
+		if seller == buyer || seller.Club.ID == userClubID {
+			continue
+		}
+		if lineSize(seller.Players, need.Line) <= lineQuotas[need.Line] {
+			continue
+		}
+		for _, player := range seller.Players {
+			if positionLines[player.Position] != need.Line || player.Quality < need.MinQuality || player.LoanedFrom != 0 || moved[player.ID] {
+				continue
+			}
+			if asking := AskingPrice(player, seller.Players, date); asking <= budget {
+				candidates = append(candidates, candidate{MarketEntry{Player: player, Club: seller}, asking})
+			}
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].asking < candidates[j].asking
+	})
+	entry := candidates[rng.IntN(min(3, len(candidates)))].entry
+
+	negotiation := NewNegotiation(entry.Player, entry.Club, buyer, date)
+	if negotiation.Bid(min(entry.Player.Valuation(date), budget)) == BidCountered && negotiation.Counter <= budget {
+		negotiation.Bid(negotiation.Counter)
+	}
+	if negotiation.Status != NegotiationAgreed {
+		return nil
+	}
+
+	transfer := negotiation.Transfer(date)
+	moveBetweenSquads(transfer, entry.Club, buyer)
+	budgets[buyer.Club.ID] -= transfer.Fee
+	budgets[entry.Club.Club.ID] += transfer.Fee / 2
+	return transfer
+}
+
+// loanPlayer sends one of the club's youngsters outside its best fourteen out
+// on loan to a weaker club that's short in the youngster's line
+func loanPlayer(parent *ClubWithPlayers, pyramid []*ClubWithPlayers, moved map[int64]bool, userClubID int64, date time.Time, rng *rand.Rand) *Transfer {
+	if len(parent.Players) <= MinimumSquadSize+1 {
+		return nil
+	}
+
+	regulars := make(map[int64]bool)
+	for _, player := range bestPlayers(parent.Players, 14) {
+		regulars[player.ID] = true
+	}
+	var youngsters []Player
+	for _, player := range parent.Players {
+		if age := player.AgeOn(date); age > 0 && age <= loanAge && player.Position != "GK" && !regulars[player.ID] && !moved[player.ID] {
+			youngsters = append(youngsters, player)
+		}
+	}
+	if len(youngsters) == 0 {
+		return nil
+	}
+	player := youngsters[rng.IntN(len(youngsters))]
+
+	var borrowers []*ClubWithPlayers
+	for _, club := range pyramid {
+		if club == parent || club.Club.ID == userClubID || club.Club.Strength >= parent.Club.Strength || len(club.Players) >= MaximumSquadSize {
+			continue
+		}
+		for _, need := range SquadNeeds(club.Players) {
+			if need.Line == positionLines[player.Position] && player.Quality >= need.MinQuality-2 {
+				borrowers = append(borrowers, club)
+				break
+			}
+		}
+	}
+	if len(borrowers) == 0 {
+		return nil
+	}
+	borrower := borrowers[rng.IntN(len(borrowers))]
+
+	transfer := &Transfer{Player: player, From: parent.Club, To: borrower.Club, Date: date, Loan: true}
+	moveBetweenSquads(transfer, parent, borrower)
+	return transfer
+}
+
+// lineSize counts the players in a line of the pitch
+func lineSize(players []Player, line int) int {
+	size := 0
+	for _, player := range players {
+		if positionLines[player.Position] == line {
+			size++
+		}
+	}
+	return size
+}
+
+// moveBetweenSquads takes the transferred player out of one squad and into
+// the other
+func moveBetweenSquads(transfer *Transfer, from, to *ClubWithPlayers) {
+	for i, player := range from.Players {
+		if player.ID == transfer.Player.ID {
+			from.Players = append(from.Players[:i:i], from.Players[i+1:]...)
+			break
+		}
+	}
+
+	player := transfer.Player
+	if transfer.Loan {
+		player.LoanedFrom = from.Club.ID
+	}
+	if transfer.Contract != nil {
+		player.Contract = *transfer.Contract
+	}
+	to.Players = append(to.Players, player)
+}
@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"math/rand/v2"
+	"testing"
+	"time"
+)
+
+// TestSquadNeeds verifies squads want players where they're short of
+// numbers first, then where their starters are weakest
+func TestSquadNeeds(t *testing.T) {
+	club := testClubs(1, 16, 2, 12)[0]
+	if needs := SquadNeeds(club.Players); len(needs) != 0 {
+		t.Errorf("Expected a balanced squad to need nobody, got %+v", needs)
+	}
+
+	// Lose a keeper and let the strikers fall behind
+	club.Players = club.Players[1:]
+	for i := range club.Players {
+		if club.Players[i].Position == "ST" {
+			club.Players[i].Quality = 8
+		}
+	}
+
+	needs := SquadNeeds(club.Players)
+	if len(needs) != 2 {
+		t.Fatalf("Expected two needs, got %+v", needs)
+	}
+	if needs[0].Line != 0 {
+		t.Errorf("Expected a keeper first, got line %d", needs[0].Line)
+	}
+	if needs[1].Line != 3 || needs[1].MinQuality != 9 {
+		t.Errorf("Expected a striker better than 8, got %+v", needs[1])
+	}
+}
+
+// TestMarketDay verifies AI clubs sign players to fill their needs within
+// budget and never deal with the user's club
+func TestMarketDay(t *testing.T) {
+	clubs := testClubs(3, 16, 2, 12)
+	buyer, user, seller := clubs[0], clubs[1], clubs[2]
+	buyer.Players = buyer.Players[1:]
+	buyer.Club.TransferBudget = 20_000_000
+	userSquad := len(user.Players)
+	seller.Players = append(seller.Players, Player{ID: 399, Position: "GK", Quality: 11, DateOfBirth: bornAged(28)})
+
+	date := time.Date(2025, time.July, 10, 0, 0, 0, 0, time.UTC)
+	rng := rand.New(rand.NewPCG(1, 2))
+
+	var deals []*Transfer
+	for day := 0; day < 200 && len(deals) == 0; day++ {
+		deals = MarketDay(clubs, nil, user.Club.ID, date, rng)
+	}
+	if len(deals) == 0 {
+		t.Fatal("Expected the buyer to sign a keeper")
+	}
+
+	deal := deals[0]
+	if deal.To != buyer.Club || deal.From != seller.Club || deal.Player.Position != "GK" {
+		t.Errorf("Expected a keeper from the seller, got %+v", deal)
+	}
+	if deal.Fee <= 0 || deal.Fee > 20_000_000 {
+		t.Errorf("Expected a fee within the buyer's budget, got %d", deal.Fee)
+	}
+	if len(buyer.Players) != len(seller.Players) {
+		t.Errorf("Expected the keeper to change squads, got %d and %d", len(buyer.Players), len(seller.Players))
+	}
+	if len(user.Players) != userSquad {
+		t.Error("Expected the user's squad to be left alone")
+	}
+}
+
+// TestLoanPlayer verifies youngsters out of the reckoning go on loan to a
+// weaker club that's short in their position
+func TestLoanPlayer(t *testing.T) {
+	parent := testClubs(2, 16, 2, 15)[0]
+	youngster := Player{ID: 199, Position: "ST", Quality: 8, DateOfBirth: bornAged(19)}
+	parent.Players = append(parent.Players, Player{ID: 198, Position: "CM", Quality: 15, DateOfBirth: bornAged(25)}, youngster)
+	borrower := testClubs(2, 16, 2, 8)[1]
+	borrower.Players = borrower.Players[:len(borrower.Players)-2]
+	pyramid := []*ClubWithPlayers{parent, borrower}
+
+	deal := loanPlayer(parent, pyramid, nil, 0, time.Date(2025, time.July, 10, 0, 0, 0, 0, time.UTC), rand.New(rand.NewPCG(1, 2)))
+	if deal == nil {
+		t.Fatal("Expected the youngster to go out on loan")
+	}
+	if !deal.Loan || deal.Fee != 0 || deal.Player.ID != youngster.ID || deal.To != borrower.Club {
+		t.Errorf("Expected a free loan to the weaker club, got %+v", deal)
+	}
+	last := borrower.Players[len(borrower.Players)-1]
+	if last.ID != youngster.ID || last.LoanedFrom != parent.Club.ID {
+		t.Errorf("Expected the borrower to have the youngster on loan, got %+v", last)
+	}
+}
@@ -5,6 +5,15 @@ type Half int
 const (
 	FirstHalf Half = iota + 1
 	SecondHalf
+	ExtraTimeFirstHalf
+	ExtraTimeSecondHalf
+	PenaltyShootout
+)
+
+// Extra time is two periods of 15 minutes with no added time
+const (
+	ExtraTimeHalfTimeMinute = 105
+	ExtraTimeFullTimeMinute = 120
 )
 
 type PhaseResult struct {
@@ -28,6 +37,9 @@ type Match struct {
 	HomeAttackingDirection AttackingDirection // Which goal Home attacks (switches at halftime)
 	PhaseHistory           []PhaseResult
 	Events                 []Event
+	Knockout               bool      // A draw goes to extra time, then penalties
+	Shootout               *Shootout // Set once a knockout match goes to penalties
+	FirstLeg               *Result   // For the second leg of a two-legged tie
 }
 
 func NewMatchFromFixture(f *Fixture) *Match {
@@ -45,6 +57,24 @@ func NewMatchFromFixture(f *Fixture) *Match {
 		HomeAttackingDirection: AttackingEast, // Home attacks East in first half
 		PhaseHistory:           make([]PhaseResult, 0),
 		Events:                 make([]Event, 0),
+		Knockout:               f.IsKnockout(),
+		FirstLeg:               f.FirstLeg,
+	}
+}
+
+// ReplaceParticipant swaps in a new team selection for one of the clubs before kick-off
+func (m *Match) ReplaceParticipant(participant *MatchParticipant) {
+	switch participant.Club.ID {
+	case m.Home.Club.ID:
+		if m.TeamInPossession == m.Home {
+			m.TeamInPossession = participant
+		}
+		m.Home = participant
+	case m.Away.Club.ID:
+		if m.TeamInPossession == m.Away {
+			m.TeamInPossession = participant
+		}
+		m.Away = participant
 	}
 }
 
@@ -116,8 +146,128 @@ func (m Match) IsHalfTime() bool {
 	return m.CurrentHalf == FirstHalf && m.CurrentMinute > 45+m.GetAddedTime(FirstHalf)
 }
 
+// IsFullTime reports whether the match is over. A knockout match level after
+// normal time carries on into extra time, and after that into penalties.
 func (m Match) IsFullTime() bool {
-	return m.CurrentMinute >= 90+m.GetAddedTime(SecondHalf)
+	switch m.CurrentHalf {
+	case ExtraTimeFirstHalf:
+		return false
+	case ExtraTimeSecondHalf:
+		return m.CurrentMinute >= ExtraTimeFullTimeMinute && !m.IsLevel()
+	case PenaltyShootout:
+		return m.Shootout.IsOver()
+	default:
+		return m.CurrentMinute >= 90+m.GetAddedTime(SecondHalf) && !(m.Knockout && m.IsLevel())
+	}
+}
+
+// IsLevel reports whether the scores are level, over both legs of a
+// two-legged tie and ignoring any penalties
+func (m Match) IsLevel() bool {
+	home, away := m.Aggregate()
+	return home == away
+}
+
+// Aggregate returns each side's goals over both legs of a two-legged tie,
+// or just this match's score otherwise
+func (m Match) Aggregate() (home, away int) {
+	home, away = m.Home.Score, m.Away.Score
+	if m.FirstLeg != nil {
+		// The sides had home and away the other way round in the first leg
+		home += m.FirstLeg.AwayGoals
+		away += m.FirstLeg.HomeGoals
+	}
+	return home, away
+}
+
+// NeedsExtraTime reports whether a knockout match has finished normal time level
+func (m Match) NeedsExtraTime() bool {
+	return m.Knockout && m.CurrentHalf <= SecondHalf &&
+		m.CurrentMinute >= 90+m.GetAddedTime(SecondHalf) && m.IsLevel()
+}
+
+// StartExtraTime kicks off the first period of extra time
+func (m *Match) StartExtraTime() {
+	m.CurrentHalf = ExtraTimeFirstHalf
+	m.CurrentMinute = 90
+	m.HomeAttackingDirection = AttackingEast
+	m.ActiveZone = WestMidCentre
+
+	m.AddEvent(NewEvent(
+		HalfStartsEvent,
+		90,
+		nil,
+		nil,
+	))
+}
+
+// IsExtraTimeHalfTime reports whether the first period of extra time is over
+func (m Match) IsExtraTimeHalfTime() bool {
+	return m.CurrentHalf == ExtraTimeFirstHalf && m.CurrentMinute >= ExtraTimeHalfTimeMinute
+}
+
+// StartExtraTimeSecondHalf kicks off the second period of extra time, with
+// the teams changing ends straight away
+func (m *Match) StartExtraTimeSecondHalf() {
+	m.CurrentHalf = ExtraTimeSecondHalf
+	m.CurrentMinute = ExtraTimeHalfTimeMinute
+	m.HomeAttackingDirection = AttackingWest
+	m.ActiveZone = WestMidCentre
+
+	m.AddEvent(NewEvent(
+		HalfStartsEvent,
+		ExtraTimeHalfTimeMinute,
+		nil,
+		nil,
+	))
+}
+
+// IsExtraTime reports whether the match has gone to extra time
+func (m *Match) IsExtraTime() bool {
+	return m.CurrentHalf >= ExtraTimeFirstHalf
+}
+
+// NeedsShootout reports whether a knockout match is still level after extra time
+func (m Match) NeedsShootout() bool {
+	return m.CurrentHalf == ExtraTimeSecondHalf && m.CurrentMinute >= ExtraTimeFullTimeMinute &&
+		m.IsLevel()
+}
+
+// StartShootout sets up penalties between the players left on the pitch
+func (m *Match) StartShootout() {
+	m.CurrentHalf = PenaltyShootout
+	m.Shootout = NewShootout(m.Home, m.Away)
+
+	m.AddEvent(NewEvent(
+		HalfStartsEvent,
+		ExtraTimeFullTimeMinute,
+		nil,
+		nil,
+	))
+}
+
+// IsShootout reports whether the match is being settled on penalties
+func (m *Match) IsShootout() bool {
+	return m.CurrentHalf == PenaltyShootout
+}
+
+// TakePenalty records the next kick of the shootout and its event
+func (m *Match) TakePenalty(kick PenaltyKick) {
+	m.Shootout.RecordKick(kick)
+
+	eventType := PenaltyScoredEvent
+	switch kick.Outcome {
+	case PenaltySaved:
+		eventType = PenaltySavedEvent
+	case PenaltyMissed:
+		eventType = PenaltyMissedEvent
+	}
+	m.AddEvent(NewEvent(
+		eventType,
+		ExtraTimeFullTimeMinute,
+		kick.For,
+		kick.Taker,
+	))
 }
 
 func (m *Match) IsFirstHalf() bool {
@@ -184,15 +334,24 @@ func (m *Match) ApplyPhaseResult(result *PhaseResult) {
 	m.CurrentMinute++
 }
 
+// GetWinner returns the club that won the match, or the tie on aggregate
+// for the second leg of a two-legged tie
 func (m *Match) GetWinner() *Club {
 	// Safety check - should never happen, but prevents crash
 	if m == nil || m.Home == nil || m.Away == nil {
 		return nil
 	}
 
-	if m.Home.Score > m.Away.Score {
+	home, away := m.Aggregate()
+	if home > away {
 		return m.Home.Club
-	} else if m.Away.Score > m.Home.Score {
+	} else if away > home {
+		return m.Away.Club
+	}
+	if m.Shootout != nil && m.Shootout.IsOver() {
+		if m.Shootout.HomeScored > m.Shootout.AwayScored {
+			return m.Home.Club
+		}
 		return m.Away.Club
 	}
 	return nil
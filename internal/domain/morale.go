@@ -0,0 +1,171 @@
+package domain
+
+// Morale runs from 0 to MaxMorale, and a player at NeutralMorale is neither
+// lifted nor weighed down by it
+const (
+	NeutralMorale = 50
+	MaxMorale     = 100
+)
+
+// moraleEffect is the quality a player at MaxMorale gains in a match, and
+// one at rock bottom loses
+const moraleEffect = 1.0
+
+// After every match the whole squad gains or loses morale on the result, and
+// those who started gain startMorale for being picked
+const (
+	winMorale   = 3
+	lossMorale  = -3
+	startMorale = 2
+)
+
+// benchedMorale is what a fit player loses for being left out of a match
+// altogether, by the role they were promised. Coming off the bench pleases
+// fringe players but not those who expect to start.
+var benchedMorale = map[SquadRole]int{
+	RoleRotation:  -1,
+	RoleFirstTeam: -3,
+	RoleKeyPlayer: -4,
+}
+
+// Each week a player in the last year of their contract, or paid less than
+// underpaidShare of what their quality is worth, loses unsettledMorale for
+// each, and everyone then settles back a moraleSettling-th of the way to
+// NeutralMorale
+const (
+	unsettledMorale = -1
+	underpaidShare  = 0.75
+	moraleSettling  = 10
+)
+
+// promiseMorale is what a player gains on being promised a bigger role
+const promiseMorale = 5
+
+// MatchMorale moves the morale of everyone in the squad after a match the
+// side played against the opponent: on the result, and for each fit player
+// on whether they played as much as their role promised
+func MatchMorale(squad *ClubWithPlayers, side, opponent *MatchParticipant) {
+	result := 0
+	switch {
+	case side.Score > opponent.Score:
+		result = winMorale
+	case side.Score < opponent.Score:
+		result = lossMorale
+	}
+
+	started, appeared := make(map[int64]bool), make(map[int64]bool)
+	for _, player := range side.Appeared {
+		appeared[player.Player.ID] = true
+		started[player.Player.ID] = player.Started
+	}
+
+	for i := range squad.Players {
+		player := &squad.Players[i]
+		change := result
+		switch {
+		case started[player.ID]:
+			change += startMorale
+		case appeared[player.ID] && player.Contract.Role >= RoleFirstTeam:
+			change--
+		case appeared[player.ID]:
+			change++
+		case player.IsAvailable():
+			change += benchedMorale[player.Contract.Role]
+		}
+		player.addMorale(change)
+	}
+}
+
+// MoraleWeek unsettles players worried about their contracts and lets
+// everyone's morale settle back towards neutral over the week
+func MoraleWeek(squad *ClubWithPlayers, startYear int) {
+	for i := range squad.Players {
+		player := &squad.Players[i]
+		change := 0
+		if player.Contract.InFinalYear(startYear) {
+			change += unsettledMorale
+		}
+		if float64(player.Contract.Wage) < underpaidShare*float64(player.MarketWage()) {
+			change += unsettledMorale
+		}
+		player.addMorale(change)
+		player.Morale += (NeutralMorale - player.Morale) / moraleSettling
+	}
+}
+
+// PromiseRole promises the player the next role up in the squad, which lifts
+// their morale now but means they expect to play more from here on. It
+// reports false for a key player, who can't be promised more.
+func (p *Player) PromiseRole() bool {
+	if p.Contract.Role >= RoleKeyPlayer {
+		return false
+	}
+	p.Contract.Role++
+	p.addMorale(promiseMorale)
+	return true
+}
+
+// addMorale changes the player's morale, keeping it in range
+func (p *Player) addMorale(change int) {
+	p.Morale = max(0, min(MaxMorale, p.Morale+change))
+}
+
+// MoraleBonus is the quality the player gains, or loses when negative, from
+// how happy they are
+func (p Player) MoraleBonus() float64 {
+	return moraleEffect * float64(p.Morale-NeutralMorale) / NeutralMorale
+}
+
+// Cohesion runs from 0 to MaxCohesion and grows when a club keeps its side
+// together: after each match it moves by cohesionStep for every starter kept
+// from the last match beyond settledCore, and falls as much for each short
+const (
+	NeutralCohesion = 50
+	MaxCohesion     = 100
+	settledCore     = 8
+	cohesionStep    = 2
+)
+
+// cohesionEffect is the strength a side at MaxCohesion gains, and one with
+// none at all loses
+const cohesionEffect = 1.0
+
+// KeptTogether updates the club's cohesion after a match from the starters
+// of its previous match and of this one. A club with no previous match to go
+// on is left as it is.
+func (c *Club) KeptTogether(previous, starters []int64) {
+	if len(previous) == 0 {
+		return
+	}
+	before := make(map[int64]bool, len(previous))
+	for _, id := range previous {
+		before[id] = true
+	}
+	kept := 0
+	for _, id := range starters {
+		if before[id] {
+			kept++
+		}
+	}
+	c.Cohesion = max(0, min(MaxCohesion, c.Cohesion+cohesionStep*(kept-settledCore)))
+}
+
+// Starters lists the IDs of the players who started the match for the side
+func (p *MatchParticipant) Starters() []int64 {
+	starters := make([]int64, 0, StartingXISize)
+	for _, player := range p.Appeared {
+		if player.Started {
+			starters = append(starters, player.Player.ID)
+		}
+	}
+	return starters
+}
+
+// CohesionBonus is the strength the side gains, or loses when negative, from
+// how well its players know each other
+func (p *MatchParticipant) CohesionBonus() float64 {
+	if p.Club == nil {
+		return 0
+	}
+	return cohesionEffect * float64(p.Club.Cohesion-NeutralCohesion) / NeutralCohesion
+}
@@ -0,0 +1,101 @@
+package domain
+
+import "testing"
+
+// TestMatchMorale verifies a win lifts the squad, starters gain more, and
+// players promised a big role sulk at being left out
+func TestMatchMorale(t *testing.T) {
+	squad := testClubs(1, 4, 0, 10)[0]
+	for i := range squad.Players {
+		squad.Players[i].Morale = NeutralMorale
+	}
+	squad.Players[2].Contract.Role = RoleKeyPlayer
+	squad.Players[3].Contract.Role = RoleKeyPlayer
+	squad.Players[3].Injury = &Injury{PlayerID: 103, DaysRemaining: 10}
+
+	side := &MatchParticipant{Score: 2, Appeared: []*MatchPlayerParticipant{
+		{Player: &squad.Players[0], Started: true},
+		{Player: &squad.Players[1]},
+	}}
+	MatchMorale(squad, side, &MatchParticipant{Score: 1})
+
+	want := []int{55, 54, 49, 53}
+	for i, morale := range want {
+		if got := squad.Players[i].Morale; got != morale {
+			t.Errorf("Expected player %d to have morale %d, got %d", i, morale, got)
+		}
+	}
+}
+
+// TestMoraleWeek verifies contract worries unsettle a player and morale
+// drifts back towards neutral
+func TestMoraleWeek(t *testing.T) {
+	squad := testClubs(1, 3, 0, 10)[0]
+	for i := range squad.Players {
+		squad.Players[i].Morale = 20
+		squad.Players[i].Contract = Contract{Wage: squad.Players[i].MarketWage(), Ends: 2028}
+	}
+	squad.Players[1].Contract.Ends = 2026
+	squad.Players[2].Contract.Wage /= 2
+
+	MoraleWeek(squad, 2025)
+
+	if got := squad.Players[0].Morale; got != 23 {
+		t.Errorf("Expected a settled player to drift towards neutral, got %d", got)
+	}
+	if got := squad.Players[1].Morale; got != 22 {
+		t.Errorf("Expected a player in their final year to be unsettled, got %d", got)
+	}
+	if got := squad.Players[2].Morale; got != 22 {
+		t.Errorf("Expected an underpaid player to be unsettled, got %d", got)
+	}
+}
+
+// TestPromiseRole verifies a promise lifts morale and raises the player's
+// role, up to key player
+func TestPromiseRole(t *testing.T) {
+	player := Player{Morale: NeutralMorale, Contract: Contract{Role: RoleFirstTeam}}
+
+	if !player.PromiseRole() || player.Contract.Role != RoleKeyPlayer || player.Morale != 55 {
+		t.Errorf("Expected a promise to make a key player of them, got %+v", player)
+	}
+	if player.PromiseRole() {
+		t.Error("Expected a key player to be promised nothing more")
+	}
+}
+
+// TestKeptTogether verifies cohesion grows when a club keeps its starters
+// and falls when it rings the changes
+func TestKeptTogether(t *testing.T) {
+	previous := []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11}
+	club := &Club{Cohesion: NeutralCohesion}
+
+	club.KeptTogether(previous, previous)
+	if club.Cohesion != 56 {
+		t.Errorf("Expected an unchanged side to grow closer, got %d", club.Cohesion)
+	}
+
+	club.KeptTogether(previous, []int64{1, 2, 3, 4, 5, 12, 13, 14, 15, 16, 17})
+	if club.Cohesion != 50 {
+		t.Errorf("Expected six changes to set the side back, got %d", club.Cohesion)
+	}
+
+	club.KeptTogether(nil, previous)
+	if club.Cohesion != 50 {
+		t.Errorf("Expected a club's first match to leave it as it is, got %d", club.Cohesion)
+	}
+}
+
+// TestMoraleAndCohesionStrength verifies happy players and a settled side
+// add to phase strength
+func TestMoraleAndCohesionStrength(t *testing.T) {
+	player := &Player{Quality: 10, Sharpness: MaxSharpness, Morale: MaxMorale}
+	side := &MatchParticipant{
+		Club:      &Club{Cohesion: 75},
+		CurrentXI: []*MatchPlayerParticipant{{Player: player}},
+	}
+
+	if got := side.PhaseStrength(); got != 11.5 {
+		t.Errorf("Expected top morale and good cohesion to add 1.5, got %.2f", got)
+	}
+}
@@ -0,0 +1,61 @@
+package domain
+
+// HomeNationality is where most of the pyramid's players come from
+const HomeNationality = "England"
+
+// surnames are the names generated players are given, by nationality
+var surnames = map[string][]string{
+	"England": {
+		"Adams", "Barker", "Bennett", "Bradley", "Carter", "Chapman", "Cole", "Dawson",
+		"Dixon", "Ellis", "Fletcher", "Foster", "Gibson", "Harding", "Hayes", "Holmes",
+		"Hughes", "Jennings", "Kemp", "Lambert", "Lawrence", "Marsh", "Mason", "Morton",
+		"Nicholls", "Osborne", "Parker", "Pearce", "Reeves", "Riley", "Shaw", "Spencer",
+		"Sutton", "Thornton", "Turner", "Walsh", "Webb", "Whitaker", "Wood", "Wright",
+	},
+	"Spain": {
+		"Alonso", "Blanco", "Castro", "Delgado", "Díaz", "Domínguez", "Fernández", "Gil",
+		"Herrera", "Iglesias", "León", "Lozano", "Márquez", "Medina", "Molina", "Navarro",
+		"Ortega", "Pascual", "Prieto", "Ramos", "Rubio", "Sáez", "Serrano", "Vidal",
+	},
+	"France": {
+		"Bernard", "Blanc", "Bonnet", "Chevalier", "Dubois", "Durand", "Fabre", "Fontaine",
+		"Gauthier", "Girard", "Lambert", "Laurent", "Lefèvre", "Leroy", "Mercier", "Moreau",
+		"Perrin", "Renard", "Roussel", "Vincent",
+	},
+	"Germany": {
+		"Bauer", "Becker", "Braun", "Fischer", "Frank", "Hartmann", "Hoffmann", "Keller",
+		"Krüger", "Lange", "Meyer", "Neumann", "Richter", "Schäfer", "Schmitt", "Schulz",
+		"Vogel", "Wagner", "Weber", "Zimmermann",
+	},
+	"Italy": {
+		"Barbieri", "Bianchi", "Bruno", "Colombo", "Conti", "Costa", "De Luca", "Esposito",
+		"Ferrara", "Fontana", "Galli", "Greco", "Lombardi", "Marino", "Mariani", "Ricci",
+		"Rinaldi", "Romano", "Russo", "Villa",
+	},
+	"Portugal": {
+		"Almeida", "Carvalho", "Correia", "Cunha", "Fonseca", "Gomes", "Lopes", "Marques",
+		"Mendes", "Moreira", "Nunes", "Pereira", "Pinto", "Ribeiro", "Santos", "Teixeira",
+	},
+	"Netherlands": {
+		"Bakker", "Bos", "de Boer", "de Graaf", "de Groot", "Dekker", "Hendriks", "Janssen",
+		"Kok", "Meijer", "Mulder", "Peters", "Smit", "van Dijk", "van Leeuwen", "Visser",
+	},
+	"Brazil": {
+		"Araújo", "Barbosa", "Cardoso", "Cavalcanti", "Duarte", "Farias", "Freitas", "Lima",
+		"Machado", "Moraes", "Nascimento", "Oliveira", "Rocha", "Silva", "Soares", "Souza",
+	},
+	"Argentina": {
+		"Acosta", "Benítez", "Cabrera", "Córdoba", "Flores", "Giménez", "Godoy", "Ledesma",
+		"Luna", "Maldonado", "Paz", "Ríos", "Rojas", "Sosa", "Vera", "Villalba",
+	},
+	"Belgium": {
+		"Claes", "De Smet", "Dubois", "Goossens", "Jacobs", "Lambrecht", "Maes", "Martens",
+		"Mertens", "Michiels", "Peeters", "Verhoeven", "Willems", "Wouters",
+	},
+}
+
+// foreignNationalities lists the nationalities other than the home one, in
+// a fixed order so generation is repeatable
+var foreignNationalities = []string{
+	"Spain", "France", "Germany", "Italy", "Portugal", "Netherlands", "Brazil", "Argentina", "Belgium",
+}
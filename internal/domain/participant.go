@@ -11,65 +11,111 @@ type MatchPlayerParticipant struct {
 	Player   *Player
 	Position string
 	Stamina  float64
+	Started  bool    // In the starting XI rather than brought on
+	Injury   *Injury // Set if the player was injured during this match
 }
 
 // MatchParticipant represents a club participating in a specific match
 type MatchParticipant struct {
-	Club      *Club
-	CurrentXI []*MatchPlayerParticipant
-	Bench     []*MatchPlayerParticipant
-	Formation string
-	Score     int
+	Club          *Club
+	CurrentXI     []*MatchPlayerParticipant
+	Bench         []*MatchPlayerParticipant
+	Appeared      []*MatchPlayerParticipant // Everyone who has taken the pitch, including players since removed
+	Captain       *MatchPlayerParticipant
+	SetPieceTaker *MatchPlayerParticipant
+	Formation     string
+	Familiarity   int // Matches running the club has played the formation before this one
+	Score         int
 }
 
-// NewMatchParticipant creates a new match participant from a club and its players
+// NewMatchParticipant creates a new match participant from a club and its players,
+// with the strongest 4-3-3 the squad can field
 func NewMatchParticipant(club *Club, players []Player) *MatchParticipant {
-	// Assign players to 4-3-3 formation positions
-	// Positions: GK, RB, CB, CB, LB, CM, CM, CM, RW, ST, LW
-	positions := []string{"GK", "RB", "CB", "CB", "LB", "CM", "CM", "CM", "RW", "ST", "LW"}
-
-	// Split into starting XI and bench
-	currentXI := make([]*MatchPlayerParticipant, 0, 11)
-	bench := make([]*MatchPlayerParticipant, 0, 7)
-
-	for i := range players {
-		if i < len(positions) {
-			// First 11 players are the starting XI
-			currentXI = append(currentXI, &MatchPlayerParticipant{
-				Player:   &players[i],
-				Position: positions[i],
-				Stamina:  100,
-			})
+	return NewMatchParticipantFromLineup(club, players, AutoPickLineup(FourThreeThree, players))
+}
+
+// NewMatchParticipantFromLineup creates a match participant from a manager's team selection
+func NewMatchParticipantFromLineup(club *Club, players []Player, lineup *Lineup) *MatchParticipant {
+	squad := squadByID(players)
+	slots := lineup.Formation.Slots()
+
+	currentXI := make([]*MatchPlayerParticipant, 0, StartingXISize)
+	appeared := make([]*MatchPlayerParticipant, 0, StartingXISize)
+	bench := make([]*MatchPlayerParticipant, 0, BenchSize)
+	var captain, setPieceTaker *MatchPlayerParticipant
+
+	for slot := 0; slot < lineup.SlotCount(); slot++ {
+		player, ok := squad[lineup.PlayerAt(slot)]
+		if !ok {
+			continue
+		}
+
+		matchPlayer := &MatchPlayerParticipant{
+			Player:  player,
+			Stamina: float64(100 - player.Fatigue),
+		}
+
+		if slot < len(lineup.Starters) {
+			matchPlayer.Position = slots[slot]
+			matchPlayer.Started = true
+			currentXI = append(currentXI, matchPlayer)
+			appeared = append(appeared, matchPlayer)
 		} else {
-			// Remaining players go on the bench (no specific position)
-			bench = append(bench, &MatchPlayerParticipant{
-				Player:   &players[i],
-				Position: "", // Bench players don't have assigned positions
-				Stamina:  100,
-			})
+			// Bench players don't have assigned positions
+			bench = append(bench, matchPlayer)
+		}
+
+		if player.ID == lineup.CaptainID {
+			captain = matchPlayer
+		}
+		if player.ID == lineup.SetPieceTakerID {
+			setPieceTaker = matchPlayer
 		}
 	}
 
+	familiarity := 0
+	if club != nil {
+		familiarity = club.FamiliarityWith(lineup.Formation.String())
+	}
+
 	return &MatchParticipant{
-		Club:      club,
-		CurrentXI: currentXI,
-		Bench:     bench,
-		Formation: "4-3-3",
-		Score:     0,
+		Club:          club,
+		CurrentXI:     currentXI,
+		Bench:         bench,
+		Appeared:      appeared,
+		Captain:       captain,
+		SetPieceTaker: setPieceTaker,
+		Formation:     lineup.Formation.String(),
+		Familiarity:   familiarity,
+		Score:         0,
 	}
 }
 
+// MakeSubstitution brings a bench player on for one on the pitch. An injured
+// player can't come back on.
 func (p *MatchParticipant) MakeSubstitution(in, out *MatchPlayerParticipant) {
+	if in.Injury != nil {
+		return
+	}
+
 	// Find and replace the player coming off in CurrentXI
+	replaced := false
 	for i, player := range p.CurrentXI {
 		if player == out {
 			// Give the substitute the position of the player coming off
 			in.Position = out.Position
 			p.CurrentXI[i] = in
+			replaced = true
 			break
 		}
 	}
 
+	// The player has already left the pitch (e.g. sent off)
+	if !replaced {
+		return
+	}
+	p.Appeared = append(p.Appeared, in)
+
 	// Remove the substitute from the bench
 	for i, player := range p.Bench {
 		if player == in {
@@ -83,6 +129,39 @@ func (p *MatchParticipant) MakeSubstitution(in, out *MatchPlayerParticipant) {
 	p.Bench = append(p.Bench, out)
 }
 
+// AvailableSubstitutes returns the bench players fit to come on, leaving out
+// anyone injured during the match
+func (p *MatchParticipant) AvailableSubstitutes() []*MatchPlayerParticipant {
+	available := make([]*MatchPlayerParticipant, 0, len(p.Bench))
+	for _, player := range p.Bench {
+		if player.Injury == nil {
+			available = append(available, player)
+		}
+	}
+	return available
+}
+
+// BestSubstitute returns the highest quality player left on the bench
+func (p *MatchParticipant) BestSubstitute() *MatchPlayerParticipant {
+	var best *MatchPlayerParticipant
+	for _, player := range p.AvailableSubstitutes() {
+		if best == nil || player.Player.Quality > best.Player.Quality {
+			best = player
+		}
+	}
+	return best
+}
+
+// RemoveFromPitch takes a player out of the current XI without a replacement
+func (p *MatchParticipant) RemoveFromPitch(player *MatchPlayerParticipant) {
+	for i, fielded := range p.CurrentXI {
+		if fielded == player {
+			p.CurrentXI = append(p.CurrentXI[:i], p.CurrentXI[i+1:]...)
+			return
+		}
+	}
+}
+
 func (p *MatchParticipant) GetStarPlayers() []*MatchPlayerParticipant {
 	stars := make([]*MatchPlayerParticipant, 0)
 	highestQuality := 0
@@ -1,7 +1,29 @@
 package domain
 
+import "time"
+
 // Player represents an individual player with permanent attributes
 type Player struct {
-	Name    string
-	Quality int // out of 20
+	ID               int64
+	Name             string
+	Position         string  // Natural position code, e.g. "GK", "CB", "ST"
+	Quality          int     // out of 20
+	Age              int     // How old the player was when they came into the game; AgeOn gives their age now
+	SuspendedMatches int     // Matches left to serve on an active ban
+	Injury           *Injury // Current injury, nil when fit
+	Fatigue          int     // Tiredness carried over from recent matches, 0 when fully rested
+	LoanedFrom       int64   // The club that owns the player while they're out on loan, 0 otherwise
+	Contract         Contract
+	DateOfBirth      time.Time
+	Potential        int // The best quality the player can grow into
+	Progress         int // Development points towards the next point of quality, or the loss of one
+	Nationality      string
+	Sharpness        int           // Match sharpness out of 100, kept up by playing and training
+	TrainingFocus    TrainingFocus // What the player works on in training, FocusGeneral to follow the squad
+	Morale           int           // How happy the player is, out of 100 with NeutralMorale content
+}
+
+// IsAvailable reports whether the player can be picked for the next match
+func (p *Player) IsAvailable() bool {
+	return p.SuspendedMatches == 0 && p.Injury == nil
 }
@@ -1,5 +1,7 @@
 package domain
 
+import "time"
+
 // ClubRepository handles persistence of clubs
 type ClubRepository interface {
 	GetAll() ([]*ClubWithPlayers, error)
@@ -14,6 +16,8 @@ type FixtureRepository interface {
 	GetByClubID(clubID int64) ([]*Fixture, error)
 	GetByGameweek(gameweek int) ([]*Fixture, error)
 	GetUnplayedByClubID(clubID int64) ([]*Fixture, error)
+	GetUnplayed() ([]*Fixture, error)
+	GetUnplayedThrough(date time.Time) ([]*Fixture, error)
 }
 
 type GameStateRepository interface {
@@ -0,0 +1,132 @@
+package domain
+
+import "math/rand/v2"
+
+// scheduleAttempts is how many club orderings are tried when looking for a
+// schedule that keeps derbies on separate gameweeks
+const scheduleAttempts = 200
+
+// Derby is a pair of clubs, by name, whose meetings are kept on separate
+// gameweeks from other derbies
+type Derby [2]string
+
+// GenerateFixtures builds a double round-robin for the clubs. Every club
+// meets every other once at home and once away, alternating venues so no club
+// has more than two home or away matches in a row. An odd number of clubs
+// gives one club a bye each gameweek. Clubs are shuffled with rng, retrying
+// until no gameweek holds more than one derby where that is possible.
+func GenerateFixtures(clubs []*ClubWithPlayers, derbies []Derby, rng *rand.Rand) []*Fixture {
+	if len(clubs) < 2 {
+		return nil
+	}
+
+	seating := make([]*ClubWithPlayers, len(clubs))
+	copy(seating, clubs)
+	if len(seating)%2 == 1 {
+		// The bye takes the fixed seat, so each club sits out the round it
+		// would have met it and alternates venues either side of it
+		seating = append(seating, nil)
+	}
+
+	var best [][]*Fixture
+	bestClashes := -1
+	for attempt := 0; attempt < scheduleAttempts && bestClashes != 0; attempt++ {
+		rng.Shuffle(len(clubs), func(i, j int) {
+			seating[i], seating[j] = seating[j], seating[i]
+		})
+
+		rounds := roundRobin(seating)
+		if clashes := derbyClashes(rounds, derbies); bestClashes == -1 || clashes < bestClashes {
+			best, bestClashes = rounds, clashes
+		}
+	}
+
+	// The second half repeats the first with venues swapped, starting one
+	// round later so nobody plays the same side twice running or gets a
+	// third home or away match in a row at the turn
+	fixtures := make([]*Fixture, 0, 2*len(best)*len(best[0]))
+	for i, round := range best {
+		for _, fixture := range round {
+			fixtures = append(fixtures, &Fixture{
+				Gameweek: i + 1,
+				HomeTeam: fixture.HomeTeam,
+				AwayTeam: fixture.AwayTeam,
+			})
+		}
+	}
+	for i := range best {
+		for _, fixture := range best[(i+1)%len(best)] {
+			fixtures = append(fixtures, &Fixture{
+				Gameweek: len(best) + i + 1,
+				HomeTeam: fixture.AwayTeam,
+				AwayTeam: fixture.HomeTeam,
+			})
+		}
+	}
+
+	return fixtures
+}
+
+// roundRobin pairs an even number of clubs into single round-robin rounds
+// using the circle method: the last club stays put while the rest rotate.
+// Venues are chosen so each club's home and away matches alternate with the
+// fewest possible breaks. A nil club must sit in the last seat; pairings
+// against it are byes and dropped.
+func roundRobin(seating []*ClubWithPlayers) [][]*Fixture {
+	n := len(seating)
+	rotating := n - 1
+	fixed := seating[rotating]
+
+	rounds := make([][]*Fixture, rotating)
+	for r := range rounds {
+		home, away := seating[r], fixed
+		if r%2 == 1 {
+			home, away = away, home
+		}
+		rounds[r] = appendPairing(rounds[r], home, away)
+
+		for k := 1; k < n/2; k++ {
+			home, away := seating[(r+k)%rotating], seating[(r-k+rotating)%rotating]
+			if k%2 == 0 {
+				home, away = away, home
+			}
+			rounds[r] = appendPairing(rounds[r], home, away)
+		}
+	}
+
+	return rounds
+}
+
+// appendPairing adds a fixture to the round unless one side is the bye
+func appendPairing(round []*Fixture, home, away *ClubWithPlayers) []*Fixture {
+	if home == nil || away == nil {
+		return round
+	}
+	return append(round, &Fixture{HomeTeam: home, AwayTeam: away})
+}
+
+// derbyClashes counts the derbies that share a round with an earlier one
+func derbyClashes(rounds [][]*Fixture, derbies []Derby) int {
+	clashes := 0
+	for _, round := range rounds {
+		count := 0
+		for _, fixture := range round {
+			if isDerby(fixture, derbies) {
+				count++
+			}
+		}
+		clashes += max(count-1, 0)
+	}
+	return clashes
+}
+
+// isDerby reports whether the fixture is between a configured pair of rivals
+func isDerby(fixture *Fixture, derbies []Derby) bool {
+	home, away := fixture.HomeTeam.Club.Name, fixture.AwayTeam.Club.Name
+	for _, derby := range derbies {
+		if (derby[0] == home && derby[1] == away) || (derby[0] == away && derby[1] == home) {
+			return true
+		}
+	}
+	return false
+}
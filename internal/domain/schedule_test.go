@@ -0,0 +1,110 @@
+package domain
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"testing"
+)
+
+// TestGenerateFixtures verifies every club meets every other home and away,
+// once per gameweek at most, with byes for odd numbers of clubs
+func TestGenerateFixtures(t *testing.T) {
+	for _, size := range []int{2, 3, 4, 5, 20} {
+		t.Run(fmt.Sprintf("%d clubs", size), func(t *testing.T) {
+			clubs := testClubs(size, 0, 0, 0)
+			fixtures := GenerateFixtures(clubs, nil, rand.New(rand.NewPCG(1, 2)))
+
+			if want := size * (size - 1); len(fixtures) != want {
+				t.Fatalf("Expected %d fixtures, got %d", want, len(fixtures))
+			}
+
+			gameweeks := 2 * (size - 1)
+			if size%2 == 1 {
+				gameweeks = 2 * size
+			}
+
+			meetings := make(map[[2]int64]int)
+			playing := make(map[int]map[int64]bool)
+			for _, fixture := range fixtures {
+				if fixture.Gameweek < 1 || fixture.Gameweek > gameweeks {
+					t.Errorf("Fixture in gameweek %d, expected 1-%d", fixture.Gameweek, gameweeks)
+				}
+				meetings[[2]int64{fixture.HomeTeam.Club.ID, fixture.AwayTeam.Club.ID}]++
+
+				if playing[fixture.Gameweek] == nil {
+					playing[fixture.Gameweek] = make(map[int64]bool)
+				}
+				for _, club := range []*ClubWithPlayers{fixture.HomeTeam, fixture.AwayTeam} {
+					if playing[fixture.Gameweek][club.Club.ID] {
+						t.Errorf("%s plays twice in gameweek %d", club.Club.Name, fixture.Gameweek)
+					}
+					playing[fixture.Gameweek][club.Club.ID] = true
+				}
+			}
+
+			for _, home := range clubs {
+				for _, away := range clubs {
+					if home == away {
+						continue
+					}
+					if n := meetings[[2]int64{home.Club.ID, away.Club.ID}]; n != 1 {
+						t.Errorf("Expected %s to host %s once, got %d", home.Club.Name, away.Club.Name, n)
+					}
+				}
+			}
+		})
+	}
+}
+
+// TestGenerateFixturesAlternatesVenues verifies no club plays three home or
+// three away matches in a row, with or without byes
+func TestGenerateFixturesAlternatesVenues(t *testing.T) {
+	for _, size := range []int{5, 7, 20, 21} {
+		for seed := uint64(1); seed <= 10; seed++ {
+			clubs := testClubs(size, 0, 0, 0)
+			fixtures := GenerateFixtures(clubs, nil, rand.New(rand.NewPCG(seed, 4)))
+
+			gameweeks := 0
+			for _, fixture := range fixtures {
+				gameweeks = max(gameweeks, fixture.Gameweek)
+			}
+
+			// Byes are skipped, so a club's run carries on across them
+			venues := make(map[int64]string)
+			for gameweek := 1; gameweek <= gameweeks; gameweek++ {
+				for _, fixture := range fixtures {
+					if fixture.Gameweek == gameweek {
+						venues[fixture.HomeTeam.Club.ID] += "H"
+						venues[fixture.AwayTeam.Club.ID] += "A"
+					}
+				}
+			}
+
+			for id, pattern := range venues {
+				if strings.Contains(pattern, "HHH") || strings.Contains(pattern, "AAA") {
+					t.Errorf("%d clubs, seed %d: club %d has three home or away matches in a row: %s", size, seed, id, pattern)
+				}
+			}
+		}
+	}
+}
+
+// TestGenerateFixturesSpreadsDerbies verifies configured derbies fall on different gameweeks
+func TestGenerateFixturesSpreadsDerbies(t *testing.T) {
+	clubs := testClubs(20, 0, 0, 0)
+	derbies := []Derby{{"A", "B"}, {"C", "D"}, {"E", "F"}, {"G", "H"}}
+
+	fixtures := GenerateFixtures(clubs, derbies, rand.New(rand.NewPCG(5, 6)))
+
+	derbyGameweeks := make(map[int]int)
+	for _, fixture := range fixtures {
+		if isDerby(fixture, derbies) {
+			derbyGameweeks[fixture.Gameweek]++
+		}
+	}
+
+	if len(derbyGameweeks) != 2*len(derbies) {
+		t.Errorf("Expected %d derbies on separate gameweeks, got %v", 2*len(derbies), derbyGameweeks)
+	}
+}
@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// Season is one year of a career. Fixtures, and the matches and tables built
+// from them, belong to exactly one season.
+type Season struct {
+	ID        int64
+	StartYear int
+	Today     time.Time // The day the game has reached
+}
+
+// Name returns the season in the usual football form, e.g. "2025/26"
+func (s *Season) Name() string {
+	return fmt.Sprintf("%d/%02d", s.StartYear, (s.StartYear+1)%100)
+}
+
+// SeasonRecord is a club's final league standing in a completed season
+type SeasonRecord struct {
+	Season       Season
+	Division     string
+	Position     int // 1-based
+	Played       int
+	Won          int
+	Drawn        int
+	Lost         int
+	GoalsFor     int
+	GoalsAgainst int
+	Points       int
+}
+
+// SeasonSummary is the end-of-season review shown before rolling over. The
+// top-level fields describe the manager's division; Tables and Movements
+// cover the whole pyramid.
+type SeasonSummary struct {
+	Season    *Season
+	Division  *Division
+	Table     *LeagueTable
+	Champion  *Club
+	Promoted  []Movement
+	Relegated []Movement
+	PlayOff   *PromotionPlayOff
+	Position  int    // 1-based final position of the manager's club
+	Cups      []*Cup // Domestic cup first, then the continental cup
+
+	Tables    map[int64]*LeagueTable
+	Movements []Movement
+}
+
+// NewSeasonSummary works out promotion and relegation across every division
+// and reads the manager's division off its final table
+func NewSeasonSummary(season *Season, divisions []*Division, tables map[int64]*LeagueTable, playOffs map[int64]*PromotionPlayOff, club *Club) *SeasonSummary {
+	summary := &SeasonSummary{
+		Season:    season,
+		Tables:    tables,
+		Movements: Movements(divisions, tables, playOffs),
+	}
+
+	for _, division := range divisions {
+		if division.ID == club.DivisionID {
+			summary.Division = division
+		}
+	}
+	summary.Table = tables[club.DivisionID]
+	summary.PlayOff = playOffs[club.DivisionID]
+	if summary.Table == nil {
+		return summary
+	}
+
+	for i, position := range summary.Table.Positions {
+		if i == 0 {
+			summary.Champion = position.Club
+		}
+		if position.Club.ID == club.ID {
+			summary.Position = i + 1
+		}
+	}
+
+	for _, movement := range summary.Movements {
+		if movement.From.ID != club.DivisionID {
+			continue
+		}
+		if movement.IsPromotion() {
+			summary.Promoted = append(summary.Promoted, movement)
+		} else {
+			summary.Relegated = append(summary.Relegated, movement)
+		}
+	}
+
+	return summary
+}
+
+// MovementFor returns the manager's club's own promotion or relegation, if any
+func (s *SeasonSummary) MovementFor(club *Club) (Movement, bool) {
+	for _, movement := range s.Movements {
+		if movement.Club.ID == club.ID {
+			return movement, true
+		}
+	}
+	return Movement{}, false
+}
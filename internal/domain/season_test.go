@@ -0,0 +1,80 @@
+package domain
+
+import "testing"
+
+// TestSeasonName verifies seasons are named across the turn of the year
+func TestSeasonName(t *testing.T) {
+	tests := []struct {
+		startYear int
+		want      string
+	}{
+		{2025, "2025/26"},
+		{2099, "2099/00"},
+		{2008, "2008/09"},
+	}
+
+	for _, tt := range tests {
+		season := &Season{StartYear: tt.startYear}
+		if got := season.Name(); got != tt.want {
+			t.Errorf("Season starting %d: expected %q, got %q", tt.startYear, tt.want, got)
+		}
+	}
+}
+
+// TestNewSeasonSummary verifies the champion, the manager's finish and the clubs leaving their division
+func TestNewSeasonSummary(t *testing.T) {
+	rules := PremierLeagueRules()
+	rules.ChampionsLeaguePlaces = 1
+	rules.EuropaLeaguePlaces = 0
+	rules.RelegationPlaces = 1
+
+	lowerRules := ChampionshipRules()
+	lowerRules.PromotionPlaces = 1
+	lowerRules.PromotionPlayOffPlaces = 0
+
+	premierLeague := &Division{ID: 1, Name: "Premier League", Tier: 1, Rules: rules}
+	championship := &Division{ID: 2, Name: "Championship", Tier: 2, Rules: lowerRules}
+
+	arsenal := &Club{ID: 1, Name: "Arsenal", DivisionID: premierLeague.ID}
+	chelsea := &Club{ID: 2, Name: "Chelsea", DivisionID: premierLeague.ID}
+	spurs := &Club{ID: 3, Name: "Spurs", DivisionID: premierLeague.ID}
+	leeds := &Club{ID: 4, Name: "Leeds", DivisionID: championship.ID}
+	burnley := &Club{ID: 5, Name: "Burnley", DivisionID: championship.ID}
+
+	tables := map[int64]*LeagueTable{
+		premierLeague.ID: NewLeagueTable(rules, []*Club{arsenal, chelsea, spurs}, []Result{
+			{Gameweek: 1, Home: arsenal, Away: spurs, HomeGoals: 2},
+			{Gameweek: 2, Home: chelsea, Away: spurs, HomeGoals: 1},
+			{Gameweek: 3, Home: arsenal, Away: chelsea, HomeGoals: 1},
+		}, AllVenues),
+		championship.ID: NewLeagueTable(lowerRules, []*Club{leeds, burnley}, []Result{
+			{Gameweek: 1, Home: leeds, Away: burnley, HomeGoals: 1},
+		}, AllVenues),
+	}
+
+	summary := NewSeasonSummary(&Season{StartYear: 2025}, []*Division{premierLeague, championship}, tables, nil, chelsea)
+	if summary.Division != premierLeague {
+		t.Errorf("Expected the Premier League as Chelsea's division, got %v", summary.Division)
+	}
+	if summary.Champion != arsenal {
+		t.Errorf("Expected Arsenal as champions, got %s", summary.Champion.Name)
+	}
+	if len(summary.Relegated) != 1 || summary.Relegated[0].Club != spurs {
+		t.Errorf("Expected only Spurs relegated, got %v", summary.Relegated)
+	}
+	if len(summary.Promoted) != 0 {
+		t.Errorf("Expected no one promoted out of the top flight, got %v", summary.Promoted)
+	}
+	if summary.Position != 2 {
+		t.Errorf("Expected Chelsea to finish 2nd, got %d", summary.Position)
+	}
+	if len(summary.Movements) != 2 {
+		t.Errorf("Expected Leeds up and Spurs down, got %v", summary.Movements)
+	}
+
+	promoted := NewSeasonSummary(&Season{StartYear: 2025}, []*Division{premierLeague, championship}, tables, nil, leeds)
+	movement, ok := promoted.MovementFor(leeds)
+	if !ok || !movement.IsPromotion() || movement.Reason != "Champions" {
+		t.Errorf("Expected Leeds promoted as champions, got %+v", movement)
+	}
+}
@@ -0,0 +1,156 @@
+package domain
+
+import "sort"
+
+// Suitability multipliers for playing someone outside their natural position
+const (
+	suitabilityNatural   = 1.0
+	suitabilityRelated   = 0.85 // e.g. a full-back at centre-back, a winger up front
+	suitabilitySameLine  = 0.7
+	suitabilityNextLine  = 0.5
+	suitabilityFarLine   = 0.3
+	suitabilityWrongRole = 0.1 // Keepers in the outfield and outfielders in goal
+	suitabilityUnknown   = 0.5
+)
+
+// positionLines groups position codes by their line on the pitch
+var positionLines = map[string]int{
+	"GK": 0,
+	"RB": 1, "CB": 1, "LB": 1,
+	"RM": 2, "CM": 2, "LM": 2,
+	"RW": 3, "ST": 3, "LW": 3,
+}
+
+// relatedPositions lists positions a player can cover with little drop-off
+var relatedPositions = map[string][]string{
+	"RB": {"CB", "LB", "RM"},
+	"CB": {"RB", "LB"},
+	"LB": {"CB", "RB", "LM"},
+	"RM": {"CM", "RW", "RB"},
+	"CM": {"RM", "LM"},
+	"LM": {"CM", "LW", "LB"},
+	"RW": {"RM", "ST", "LW"},
+	"ST": {"RW", "LW"},
+	"LW": {"LM", "ST", "RW"},
+}
+
+// Suitability returns how well a player whose natural position is natural
+// plays in slot, from 1 (natural) down towards 0
+func Suitability(natural, slot string) float64 {
+	if natural == slot {
+		return suitabilityNatural
+	}
+
+	naturalLine, known := positionLines[natural]
+	slotLine := positionLines[slot]
+	if !known {
+		return suitabilityUnknown
+	}
+	if naturalLine == 0 || slotLine == 0 {
+		return suitabilityWrongRole
+	}
+
+	for _, related := range relatedPositions[natural] {
+		if related == slot {
+			return suitabilityRelated
+		}
+	}
+
+	switch distance := abs(naturalLine - slotLine); distance {
+	case 0:
+		return suitabilitySameLine
+	case 1:
+		return suitabilityNextLine
+	default:
+		return suitabilityFarLine
+	}
+}
+
+// SlotRating returns a player's effective quality when played in slot
+func SlotRating(player Player, slot string) float64 {
+	return float64(player.Quality) * Suitability(player.Position, slot)
+}
+
+// AutoPickLineup picks the strongest legal XI and bench for the formation.
+// Only available players are considered. Starting slots are filled greedily by
+// effective quality, marked down for tired legs. A backup keeper takes the
+// first bench spot if there is one, and the rest of the bench is the best of
+// who's left.
+func AutoPickLineup(formation Formation, players []Player) *Lineup {
+	lineup := NewLineup(formation)
+	slots := formation.Slots()
+
+	var available []Player
+	for _, player := range players {
+		if player.IsAvailable() {
+			available = append(available, player)
+		}
+	}
+
+	type candidate struct {
+		slot   int
+		player int
+		rating float64
+	}
+	var candidates []candidate
+	for slot, position := range slots {
+		for i, player := range available {
+			candidates = append(candidates, candidate{slot, i, SlotRating(player, position) * player.Freshness()})
+		}
+	}
+	// Stable so ties fall back to slot order, then squad order
+	sort.SliceStable(candidates, func(a, b int) bool {
+		return candidates[a].rating > candidates[b].rating
+	})
+
+	picked := make(map[int]bool)
+	for _, c := range candidates {
+		if lineup.Starters[c.slot] != 0 || picked[c.player] {
+			continue
+		}
+		lineup.Starters[c.slot] = available[c.player].ID
+		picked[c.player] = true
+	}
+
+	var remaining []Player
+	for i, player := range available {
+		if !picked[i] {
+			remaining = append(remaining, player)
+		}
+	}
+	sort.SliceStable(remaining, func(a, b int) bool {
+		return remaining[a].Quality > remaining[b].Quality
+	})
+
+	bench := 0
+	for i, player := range remaining {
+		if player.Position == "GK" {
+			lineup.Bench[bench] = player.ID
+			bench++
+			remaining = append(remaining[:i], remaining[i+1:]...)
+			break
+		}
+	}
+	for _, player := range remaining {
+		if bench >= len(lineup.Bench) {
+			break
+		}
+		if player.Position == "GK" {
+			continue // One backup keeper is enough
+		}
+		lineup.Bench[bench] = player.ID
+		bench++
+	}
+
+	lineup.CaptainID = lineup.bestStarter(players, false)
+	lineup.SetPieceTakerID = lineup.bestStarter(players, true)
+
+	return lineup
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,91 @@
+package domain
+
+import "testing"
+
+// TestSuitability verifies players lose effectiveness the further they play from their natural position
+func TestSuitability(t *testing.T) {
+	tests := []struct {
+		natural, slot string
+		want          float64
+	}{
+		{"CB", "CB", suitabilityNatural},
+		{"RB", "CB", suitabilityRelated},
+		{"RW", "ST", suitabilityRelated},
+		{"RB", "LM", suitabilityNextLine},
+		{"CB", "ST", suitabilityFarLine},
+		{"GK", "CB", suitabilityWrongRole},
+		{"ST", "GK", suitabilityWrongRole},
+		{"", "CM", suitabilityUnknown},
+	}
+
+	for _, tt := range tests {
+		if got := Suitability(tt.natural, tt.slot); got != tt.want {
+			t.Errorf("Suitability(%q, %q) = %.2f, want %.2f", tt.natural, tt.slot, got, tt.want)
+		}
+	}
+}
+
+// TestAutoPickLineup verifies the auto-pick plays people in position, skips
+// unavailable players and keeps a backup keeper on the bench
+func TestAutoPickLineup(t *testing.T) {
+	positions := []string{
+		"GK", "GK", "RB", "CB", "CB", "LB", "CM", "CM", "CM", "RW", "ST", "LW",
+		"CB", "CM", "ST", "RB", "LW", "CB", "GK", "ST",
+	}
+	players := testSquad(12, positions...)
+	players[1].Quality = 16                        // Better keeper on the bench in squad order
+	players[3].Injury = &Injury{DaysRemaining: 21} // First-choice CB is out
+	players[10].SuspendedMatches = 1               // First-choice ST is banned
+	players[19].Quality = 18                       // Best player is a striker
+
+	lineup := AutoPickLineup(FourThreeThree, players)
+
+	if err := lineup.Validate(players); err != nil {
+		t.Fatalf("Expected a valid lineup, got %v", err)
+	}
+
+	squad := squadByID(players)
+	for slot, position := range FourThreeThree.Slots() {
+		player := squad[lineup.Starters[slot]]
+		if player.Position != position {
+			t.Errorf("Expected a natural %s in slot %d, got %s", position, slot, player.Position)
+		}
+	}
+
+	if lineup.Starters[0] != players[1].ID {
+		t.Errorf("Expected the stronger keeper %d to start, got %d", players[1].ID, lineup.Starters[0])
+	}
+	if lineup.Contains(players[3].ID) || lineup.Contains(players[10].ID) {
+		t.Error("Expected unavailable players to be left out")
+	}
+	if bench := squad[lineup.Bench[0]]; bench.Position != "GK" {
+		t.Errorf("Expected a backup keeper first on the bench, got %s", bench.Position)
+	}
+	if lineup.CaptainID != players[19].ID {
+		t.Errorf("Expected the best starter %d as captain, got %d", players[19].ID, lineup.CaptainID)
+	}
+}
+
+// TestAutoPickLineupRestsTiredPlayers verifies a worn-out regular makes way
+// for a fresh player of similar quality, but not for a much weaker one
+func TestAutoPickLineupRestsTiredPlayers(t *testing.T) {
+	positions := []string{"GK", "RB", "CB", "CB", "LB", "CM", "CM", "CM", "RW", "ST", "LW", "ST"}
+	players := testSquad(14, positions...)
+	striker, backup := &players[9], &players[11]
+	striker.Quality = 15
+	backup.Quality = 13
+
+	if lineup := AutoPickLineup(FourThreeThree, players); !lineup.IsStarter(striker.ID) {
+		t.Error("Expected the rested first-choice striker to start")
+	}
+
+	striker.Fatigue = 40
+	if lineup := AutoPickLineup(FourThreeThree, players); lineup.IsStarter(striker.ID) || !lineup.IsStarter(backup.ID) {
+		t.Error("Expected the tired striker to be rested for the backup")
+	}
+
+	backup.Quality = 8
+	if lineup := AutoPickLineup(FourThreeThree, players); !lineup.IsStarter(striker.ID) {
+		t.Error("Expected the tired striker to start over a much weaker backup")
+	}
+}
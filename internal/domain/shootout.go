@@ -0,0 +1,157 @@
+package domain
+
+import "sort"
+
+// ShootoutKicks is how many penalties each side takes before sudden death
+const ShootoutKicks = 5
+
+// PenaltyOutcome is how a shootout kick ended
+type PenaltyOutcome int
+
+const (
+	PenaltyScored PenaltyOutcome = iota
+	PenaltySaved
+	PenaltyMissed
+)
+
+// PenaltyKick is one kick of a shootout: a duel between taker and keeper
+type PenaltyKick struct {
+	For     *MatchParticipant
+	Taker   *MatchPlayerParticipant
+	Keeper  *MatchPlayerParticipant
+	Outcome PenaltyOutcome
+}
+
+// Shootout is the penalty shootout that settles a knockout match still level
+// after extra time. The home side kicks first, and each side works through
+// its takers in order, going round again if sudden death lasts that long.
+type Shootout struct {
+	HomeTakers []*MatchPlayerParticipant
+	AwayTakers []*MatchPlayerParticipant
+	Kicks      []PenaltyKick
+	HomeKicks  int
+	HomeScored int
+	AwayKicks  int
+	AwayScored int
+}
+
+// NewShootout sets up a shootout between the players left on the pitch. A
+// side with more players must reduce to match the other, leaving its
+// weakest takers out.
+func NewShootout(home, away *MatchParticipant) *Shootout {
+	homeTakers := home.PenaltyTakers()
+	awayTakers := away.PenaltyTakers()
+	takers := min(len(homeTakers), len(awayTakers))
+
+	return &Shootout{
+		HomeTakers: homeTakers[:takers],
+		AwayTakers: awayTakers[:takers],
+		Kicks:      make([]PenaltyKick, 0, 2*ShootoutKicks),
+	}
+}
+
+// HomeToKick reports whether the home side takes the next penalty
+func (s *Shootout) HomeToKick() bool {
+	return s.HomeKicks == s.AwayKicks
+}
+
+// NextTaker returns the player stepping up for the next kick, or nil if the
+// side to kick has nobody left on the pitch
+func (s *Shootout) NextTaker() *MatchPlayerParticipant {
+	takers, kicks := s.AwayTakers, s.AwayKicks
+	if s.HomeToKick() {
+		takers, kicks = s.HomeTakers, s.HomeKicks
+	}
+	if len(takers) == 0 {
+		return nil
+	}
+	return takers[kicks%len(takers)]
+}
+
+// RecordKick adds the outcome of the next penalty
+func (s *Shootout) RecordKick(kick PenaltyKick) {
+	scored := kick.Outcome == PenaltyScored
+	s.Kicks = append(s.Kicks, kick)
+
+	if s.HomeToKick() {
+		s.HomeKicks++
+		if scored {
+			s.HomeScored++
+		}
+		return
+	}
+
+	s.AwayKicks++
+	if scored {
+		s.AwayScored++
+	}
+}
+
+// IsOver reports whether the shootout is decided: during the first five kicks
+// once one side can no longer catch up, then in sudden death after any round
+// that leaves the sides apart
+func (s *Shootout) IsOver() bool {
+	homeLeft := max(ShootoutKicks-s.HomeKicks, 0)
+	awayLeft := max(ShootoutKicks-s.AwayKicks, 0)
+	if s.HomeScored+homeLeft < s.AwayScored || s.AwayScored+awayLeft < s.HomeScored {
+		return true
+	}
+
+	return s.HomeKicks == s.AwayKicks && s.HomeKicks >= ShootoutKicks && s.HomeScored != s.AwayScored
+}
+
+// IsSuddenDeath reports whether the sides are past their first five kicks
+func (s *Shootout) IsSuddenDeath() bool {
+	return s.HomeKicks >= ShootoutKicks && s.AwayKicks >= ShootoutKicks
+}
+
+// KicksFor returns the kicks taken by one side so far, in order
+func (s *Shootout) KicksFor(participant *MatchParticipant) []PenaltyKick {
+	var kicks []PenaltyKick
+	for _, kick := range s.Kicks {
+		if kick.For == participant {
+			kicks = append(kicks, kick)
+		}
+	}
+	return kicks
+}
+
+// PenaltyTakers returns the order a side's players on the pitch would take a
+// shootout in: the set-piece taker first, then the best and freshest
+// outfielders, with the goalkeeper last
+func (p *MatchParticipant) PenaltyTakers() []*MatchPlayerParticipant {
+	takers := make([]*MatchPlayerParticipant, len(p.CurrentXI))
+	copy(takers, p.CurrentXI)
+
+	rank := func(player *MatchPlayerParticipant) int {
+		switch {
+		case player == p.SetPieceTaker:
+			return 0
+		case player.Position == "GK":
+			return 2
+		default:
+			return 1
+		}
+	}
+	sort.SliceStable(takers, func(i, j int) bool {
+		a, b := takers[i], takers[j]
+		if rank(a) != rank(b) {
+			return rank(a) < rank(b)
+		}
+		if a.Player.Quality != b.Player.Quality {
+			return a.Player.Quality > b.Player.Quality
+		}
+		return a.Stamina > b.Stamina
+	})
+	return takers
+}
+
+// Goalkeeper returns the side's keeper on the pitch, or nil if it has none
+func (p *MatchParticipant) Goalkeeper() *MatchPlayerParticipant {
+	for _, player := range p.CurrentXI {
+		if player.Position == "GK" {
+			return player
+		}
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package domain
+
+import "testing"
+
+// TestShootoutIsOver verifies shootouts end once decided, then go to sudden death
+func TestShootoutIsOver(t *testing.T) {
+	tests := []struct {
+		name  string
+		kicks []bool // In order, home first
+		want  bool
+	}{
+		{"not started", nil, false},
+		{"level after three each", []bool{true, true, true, true, true, true}, false},
+		{"decided early", []bool{true, false, true, false, true, false}, true},
+		{"level after five", []bool{true, true, true, true, true, true, true, true, true, true}, false},
+		{"sudden death home misses", []bool{true, true, true, true, true, true, true, true, true, true, false, true}, true},
+		{"sudden death both score", []bool{true, true, true, true, true, true, true, true, true, true, true, true}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shootout := &Shootout{}
+			for _, scored := range tt.kicks {
+				outcome := PenaltyMissed
+				if scored {
+					outcome = PenaltyScored
+				}
+				shootout.RecordKick(PenaltyKick{Outcome: outcome})
+			}
+			if got := shootout.IsOver(); got != tt.want {
+				t.Errorf("Expected IsOver %v, got %v (%d-%d)", tt.want, got, shootout.HomeScored, shootout.AwayScored)
+			}
+		})
+	}
+}
+
+// TestPenaltyTakers verifies the set-piece taker goes first and the keeper last
+func TestPenaltyTakers(t *testing.T) {
+	keeper := &MatchPlayerParticipant{Player: &Player{Name: "Keeper", Quality: 18}, Position: "GK", Stamina: 100}
+	star := &MatchPlayerParticipant{Player: &Player{Name: "Star", Quality: 17}, Position: "ST", Stamina: 100}
+	tired := &MatchPlayerParticipant{Player: &Player{Name: "Tired", Quality: 14}, Position: "CM", Stamina: 40}
+	fresh := &MatchPlayerParticipant{Player: &Player{Name: "Fresh", Quality: 14}, Position: "CM", Stamina: 90}
+	taker := &MatchPlayerParticipant{Player: &Player{Name: "Taker", Quality: 12}, Position: "LB", Stamina: 100}
+
+	participant := &MatchParticipant{
+		CurrentXI:     []*MatchPlayerParticipant{keeper, tired, star, fresh, taker},
+		SetPieceTaker: taker,
+	}
+
+	want := []*MatchPlayerParticipant{taker, star, fresh, tired, keeper}
+	got := participant.PenaltyTakers()
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Taker %d: expected %s, got %s", i+1, want[i].Player.Name, got[i].Player.Name)
+		}
+	}
+	if participant.Goalkeeper() != keeper {
+		t.Error("Expected the keeper to be found")
+	}
+}
+
+// TestNewShootoutEvensNumbers verifies a side with more players left drops
+// its weakest takers, and takers go round again in sudden death
+func TestNewShootoutEvensNumbers(t *testing.T) {
+	players := func(n int) []*MatchPlayerParticipant {
+		xi := make([]*MatchPlayerParticipant, n)
+		for i := range xi {
+			xi[i] = &MatchPlayerParticipant{Player: &Player{Quality: 20 - i}, Position: "CM", Stamina: 100}
+		}
+		return xi
+	}
+	home := &MatchParticipant{CurrentXI: players(11)}
+	away := &MatchParticipant{CurrentXI: players(10)}
+
+	shootout := NewShootout(home, away)
+	if len(shootout.HomeTakers) != 10 || len(shootout.AwayTakers) != 10 {
+		t.Fatalf("Expected 10 takers each, got %d and %d", len(shootout.HomeTakers), len(shootout.AwayTakers))
+	}
+	if shootout.HomeTakers[9] == home.CurrentXI[10] {
+		t.Error("Expected the home side's weakest taker to be left out")
+	}
+
+	for i := 0; i < 20; i++ {
+		shootout.RecordKick(PenaltyKick{Outcome: PenaltyScored})
+	}
+	if shootout.NextTaker() != shootout.HomeTakers[0] {
+		t.Error("Expected the first taker to go again once everyone has taken one")
+	}
+}
@@ -0,0 +1,129 @@
+package domain
+
+import "math"
+
+// FormMatches is how many recent appearances count towards a player's form
+const FormMatches = 5
+
+// Match rating adjustments, out of 10
+const (
+	baseRating       = 6.0
+	goalRating       = 1.0
+	winRating        = 0.5
+	cleanSheetRating = 0.5
+	yellowRating     = -0.5
+	redRating        = -2.0
+	minRating        = 1.0
+	maxRating        = 10.0
+)
+
+// Appearance is one player's contribution to a single match
+type Appearance struct {
+	PlayerID    int64
+	FixtureID   int
+	Started     bool
+	Goals       int
+	YellowCards int
+	RedCards    int
+	Rating      float64
+}
+
+// SeasonStats totals a player's appearances
+type SeasonStats struct {
+	Appearances   int
+	Starts        int
+	Goals         int
+	YellowCards   int
+	RedCards      int
+	AverageRating float64
+	Form          float64 // Average rating over the last FormMatches appearances
+}
+
+// NewSeasonStats totals a player's appearances, which must be ordered most recent first
+func NewSeasonStats(appearances []Appearance) SeasonStats {
+	stats := SeasonStats{Appearances: len(appearances)}
+	if len(appearances) == 0 {
+		return stats
+	}
+
+	total, recent := 0.0, 0.0
+	for i, appearance := range appearances {
+		if appearance.Started {
+			stats.Starts++
+		}
+		stats.Goals += appearance.Goals
+		stats.YellowCards += appearance.YellowCards
+		stats.RedCards += appearance.RedCards
+		total += appearance.Rating
+		if i < FormMatches {
+			recent += appearance.Rating
+		}
+	}
+
+	stats.AverageRating = total / float64(len(appearances))
+	stats.Form = recent / float64(min(len(appearances), FormMatches))
+	return stats
+}
+
+// Appearances returns a rated appearance for everyone who took the pitch for either side
+func (m *Match) Appearances() []Appearance {
+	appearances := make([]Appearance, 0)
+	homeScore, awayScore := m.GetScore()
+
+	for _, participant := range []*MatchParticipant{m.Home, m.Away} {
+		scored, conceded := homeScore, awayScore
+		if participant == m.Away {
+			scored, conceded = conceded, scored
+		}
+
+		for _, player := range participant.Appeared {
+			appearance := Appearance{
+				PlayerID:  player.Player.ID,
+				FixtureID: m.ForFixture.ID,
+				Started:   player.Started,
+			}
+
+			for _, event := range m.Events {
+				if event.Player != player {
+					continue
+				}
+				switch event.Type {
+				case GoalEvent:
+					appearance.Goals++
+				case YellowCardEvent:
+					appearance.YellowCards++
+				case RedCardEvent:
+					appearance.RedCards++
+				}
+			}
+
+			appearance.Rating = matchRating(appearance, player.Player.Position, scored, conceded)
+			appearances = append(appearances, appearance)
+		}
+	}
+
+	return appearances
+}
+
+// matchRating scores an appearance out of 10 from the player's goals, cards
+// and the team's result
+func matchRating(appearance Appearance, position string, scored, conceded int) float64 {
+	rating := baseRating
+	rating += goalRating * float64(appearance.Goals)
+	rating += yellowRating * float64(appearance.YellowCards)
+	rating += redRating * float64(appearance.RedCards)
+
+	switch {
+	case scored > conceded:
+		rating += winRating
+	case scored < conceded:
+		rating -= winRating
+	}
+
+	// Keepers and defenders get credit for a clean sheet
+	if line, known := positionLines[position]; known && line <= 1 && conceded == 0 {
+		rating += cleanSheetRating
+	}
+
+	return math.Max(minRating, math.Min(maxRating, rating))
+}
@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"math"
+	"testing"
+)
+
+// TestNewSeasonStats verifies totals and that form only counts the most recent appearances
+func TestNewSeasonStats(t *testing.T) {
+	ratings := []float64{8, 8, 8, 8, 8, 4, 4}
+	appearances := make([]Appearance, len(ratings))
+	for i, rating := range ratings {
+		appearances[i] = Appearance{Started: i%2 == 0, Goals: 1, Rating: rating}
+	}
+	appearances[6].YellowCards = 1
+
+	stats := NewSeasonStats(appearances)
+
+	if stats.Appearances != 7 || stats.Starts != 4 || stats.Goals != 7 || stats.YellowCards != 1 {
+		t.Errorf("Unexpected totals: %+v", stats)
+	}
+	if stats.Form != 8 {
+		t.Errorf("Expected form 8 from the last %d appearances, got %.2f", FormMatches, stats.Form)
+	}
+	if want := 48.0 / 7; math.Abs(stats.AverageRating-want) > 1e-9 {
+		t.Errorf("Expected average rating %.2f, got %.2f", want, stats.AverageRating)
+	}
+}
+
+// TestMatchAppearances verifies everyone who took the pitch is rated,
+// including substitutes and players sent off
+func TestMatchAppearances(t *testing.T) {
+	clubs := testClubs(2, 18, 1, 10)
+	match := NewMatchFromFixture(&Fixture{ID: 3, HomeTeam: clubs[0], AwayTeam: clubs[1]})
+
+	scorer := match.Home.CurrentXI[9]
+	match.AddEvent(NewEvent(GoalEvent, 30, match.Home, scorer))
+	match.SendOff(match.Home, match.Home.CurrentXI[5])
+	sub := match.Home.Bench[2]
+	match.Home.MakeSubstitution(sub, scorer)
+
+	appearances := match.Appearances()
+	if len(appearances) != 2*StartingXISize+1 {
+		t.Fatalf("Expected %d appearances, got %d", 2*StartingXISize+1, len(appearances))
+	}
+
+	byPlayer := make(map[int64]Appearance)
+	for _, appearance := range appearances[:StartingXISize+1] {
+		byPlayer[appearance.PlayerID] = appearance
+	}
+
+	if got := byPlayer[scorer.Player.ID]; got.Goals != 1 || got.Rating != baseRating+goalRating+winRating {
+		t.Errorf("Expected scorer to have 1 goal and a %.1f rating, got %+v", baseRating+goalRating+winRating, got)
+	}
+	if got, ok := byPlayer[sub.Player.ID]; !ok || got.Started {
+		t.Errorf("Expected substitute to appear off the bench, got %+v", got)
+	}
+	if got := byPlayer[clubs[0].Players[0].ID]; got.Rating != baseRating+winRating+cleanSheetRating {
+		t.Errorf("Expected keeper clean sheet rating %.1f, got %.1f", baseRating+winRating+cleanSheetRating, got.Rating)
+	}
+	if got := appearances[len(appearances)-1]; got.Rating != baseRating-winRating {
+		t.Errorf("Expected losing side rating %.1f, got %.1f", baseRating-winRating, got.Rating)
+	}
+}
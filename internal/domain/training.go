@@ -0,0 +1,235 @@
+package domain
+
+import (
+	"math/rand/v2"
+	"time"
+)
+
+// TrainingIntensity is how hard a club works its players between matches.
+// Harder training sharpens them and speeds their development, but leaves
+// them more tired and more likely to pick up an injury.
+type TrainingIntensity int
+
+const (
+	IntensityLight TrainingIntensity = iota
+	IntensityNormal
+	IntensityIntense
+)
+
+var trainingIntensityNames = map[TrainingIntensity]string{
+	IntensityLight:   "Light",
+	IntensityNormal:  "Normal",
+	IntensityIntense: "Intense",
+}
+
+func (i TrainingIntensity) String() string {
+	return trainingIntensityNames[i]
+}
+
+// TrainingFocus is what training concentrates on. A squad works on one
+// focus together, and any player can be given one of their own instead.
+type TrainingFocus int
+
+const (
+	FocusGeneral TrainingFocus = iota
+	FocusAttacking
+	FocusDefending
+	FocusSetPieces
+	FocusFitness
+)
+
+var trainingFocusNames = map[TrainingFocus]string{
+	FocusGeneral:   "General",
+	FocusAttacking: "Attacking",
+	FocusDefending: "Defending",
+	FocusSetPieces: "Set pieces",
+	FocusFitness:   "Fitness",
+}
+
+func (f TrainingFocus) String() string {
+	return trainingFocusNames[f]
+}
+
+// TrainingPlan is a club's weekly training schedule
+type TrainingPlan struct {
+	Intensity TrainingIntensity
+	Focus     TrainingFocus
+}
+
+// DefaultTrainingPlan is the schedule every club starts with
+var DefaultTrainingPlan = TrainingPlan{Intensity: IntensityNormal, Focus: FocusGeneral}
+
+// FocusFor is what the player works on in training: their own focus if
+// they have one, otherwise the squad's
+func (t TrainingPlan) FocusFor(player Player) TrainingFocus {
+	if player.TrainingFocus != FocusGeneral {
+		return player.TrainingFocus
+	}
+	return t.Focus
+}
+
+// trainingLoad is what a week's training at an intensity does to a player:
+// the sharpness and development points they gain, the fatigue they carry
+// into the next week, and their chance of getting injured
+type trainingLoad struct {
+	sharpness    int
+	progress     int
+	fatigue      int
+	injuryChance float64
+}
+
+var trainingLoads = map[TrainingIntensity]trainingLoad{
+	IntensityLight:   {sharpness: 3, progress: 0, fatigue: -10, injuryChance: 0.002},
+	IntensityNormal:  {sharpness: 6, progress: 1, fatigue: 0, injuryChance: 0.005},
+	IntensityIntense: {sharpness: 10, progress: 2, fatigue: 10, injuryChance: 0.012},
+}
+
+// Match sharpness runs from 0 to MaxSharpness. Players lose sharpnessDecay
+// a week, win back a point for every sharpnessMinutes they play, up to
+// weeklyMinutes' worth, and make up some of the rest in training.
+const (
+	MaxSharpness     = 100
+	sharpnessDecay   = 15
+	sharpnessMinutes = 3
+	weeklyMinutes    = 180
+)
+
+// Working on a focus that suits them earns a player focusProgress extra
+// development points a week. A fitness focus adds fitnessSharpness to their
+// sharpness and takes fitnessRecovery off their fatigue.
+const (
+	focusProgress    = 1
+	fitnessSharpness = 4
+	fitnessRecovery  = 5
+)
+
+// focusLines is the lines of the pitch a focus helps develop
+var focusLines = map[TrainingFocus][]int{
+	FocusAttacking: {2, 3},
+	FocusDefending: {0, 1},
+}
+
+// TrainingWeek puts the club's squad through the week's training to the day
+// on its plan, given the minutes each player played over the week, and
+// returns the injuries picked up. Injured players sit training out and lose
+// sharpness.
+func TrainingWeek(club *ClubWithPlayers, minutes map[int64]int, today time.Time, rng *rand.Rand) []Injury {
+	load := trainingLoads[club.Club.Training.Intensity]
+
+	var injuries []Injury
+	for i := range club.Players {
+		player := &club.Players[i]
+		sharpness := player.Sharpness - sharpnessDecay + min(minutes[player.ID], weeklyMinutes)/sharpnessMinutes
+		if player.Injury != nil {
+			player.Sharpness = max(0, min(MaxSharpness, sharpness))
+			continue
+		}
+
+		focus := club.Club.Training.FocusFor(*player)
+		sharpness += load.sharpness
+		fatigue := player.Fatigue + load.fatigue
+		if focus == FocusFitness {
+			sharpness += fitnessSharpness
+			fatigue -= fitnessRecovery
+		}
+		player.Sharpness = max(0, min(MaxSharpness, sharpness))
+		player.Fatigue = max(0, min(100, fatigue))
+		player.addProgress(trainingPoints(*player, load, focus, today))
+
+		if rng.Float64() < load.injuryChance*(1+float64(player.Fatigue)/100) {
+			player.Injury = NewRandomInjury(player, float64(100-player.Fatigue))
+			injuries = append(injuries, *player.Injury)
+		}
+	}
+	return injuries
+}
+
+// trainingPoints is how far the week's training to the day moves a player
+// towards their next point of quality. Players still growing gain from the
+// intensity and from a focus on their line; veterans gain nothing, though
+// fitness work slows their decline.
+func trainingPoints(player Player, load trainingLoad, focus TrainingFocus, today time.Time) int {
+	if player.AgeOn(today) >= declineAge {
+		if focus == FocusFitness {
+			return focusProgress
+		}
+		return 0
+	}
+	if player.Quality >= player.Potential {
+		return 0
+	}
+
+	points := load.progress
+	for _, line := range focusLines[focus] {
+		if positionLines[player.Position] == line {
+			points += focusProgress
+		}
+	}
+	return points
+}
+
+// Familiarity with a formation grows by a match each time a club plays it in
+// a row, up to maxFamiliarity, and adds familiarityBonus to the side's
+// strength for each match
+const (
+	maxFamiliarity   = 5
+	familiarityBonus = 0.2
+)
+
+// setPieceEdge is how much likelier a player who trains on set pieces is to
+// score a penalty
+const setPieceEdge = 0.05
+
+// FamiliarityWith is how many matches running the club has played the
+// formation, or 0 if it last played another
+func (c *Club) FamiliarityWith(formation string) int {
+	if c.Formation != formation {
+		return 0
+	}
+	return c.Familiarity
+}
+
+// PlayedFormation records the club playing a match in the formation
+func (c *Club) PlayedFormation(formation string) {
+	c.Familiarity = min(maxFamiliarity, c.FamiliarityWith(formation)+1)
+	c.Formation = formation
+}
+
+// FamiliarityBonus is the strength the side gains from knowing its
+// formation well
+func (p *MatchParticipant) FamiliarityBonus() float64 {
+	return familiarityBonus * float64(p.Familiarity)
+}
+
+// sharpnessPenalty is the quality a player with no match sharpness at all
+// loses
+const sharpnessPenalty = 2.0
+
+// MatchQuality is what the player brings to a match: their quality, less up
+// to sharpnessPenalty the less match-sharp they are, and their morale bonus
+func (p Player) MatchQuality() float64 {
+	return float64(p.Quality) - sharpnessPenalty*float64(MaxSharpness-p.Sharpness)/MaxSharpness + p.MoraleBonus()
+}
+
+// PhaseStrength is the side's strength in a phase of play: the average
+// match quality of the players on the pitch, and its familiarity and
+// cohesion bonuses
+func (p *MatchParticipant) PhaseStrength() float64 {
+	if len(p.CurrentXI) == 0 {
+		return 0
+	}
+	total := 0.0
+	for _, player := range p.CurrentXI {
+		total += player.Player.MatchQuality()
+	}
+	return total/float64(len(p.CurrentXI)) + p.FamiliarityBonus() + p.CohesionBonus()
+}
+
+// PenaltyEdge is how much likelier the player is to score a penalty for
+// having trained on set pieces
+func (p *MatchParticipant) PenaltyEdge(taker *MatchPlayerParticipant) float64 {
+	if p.Club == nil || p.Club.Training.FocusFor(*taker.Player) != FocusSetPieces {
+		return 0
+	}
+	return setPieceEdge
+}
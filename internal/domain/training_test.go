@@ -0,0 +1,102 @@
+package domain
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+// TestTrainingWeek verifies players who play stay sharp, those who don't
+// lose their edge, and harder training leaves them more tired
+func TestTrainingWeek(t *testing.T) {
+	squad := func(intensity TrainingIntensity) *ClubWithPlayers {
+		club := testClubs(1, 3, 0, 10)[0]
+		club.Club.Training.Intensity = intensity
+		for i := range club.Players {
+			club.Players[i].Sharpness = 80
+			club.Players[i].Fatigue = 20
+		}
+		club.Players[2].Injury = &Injury{PlayerID: 102, DaysRemaining: 10}
+		return club
+	}
+	light, intense := squad(IntensityLight), squad(IntensityIntense)
+	minutes := map[int64]int{100: 90}
+
+	rng := rand.New(rand.NewPCG(1, 2))
+	TrainingWeek(light, minutes, testSeasonStart, rng)
+	TrainingWeek(intense, minutes, testSeasonStart, rng)
+
+	if got := light.Players[0].Sharpness; got != 98 {
+		t.Errorf("Expected a full match to sharpen a player up, got %d", got)
+	}
+	if got := light.Players[1].Sharpness; got != 68 {
+		t.Errorf("Expected a player left out to lose sharpness, got %d", got)
+	}
+	if got := intense.Players[1].Sharpness; got != 75 {
+		t.Errorf("Expected intense training to make up more sharpness, got %d", got)
+	}
+	if light.Players[1].Fatigue != 10 || intense.Players[1].Fatigue != 30 {
+		t.Errorf("Expected light training to rest players and intense to tire them, got %d and %d",
+			light.Players[1].Fatigue, intense.Players[1].Fatigue)
+	}
+	if got := light.Players[2]; got.Sharpness != 65 || got.Fatigue != 20 {
+		t.Errorf("Expected an injured player to sit training out, got %+v", got)
+	}
+}
+
+// TestTrainingFocus verifies a focus on a player's line speeds their
+// development, and a player's own focus overrides the squad's
+func TestTrainingFocus(t *testing.T) {
+	plan := TrainingPlan{Intensity: IntensityNormal, Focus: FocusAttacking}
+	load := trainingLoads[plan.Intensity]
+	striker := Player{Position: "ST", Quality: 10, Potential: 15, DateOfBirth: bornAged(20)}
+	defender := Player{Position: "CB", Quality: 10, Potential: 15, DateOfBirth: bornAged(20)}
+
+	if got := trainingPoints(striker, load, plan.FocusFor(striker), testSeasonStart); got != 2 {
+		t.Errorf("Expected a striker to gain from an attacking focus, got %d", got)
+	}
+	if got := trainingPoints(defender, load, plan.FocusFor(defender), testSeasonStart); got != 1 {
+		t.Errorf("Expected a defender to gain only from the intensity, got %d", got)
+	}
+
+	defender.TrainingFocus = FocusDefending
+	if got := trainingPoints(defender, load, plan.FocusFor(defender), testSeasonStart); got != 2 {
+		t.Errorf("Expected a defender's own focus to count, got %d", got)
+	}
+}
+
+// TestFamiliarity verifies a club grows used to a formation by playing it
+// repeatedly, and loses that by switching
+func TestFamiliarity(t *testing.T) {
+	club := &Club{}
+	for range maxFamiliarity + 2 {
+		club.PlayedFormation("4-3-3")
+	}
+	if club.FamiliarityWith("4-3-3") != maxFamiliarity {
+		t.Errorf("Expected familiarity to build to %d, got %d", maxFamiliarity, club.Familiarity)
+	}
+	if club.FamiliarityWith("4-4-2") != 0 {
+		t.Error("Expected no familiarity with a formation not played")
+	}
+
+	club.PlayedFormation("4-4-2")
+	if club.FamiliarityWith("4-4-2") != 1 || club.FamiliarityWith("4-3-3") != 0 {
+		t.Errorf("Expected a change of formation to start again, got %+v", club)
+	}
+}
+
+// TestPhaseStrength verifies a side loses strength for players short of
+// sharpness and gains it for knowing its formation
+func TestPhaseStrength(t *testing.T) {
+	sharp := &Player{Quality: 10, Sharpness: MaxSharpness, Morale: NeutralMorale}
+	rusty := &Player{Quality: 10, Sharpness: 50, Morale: NeutralMorale}
+	side := &MatchParticipant{CurrentXI: []*MatchPlayerParticipant{{Player: sharp}, {Player: rusty}}}
+
+	if got := side.PhaseStrength(); got != 9.5 {
+		t.Errorf("Expected a half-sharp player to cost half a point between two, got %.2f", got)
+	}
+
+	side.Familiarity = maxFamiliarity
+	if got := side.PhaseStrength(); got != 10.5 {
+		t.Errorf("Expected a familiar formation to add a point, got %.2f", got)
+	}
+}
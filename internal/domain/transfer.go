@@ -0,0 +1,272 @@
+package domain
+
+import (
+	"math"
+	"time"
+)
+
+// Squads have to stay big enough to name a side and a bench, and small
+// enough to manage
+const (
+	MinimumSquadSize = 16
+	MaximumSquadSize = 30
+)
+
+// MaxBids is how many bids a selling club will hear before breaking off talks
+const MaxBids = 3
+
+// derisoryBidShare is the share of the asking price below which a bid is
+// turned down without a counter-offer
+const derisoryBidShare = 0.6
+
+// TransferWindow is a stretch of the season in which players can change clubs
+type TransferWindow struct {
+	Name   string
+	Opens  time.Time
+	Closes time.Time // The last day deals can be done
+}
+
+// TransferWindows returns a season's windows: the summer window through
+// pre-season to 1 September, and the January window to 2 February
+func TransferWindows(startYear int) []TransferWindow {
+	return []TransferWindow{
+		{
+			Name:   "Summer",
+			Opens:  PreseasonStart(startYear),
+			Closes: time.Date(startYear, time.September, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Name:   "January",
+			Opens:  time.Date(startYear+1, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Closes: time.Date(startYear+1, time.February, 2, 0, 0, 0, 0, time.UTC),
+		},
+	}
+}
+
+// OpenTransferWindow returns the window open on the day, or nil if the
+// window is shut
+func OpenTransferWindow(startYear int, day time.Time) *TransferWindow {
+	for _, window := range TransferWindows(startYear) {
+		if !day.Before(window.Opens) && !day.After(window.Closes) {
+			return &window
+		}
+	}
+	return nil
+}
+
+// NextTransferWindow returns the next window to open after the day, or nil
+// if there are none left this season
+func NextTransferWindow(startYear int, day time.Time) *TransferWindow {
+	for _, window := range TransferWindows(startYear) {
+		if window.Opens.After(day) {
+			return &window
+		}
+	}
+	return nil
+}
+
+// Valuation is what the player would fetch on the market on the day
+func (p Player) Valuation(today time.Time) int64 {
+	return marketValue(p.Quality, p.AgeOn(today))
+}
+
+// marketValue is what a player of the quality and age would fetch. Value
+// climbs steeply with quality, with a premium for youngsters still to peak
+// and a discount once a player is past thirty. An age of 0 is unknown.
+func marketValue(quality, age int) int64 {
+	value := 50_000 * math.Pow(1.45, float64(quality))
+
+	switch {
+	case age == 0:
+	case age <= 21:
+		value *= 1.25
+	case age <= 27:
+	case age <= 30:
+		value *= 0.75
+	case age <= 32:
+		value *= 0.5
+	default:
+		value *= 0.3
+	}
+
+	return RoundFee(int64(value))
+}
+
+// RoundFee rounds a fee to the nearest £10k, or the nearest £50k from £1m up
+func RoundFee(fee int64) int64 {
+	step := int64(10_000)
+	if fee >= 1_000_000 {
+		step = 50_000
+	}
+	return max((fee+step/2)/step*step, step)
+}
+
+// Transfer is a player's move from one club to another. A loan moves the
+// player for the rest of the season without a fee, still under contract to
+// their own club.
+type Transfer struct {
+	Player   Player
+	From     *Club
+	To       *Club
+	Fee      int64
+	Date     time.Time
+	Loan     bool
+	Contract *Contract // The terms the player signs with their new club, nil for a loan
+}
+
+// MarketEntry is a player available on the transfer market and the club that
+// holds their registration
+type MarketEntry struct {
+	Player Player
+	Club   *ClubWithPlayers
+}
+
+// NegotiationStatus is where talks over a transfer have got to
+type NegotiationStatus int
+
+const (
+	NegotiationOpen NegotiationStatus = iota
+	NegotiationAgreed
+	NegotiationCollapsed
+)
+
+// BidResponse is how the selling club answers a bid
+type BidResponse int
+
+const (
+	BidAccepted BidResponse = iota
+	BidCountered
+	BidRejected
+)
+
+// Negotiation is a club's attempt to buy a player, one bid at a time. The
+// seller sets an asking price from the player's value and standing in the
+// squad, counters bids within reach, and gives up after MaxBids.
+type Negotiation struct {
+	Player  Player
+	Seller  *Club
+	Buyer   *Club
+	Asking  int64
+	Counter int64   // The seller's latest counter-offer, 0 before one is made
+	Bids    []int64 // Every fee offered so far
+	Status  NegotiationStatus
+	Refusal string   // Why the seller won't deal at any price, if they won't
+	squad   []Player // The buyer's squad, for drawing up the player's contract
+}
+
+// NewNegotiation opens talks with the player's club on the day. Sellers won't
+// let their squad drop below MinimumSquadSize or lose their last goalkeeper,
+// players on loan can't be sold by the club borrowing them, and a buyer can't
+// take their squad past MaximumSquadSize or sign anyone under a transfer
+// embargo.
+func NewNegotiation(player Player, seller *ClubWithPlayers, buyer *ClubWithPlayers, today time.Time) *Negotiation {
+	n := &Negotiation{
+		Player: player,
+		Seller: seller.Club,
+		Buyer:  buyer.Club,
+		Asking: AskingPrice(player, seller.Players, today),
+		squad:  buyer.Players,
+	}
+
+	keepers := 0
+	for _, teammate := range seller.Players {
+		if teammate.Position == "GK" {
+			keepers++
+		}
+	}
+
+	switch {
+	case player.LoanedFrom != 0:
+		n.Refusal = player.Name + " is only on loan at " + seller.Club.Name
+	case len(seller.Players) <= MinimumSquadSize:
+		n.Refusal = seller.Club.Name + " can't spare any more players"
+	case player.Position == "GK" && keepers <= 1:
+		n.Refusal = seller.Club.Name + " won't sell their only goalkeeper"
+	case len(buyer.Players) >= MaximumSquadSize:
+		n.Refusal = buyer.Club.Name + " have no room in the squad"
+	case buyer.BoardStance() == BoardEmbargo:
+		n.Refusal = buyer.Club.Name + " are under a transfer embargo"
+	}
+	if n.Refusal != "" {
+		n.Status = NegotiationCollapsed
+	}
+
+	// A release clause caps what the seller can ask
+	if clause := player.Contract.ReleaseClause; clause > 0 {
+		n.Asking = min(n.Asking, clause)
+	}
+
+	return n
+}
+
+// AskingPrice is what a club wants for one of its players on the day. Its
+// best eleven players are priced higher than those on the fringes of the
+// squad.
+func AskingPrice(player Player, squad []Player, today time.Time) int64 {
+	better := 0
+	for _, teammate := range squad {
+		if teammate.Quality > player.Quality {
+			better++
+		}
+	}
+
+	premium := 1.15
+	if better < 11 {
+		premium = 1.5
+	}
+	return RoundFee(int64(float64(player.Valuation(today)) * premium))
+}
+
+// Bid offers the seller a fee. A bid that meets the asking price, or the
+// seller's last counter-offer, is accepted. A derisory bid is rejected
+// outright; anything closer draws a counter-offer that meets the buyer a
+// third of the way. Talks collapse when the seller runs out of patience.
+func (n *Negotiation) Bid(fee int64) BidResponse {
+	if n.Status != NegotiationOpen {
+		return BidRejected
+	}
+	n.Bids = append(n.Bids, fee)
+
+	target := n.Asking
+	if n.Counter > 0 {
+		target = n.Counter
+	}
+
+	response := BidRejected
+	switch {
+	case fee >= target:
+		n.Status = NegotiationAgreed
+		return BidAccepted
+	case float64(fee) >= float64(n.Asking)*derisoryBidShare:
+		n.Counter = RoundFee(target - (target-fee)/3)
+		response = BidCountered
+	}
+
+	if len(n.Bids) >= MaxBids {
+		n.Status = NegotiationCollapsed
+		return BidRejected
+	}
+	return response
+}
+
+// Fee returns the agreed fee, or 0 until a bid has been accepted
+func (n *Negotiation) Fee() int64 {
+	if n.Status != NegotiationAgreed {
+		return 0
+	}
+	return n.Bids[len(n.Bids)-1]
+}
+
+// Transfer returns the move talks have agreed, dated the day it goes
+// through, with the player on a new contract at the buying club
+func (n *Negotiation) Transfer(date time.Time) *Transfer {
+	contract := NewContract(n.Player, n.squad, date)
+	return &Transfer{
+		Player:   n.Player,
+		From:     n.Seller,
+		To:       n.Buyer,
+		Fee:      n.Fee(),
+		Date:     date,
+		Contract: &contract,
+	}
+}
@@ -0,0 +1,150 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+// TestTransferWindows verifies the windows open over the summer and in
+// January and are shut in between
+func TestTransferWindows(t *testing.T) {
+	tests := []struct {
+		day  string
+		want string
+	}{
+		{day: "2025-07-01", want: "Summer"},
+		{day: "2025-09-01", want: "Summer"},
+		{day: "2025-09-02", want: ""},
+		{day: "2026-01-01", want: "January"},
+		{day: "2026-02-02", want: "January"},
+		{day: "2026-02-03", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.day, func(t *testing.T) {
+			got := ""
+			if window := OpenTransferWindow(2025, ParseDate(tt.day)); window != nil {
+				got = window.Name
+			}
+			if got != tt.want {
+				t.Errorf("Expected %q window open, got %q", tt.want, got)
+			}
+		})
+	}
+
+	next := NextTransferWindow(2025, ParseDate("2025-10-01"))
+	if next == nil || next.Name != "January" {
+		t.Errorf("Expected the January window next, got %+v", next)
+	}
+	if next := NextTransferWindow(2025, ParseDate("2026-03-01")); next != nil {
+		t.Errorf("Expected no window left in the season, got %+v", next)
+	}
+}
+
+// TestValuation verifies better and younger players are worth more, and
+// fees come out rounded
+func TestValuation(t *testing.T) {
+	average := Player{Quality: 12, DateOfBirth: bornAged(25)}
+	better := Player{Quality: 15, DateOfBirth: bornAged(25)}
+	younger := Player{Quality: 12, DateOfBirth: bornAged(20)}
+	veteran := Player{Quality: 12, DateOfBirth: bornAged(34)}
+
+	if better.Valuation(testSeasonStart) <= average.Valuation(testSeasonStart) {
+		t.Errorf("Expected quality to raise value, got %d and %d", better.Valuation(testSeasonStart), average.Valuation(testSeasonStart))
+	}
+	if younger.Valuation(testSeasonStart) <= average.Valuation(testSeasonStart) || veteran.Valuation(testSeasonStart) >= average.Valuation(testSeasonStart) {
+		t.Errorf("Expected value to fall with age, got %d, %d and %d",
+			younger.Valuation(testSeasonStart), average.Valuation(testSeasonStart), veteran.Valuation(testSeasonStart))
+	}
+	if value := better.Valuation(testSeasonStart); value%50_000 != 0 {
+		t.Errorf("Expected a fee rounded to £50k, got %d", value)
+	}
+}
+
+// TestAskingPrice verifies clubs ask more for their best players
+func TestAskingPrice(t *testing.T) {
+	squad := testClubs(1, 20, 2, 10)[0]
+	star := Player{Quality: 10, DateOfBirth: bornAged(25)}
+	squad.Players = append(squad.Players, Player{Quality: 12, DateOfBirth: bornAged(25)})
+
+	if got := AskingPrice(star, squad.Players, testSeasonStart); got < star.Valuation(testSeasonStart) {
+		t.Errorf("Expected an asking price above value, got %d for %d", got, star.Valuation(testSeasonStart))
+	}
+
+	fringe := Player{Quality: 5, DateOfBirth: bornAged(25)}
+	if AskingPrice(fringe, squad.Players, testSeasonStart)*star.Valuation(testSeasonStart) >= AskingPrice(star, squad.Players, testSeasonStart)*fringe.Valuation(testSeasonStart) {
+		t.Error("Expected a smaller premium on a fringe player")
+	}
+}
+
+// TestNegotiation verifies bids are accepted, countered, rejected and that
+// talks collapse after too many bids
+func TestNegotiation(t *testing.T) {
+	clubs := testClubs(2, 20, 2, 10)
+	seller, buyer := clubs[0], clubs[1]
+	player := seller.Players[5]
+
+	t.Run("accepts the asking price", func(t *testing.T) {
+		n := NewNegotiation(player, seller, buyer, testSeasonStart)
+		if got := n.Bid(n.Asking); got != BidAccepted || n.Fee() != n.Asking {
+			t.Errorf("Expected the asking price accepted, got %v with fee %d", got, n.Fee())
+		}
+		transfer := n.Transfer(time.Date(2025, time.August, 1, 0, 0, 0, 0, time.UTC))
+		if transfer.From != seller.Club || transfer.To != buyer.Club || transfer.Fee != n.Asking {
+			t.Errorf("Expected the agreed move, got %+v", transfer)
+		}
+	})
+
+	t.Run("counters a close bid", func(t *testing.T) {
+		n := NewNegotiation(player, seller, buyer, testSeasonStart)
+		bid := n.Asking * 8 / 10
+		if got := n.Bid(bid); got != BidCountered {
+			t.Fatalf("Expected a counter-offer, got %v", got)
+		}
+		if n.Counter <= bid || n.Counter >= n.Asking {
+			t.Errorf("Expected a counter between %d and %d, got %d", bid, n.Asking, n.Counter)
+		}
+		if got := n.Bid(n.Counter); got != BidAccepted {
+			t.Errorf("Expected the counter-offer accepted, got %v", got)
+		}
+	})
+
+	t.Run("collapses after too many bids", func(t *testing.T) {
+		n := NewNegotiation(player, seller, buyer, testSeasonStart)
+		for range MaxBids {
+			if got := n.Bid(n.Asking / 4); got != BidRejected {
+				t.Fatalf("Expected a derisory bid rejected, got %v", got)
+			}
+		}
+		if n.Status != NegotiationCollapsed {
+			t.Errorf("Expected talks to collapse after %d bids", MaxBids)
+		}
+		if got := n.Bid(n.Asking); got != BidRejected {
+			t.Errorf("Expected no more bids heard, got %v", got)
+		}
+	})
+}
+
+// TestNegotiationRefusals verifies clubs won't deal when a squad would end
+// up too small or too big, or without a goalkeeper
+func TestNegotiationRefusals(t *testing.T) {
+	tests := []struct {
+		name   string
+		seller *ClubWithPlayers
+		buyer  *ClubWithPlayers
+		player int
+	}{
+		{name: "seller squad too small", seller: testClubs(1, MinimumSquadSize, 2, 10)[0], buyer: testClubs(2, 20, 2, 10)[1], player: 5},
+		{name: "only goalkeeper", seller: testClubs(1, 20, 1, 10)[0], buyer: testClubs(2, 20, 2, 10)[1], player: 0},
+		{name: "buyer squad full", seller: testClubs(1, 20, 2, 10)[0], buyer: testClubs(2, MaximumSquadSize, 2, 10)[1], player: 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := NewNegotiation(tt.seller.Players[tt.player], tt.seller, tt.buyer, testSeasonStart)
+			if n.Status != NegotiationCollapsed || n.Refusal == "" {
+				t.Errorf("Expected talks refused, got %+v", n)
+			}
+		})
+	}
+}
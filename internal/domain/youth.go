@@ -0,0 +1,198 @@
+package domain
+
+import "math/rand/v2"
+
+// Every summer each club's academy produces between minIntake and maxIntake
+// players ready for the squad
+const (
+	minIntake = 2
+	maxIntake = 4
+)
+
+// academySquadSize is how big an AI club lets its squad grow with graduates.
+// Beyond it they take on only the best of the intake.
+const academySquadSize = 24
+
+// homeShare is the share of a pyramid club's graduates who are English
+const homeShare = 0.75
+
+// linePositions lists the positions in each line of the pitch
+var linePositions = [][]string{
+	{"GK"},
+	{"RB", "CB", "LB"},
+	{"RM", "CM", "LM"},
+	{"RW", "ST", "LW"},
+}
+
+// Graduate is a youngster joining a club's squad, from its academy or to
+// take the place of a player who retired
+type Graduate struct {
+	Player Player
+	Club   *Club
+}
+
+// AcademyRating is how good a club's youth setup is, out of 20: about as
+// good as the club, give or take a few points
+func AcademyRating(club *Club, rng *rand.Rand) int {
+	return min(MaxQuality, max(1, club.Strength-3+rng.IntN(7)))
+}
+
+// YouthIntakes brings each club's academy graduates into its squad for the
+// season starting in the year. Graduates are 16 or 17, fill lines the squad
+// is short in first, and have more potential the better the academy. The
+// user's club takes the whole intake; AI clubs with big squads keep only the
+// best of it. No squad grows past MaximumSquadSize.
+func YouthIntakes(clubs []*ClubWithPlayers, userClubID int64, startYear int, rng *rand.Rand) []Graduate {
+	var graduates []Graduate
+	for _, club := range clubs {
+		intake := make([]Player, minIntake+rng.IntN(maxIntake-minIntake+1))
+		for i := range intake {
+			potential := min(MaxQuality, max(3, club.Club.Academy-5+rng.IntN(9)))
+			intake[i] = youngster(intakePosition(club.Players, rng), 16+rng.IntN(2), potential, academyNationality(club.Club, rng), startYear, rng)
+		}
+
+		room := MaximumSquadSize - len(club.Players)
+		if club.Club.ID != userClubID {
+			room = min(room, max(1, academySquadSize-len(club.Players)))
+		}
+		room = max(0, room)
+		for _, player := range bestPlayers(intake, room) {
+			player.Name = uniqueName(player.Nationality, club.Players, rng)
+			club.Players = append(club.Players, player)
+			graduates = append(graduates, Graduate{Player: player, Club: club.Club})
+		}
+	}
+	return graduates
+}
+
+// Regens generates a youngster in place of each retiring player, of the
+// same position and nationality and with about the potential they had. They
+// join the club the player retired from, unless it's the user's or has no
+// room, in which case an AI club in the pyramid with room takes them.
+func Regens(retirements []Retirement, clubs []*ClubWithPlayers, userClubID int64, startYear int, rng *rand.Rand) []Graduate {
+	var graduates []Graduate
+	for _, retirement := range retirements {
+		club := regenClub(retirement.Club, clubs, userClubID, rng)
+		if club == nil {
+			continue
+		}
+
+		retired := retirement.Player
+		nationality := retired.Nationality
+		if nationality == "" {
+			nationality = academyNationality(club.Club, rng)
+		}
+		potential := min(MaxQuality, max(3, retired.Potential-2+rng.IntN(5)))
+		player := youngster(retired.Position, 16+rng.IntN(3), potential, nationality, startYear, rng)
+		player.Name = uniqueName(nationality, club.Players, rng)
+
+		club.Players = append(club.Players, player)
+		graduates = append(graduates, Graduate{Player: player, Club: club.Club})
+	}
+	return graduates
+}
+
+// regenClub picks the club a regen joins
+func regenClub(retiredFrom *Club, clubs []*ClubWithPlayers, userClubID int64, rng *rand.Rand) *ClubWithPlayers {
+	var candidates []*ClubWithPlayers
+	for _, club := range clubs {
+		if club.Club.ID == userClubID || len(club.Players) >= MaximumSquadSize {
+			continue
+		}
+		if retiredFrom != nil && club.Club.ID == retiredFrom.ID {
+			return club
+		}
+		if club.Club.DivisionID != 0 {
+			candidates = append(candidates, club)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+	return candidates[rng.IntN(len(candidates))]
+}
+
+// youngster generates a player of the age for the season starting in the
+// year, still to be named. They start a few points short of their potential,
+// on a three-year contract as a prospect.
+func youngster(position string, age, potential int, nationality string, startYear int, rng *rand.Rand) Player {
+	player := Player{
+		Position:    position,
+		Quality:     max(1, potential-5-rng.IntN(4)),
+		Age:         age,
+		DateOfBirth: DateOfBirth(age, startYear, rng),
+		Potential:   potential,
+		Nationality: nationality,
+	}
+	player.Contract = Contract{
+		Wage: RoundWage(player.MarketWage() / 4),
+		Ends: startYear + 3,
+		Role: RoleProspect,
+	}
+	return player
+}
+
+// intakePosition picks a graduate's position: in a line the squad is short
+// of players in if there is one, otherwise in proportion to how many players
+// a squad wants in each line
+func intakePosition(squad []Player, rng *rand.Rand) string {
+	var short []int
+	for line, quota := range lineQuotas {
+		if lineSize(squad, line) < quota {
+			short = append(short, line)
+		}
+	}
+
+	line := 0
+	if len(short) > 0 {
+		line = short[rng.IntN(len(short))]
+	} else {
+		total := 0
+		for _, quota := range lineQuotas {
+			total += quota
+		}
+		pick := rng.IntN(total)
+		for line = range lineQuotas {
+			if pick < lineQuotas[line] {
+				break
+			}
+			pick -= lineQuotas[line]
+		}
+	}
+
+	positions := linePositions[line]
+	return positions[rng.IntN(len(positions))]
+}
+
+// academyNationality picks where a club's graduate is from: mostly England
+// for clubs in the pyramid, and anywhere else for clubs abroad
+func academyNationality(club *Club, rng *rand.Rand) string {
+	if club.DivisionID != 0 && rng.Float64() < homeShare {
+		return HomeNationality
+	}
+	return foreignNationalities[rng.IntN(len(foreignNationalities))]
+}
+
+// uniqueName picks a surname of the nationality nobody in the squad has, if
+// there is one left
+func uniqueName(nationality string, squad []Player, rng *rand.Rand) string {
+	pool := surnames[nationality]
+	if len(pool) == 0 {
+		pool = surnames[HomeNationality]
+	}
+
+	taken := make(map[string]bool, len(squad))
+	for _, player := range squad {
+		taken[player.Name] = true
+	}
+	var free []string
+	for _, name := range pool {
+		if !taken[name] {
+			free = append(free, name)
+		}
+	}
+	if len(free) == 0 {
+		return pool[rng.IntN(len(pool))]
+	}
+	return free[rng.IntN(len(free))]
+}
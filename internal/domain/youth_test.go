@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+// TestYouthIntakes verifies every club takes on graduates with room to grow,
+// and no squad grows past the maximum
+func TestYouthIntakes(t *testing.T) {
+	user := testClubs(1, 29, 2, 10)[0]
+	user.Club.Academy = 15
+	ai := testClubs(2, 18, 2, 10)[1]
+	ai.Club.Academy = 5
+
+	rng := rand.New(rand.NewPCG(1, 2))
+	graduates := YouthIntakes([]*ClubWithPlayers{user, ai}, user.Club.ID, 2025, rng)
+
+	joined := make(map[int64]int)
+	for _, graduate := range graduates {
+		player := graduate.Player
+		joined[graduate.Club.ID]++
+		if age := player.AgeOn(testSeasonStart); age < 16 || age > 17 || player.Name == "" || player.Nationality == "" {
+			t.Errorf("Expected a named 16 or 17-year-old, got %+v", player)
+		}
+		if player.Potential < 3 || player.Quality >= player.Potential || player.Contract.Ends != 2028 {
+			t.Errorf("Expected a prospect with room to grow on a three-year deal, got %+v", player)
+		}
+		if graduate.Club.ID == ai.Club.ID && player.Potential > 8 {
+			t.Errorf("Expected a weak academy's graduates to have limited potential, got %d", player.Potential)
+		}
+	}
+	if joined[user.Club.ID] != 1 || len(user.Players) != MaximumSquadSize {
+		t.Errorf("Expected the user's squad filled to the maximum, got %d graduates", joined[user.Club.ID])
+	}
+	if joined[ai.Club.ID] < minIntake || joined[ai.Club.ID] > maxIntake {
+		t.Errorf("Expected the AI club to take its whole intake, got %d graduates", joined[ai.Club.ID])
+	}
+}
+
+// TestRegens verifies each retiree is replaced by a youngster like them,
+// away from the user's club
+func TestRegens(t *testing.T) {
+	clubs := testClubs(2, 18, 2, 10)
+	user, ai := clubs[0], clubs[1]
+
+	retirements := []Retirement{
+		{Player: Player{Position: "ST", Potential: 16, Nationality: "Brazil"}, Club: ai.Club},
+		{Player: Player{Position: "GK", Potential: 12, Nationality: "Spain"}, Club: user.Club},
+	}
+	rng := rand.New(rand.NewPCG(1, 2))
+	graduates := Regens(retirements, clubs, user.Club.ID, 2025, rng)
+
+	if len(graduates) != 2 || len(user.Players) != 18 || len(ai.Players) != 20 {
+		t.Fatalf("Expected both regens at the AI club, got %+v", graduates)
+	}
+	for i, graduate := range graduates {
+		retired := retirements[i].Player
+		player := graduate.Player
+		if player.Position != retired.Position || player.Nationality != retired.Nationality {
+			t.Errorf("Expected a regen like %+v, got %+v", retired, player)
+		}
+		if player.Potential < retired.Potential-2 || player.Potential > retired.Potential+2 {
+			t.Errorf("Expected a potential near %d, got %d", retired.Potential, player.Potential)
+		}
+	}
+}
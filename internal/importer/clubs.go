@@ -0,0 +1,94 @@
+package importer
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/cameronjpr/gaffer/internal/domain"
+)
+
+// ignoredWords are dropped when comparing club names, so "Liverpool FC"
+// and "AFC Bournemouth" match the names in the game
+var ignoredWords = map[string]bool{
+	"fc":  true,
+	"afc": true,
+	"cf":  true,
+	"the": true,
+}
+
+// clubAliases maps common short names, already normalised, to the normalised full name
+var clubAliases = map[string]string{
+	"man utd":       "manchester united",
+	"man united":    "manchester united",
+	"man u":         "manchester united",
+	"man city":      "manchester city",
+	"spurs":         "tottenham hotspur",
+	"wolves":        "wolverhampton wanderers",
+	"nottm forest":  "nottingham forest",
+	"nott m forest": "nottingham forest",
+	"notts forest":  "nottingham forest",
+	"forest":        "nottingham forest",
+	"villa":         "aston villa",
+	"palace":        "crystal palace",
+}
+
+// ClubMatcher finds the game's club for a name written in an external list
+type ClubMatcher struct {
+	clubs map[string]*domain.ClubWithPlayers // By normalised name
+}
+
+func NewClubMatcher(clubs []*domain.ClubWithPlayers) *ClubMatcher {
+	matcher := &ClubMatcher{clubs: make(map[string]*domain.ClubWithPlayers, len(clubs))}
+	for _, club := range clubs {
+		matcher.clubs[normaliseClubName(club.Club.Name)] = club
+	}
+	return matcher
+}
+
+// Match returns the club a name refers to. Names match exactly once
+// normalised, through a known alias, or as the unambiguous start of a
+// club's name, so "Brighton" finds "Brighton & Hove Albion".
+func (m *ClubMatcher) Match(name string) (*domain.ClubWithPlayers, bool) {
+	normalised := normaliseClubName(name)
+	if normalised == "" {
+		return nil, false
+	}
+	if alias, ok := clubAliases[normalised]; ok {
+		normalised = alias
+	}
+
+	if club, ok := m.clubs[normalised]; ok {
+		return club, true
+	}
+
+	var found *domain.ClubWithPlayers
+	for clubName, club := range m.clubs {
+		if strings.HasPrefix(clubName, normalised+" ") {
+			if found != nil {
+				return nil, false // Ambiguous, e.g. "Manchester"
+			}
+			found = club
+		}
+	}
+	return found, found != nil
+}
+
+// normaliseClubName lowercases a name, spells out "&", strips punctuation
+// and drops words like "FC" that clubs are inconsistently listed with
+func normaliseClubName(name string) string {
+	name = strings.ReplaceAll(strings.ToLower(name), "&", " and ")
+	name = strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return ' '
+	}, name)
+
+	words := make([]string, 0, 4)
+	for _, word := range strings.Fields(name) {
+		if !ignoredWords[word] {
+			words = append(words, word)
+		}
+	}
+	return strings.Join(words, " ")
+}
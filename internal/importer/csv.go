@@ -0,0 +1,90 @@
+package importer
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// csvDateLayouts are the date formats accepted in the first column
+var csvDateLayouts = []string{
+	"2006-01-02",
+	"2006-01-02 15:04",
+	"02/01/2006",
+	"02/01/2006 15:04",
+	"02/01/06",
+}
+
+// CSVFormat reads rows of date, home, away. A header row is skipped and
+// gameweeks are worked out from the order of the dates.
+type CSVFormat struct{}
+
+func (CSVFormat) Name() string {
+	return "csv"
+}
+
+func (CSVFormat) Parse(data []byte) (*FixtureList, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	list := &FixtureList{}
+	for i, record := range records {
+		if len(record) < 3 {
+			return nil, fmt.Errorf("line %d: expected date, home, away", i+1)
+		}
+
+		date, err := parseCSVDate(record[0])
+		if err != nil {
+			if i == 0 {
+				continue // Header row
+			}
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+
+		list.Fixtures = append(list.Fixtures, Fixture{
+			Date: date,
+			Home: strings.TrimSpace(record[1]),
+			Away: strings.TrimSpace(record[2]),
+		})
+	}
+
+	assignGameweeks(list.Fixtures)
+	return list, nil
+}
+
+func parseCSVDate(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	for _, layout := range csvDateLayouts {
+		if date, err := time.Parse(layout, value); err == nil {
+			return date, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unrecognised date %q", value)
+}
+
+// assignGameweeks numbers fixtures without a gameweek in date order, putting
+// each in the earliest gameweek after both clubs' previous matches
+func assignGameweeks(fixtures []Fixture) {
+	sort.SliceStable(fixtures, func(i, j int) bool {
+		return fixtures[i].Date.Before(fixtures[j].Date)
+	})
+
+	played := make(map[string]int) // Last gameweek by club name
+	for i := range fixtures {
+		fixture := &fixtures[i]
+		if fixture.Gameweek == 0 {
+			fixture.Gameweek = max(played[fixture.Home], played[fixture.Away]) + 1
+		}
+		played[fixture.Home] = fixture.Gameweek
+		played[fixture.Away] = fixture.Gameweek
+	}
+}
@@ -0,0 +1,194 @@
+// Package importer reads fixture lists published in other formats and
+// matches their club names against the clubs in the game.
+package importer
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cameronjpr/gaffer/internal/domain"
+)
+
+// Fixture is one match as it appears in an external list, before its club
+// names have been matched
+type Fixture struct {
+	Gameweek int
+	Date     time.Time // Zero when the list has no date
+	Home     string
+	Away     string
+}
+
+// FixtureList is everything read from one external file
+type FixtureList struct {
+	Season   string // e.g. "2025/26", empty when the list doesn't say
+	Fixtures []Fixture
+}
+
+// Format parses one layout of fixture list
+type Format interface {
+	Name() string
+	Parse(data []byte) (*FixtureList, error)
+}
+
+// Formats lists every supported layout
+var Formats = []Format{
+	JSONFormat{},
+	CSVFormat{},
+	OpenFootballFormat{},
+}
+
+// FormatByName looks up a format by the name given on the command line
+func FormatByName(name string) (Format, error) {
+	for _, format := range Formats {
+		if format.Name() == name {
+			return format, nil
+		}
+	}
+
+	names := make([]string, len(Formats))
+	for i, format := range Formats {
+		names[i] = format.Name()
+	}
+	return nil, fmt.Errorf("unknown format %q (supported: %s)", name, strings.Join(names, ", "))
+}
+
+// DetectFormat picks a format from the file extension, treating anything
+// that isn't JSON or CSV as openfootball text
+func DetectFormat(path string) Format {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return JSONFormat{}
+	case ".csv":
+		return CSVFormat{}
+	default:
+		return OpenFootballFormat{}
+	}
+}
+
+// ParseFile reads a fixture list, detecting the format when none is given
+func ParseFile(path string, format Format) (*FixtureList, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	if format == nil {
+		format = DetectFormat(path)
+	}
+
+	list, err := format.Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s as %s: %w", path, format.Name(), err)
+	}
+	if len(list.Fixtures) == 0 {
+		return nil, fmt.Errorf("no fixtures found in %s", path)
+	}
+
+	return list, nil
+}
+
+// Report summarises an import so unmatched club names can be fixed at source
+type Report struct {
+	Season    string
+	Fixtures  int            // Fixtures with both clubs matched
+	Skipped   int            // Fixtures dropped for an unmatched club
+	Unmatched map[string]int // Club name as written, and how many fixtures it appeared in
+}
+
+// OK reports whether every club in the list was matched
+func (r *Report) OK() bool {
+	return len(r.Unmatched) == 0
+}
+
+func (r *Report) String() string {
+	var b strings.Builder
+
+	season := r.Season
+	if season == "" {
+		season = "unknown season"
+	}
+	fmt.Fprintf(&b, "%s: %d fixtures matched", season, r.Fixtures)
+	if r.Skipped > 0 {
+		fmt.Fprintf(&b, ", %d skipped", r.Skipped)
+	}
+	b.WriteString("\n")
+
+	if len(r.Unmatched) > 0 {
+		names := make([]string, 0, len(r.Unmatched))
+		for name := range r.Unmatched {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		b.WriteString("Unmatched clubs:\n")
+		for _, name := range names {
+			fixtures := "fixtures"
+			if r.Unmatched[name] == 1 {
+				fixtures = "fixture"
+			}
+			fmt.Fprintf(&b, "  %q in %d %s\n", name, r.Unmatched[name], fixtures)
+		}
+	}
+
+	return b.String()
+}
+
+// Resolve matches each fixture's clubs against the game's clubs, keeping
+// only the fixtures where both were found
+func Resolve(list *FixtureList, clubs []*domain.ClubWithPlayers) ([]*domain.Fixture, *Report) {
+	matcher := NewClubMatcher(clubs)
+	report := &Report{
+		Season:    list.Season,
+		Unmatched: make(map[string]int),
+	}
+
+	fixtures := make([]*domain.Fixture, 0, len(list.Fixtures))
+	for _, fixture := range list.Fixtures {
+		home, homeOK := matcher.Match(fixture.Home)
+		away, awayOK := matcher.Match(fixture.Away)
+		if !homeOK {
+			report.Unmatched[fixture.Home]++
+		}
+		if !awayOK {
+			report.Unmatched[fixture.Away]++
+		}
+		if !homeOK || !awayOK {
+			report.Skipped++
+			continue
+		}
+
+		fixtures = append(fixtures, &domain.Fixture{
+			DivisionID: home.Club.DivisionID,
+			Gameweek:   fixture.Gameweek,
+			Date:       fixture.Date,
+			HomeTeam:   home,
+			AwayTeam:   away,
+		})
+	}
+	report.Fixtures = len(fixtures)
+
+	return fixtures, report
+}
+
+// DivisionOf returns the division a fixture list is played in. Every club
+// in the list has to be in the same division.
+func DivisionOf(fixtures []*domain.Fixture) (int64, error) {
+	if len(fixtures) == 0 {
+		return 0, fmt.Errorf("no fixtures to import")
+	}
+
+	divisionID := fixtures[0].HomeTeam.Club.DivisionID
+	for _, fixture := range fixtures {
+		for _, club := range []*domain.Club{fixture.HomeTeam.Club, fixture.AwayTeam.Club} {
+			if club.DivisionID != divisionID {
+				return 0, fmt.Errorf("%s is not in the same division as %s", club.Name, fixtures[0].HomeTeam.Club.Name)
+			}
+		}
+	}
+
+	return divisionID, nil
+}
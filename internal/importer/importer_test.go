@@ -0,0 +1,190 @@
+package importer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cameronjpr/gaffer/internal/domain"
+)
+
+// namedClubs creates clubs with the given names and no squads
+func namedClubs(names ...string) []*domain.ClubWithPlayers {
+	clubs := make([]*domain.ClubWithPlayers, len(names))
+	for i, name := range names {
+		clubs[i] = &domain.ClubWithPlayers{Club: &domain.Club{ID: int64(i + 1), Name: name}}
+	}
+	return clubs
+}
+
+// TestClubMatcher verifies names match through normalisation, aliases and unambiguous prefixes
+func TestClubMatcher(t *testing.T) {
+	matcher := NewClubMatcher(namedClubs(
+		"AFC Bournemouth",
+		"Brighton & Hove Albion",
+		"Manchester City",
+		"Manchester United",
+		"Nottingham Forest",
+		"Tottenham Hotspur",
+	))
+
+	tests := []struct {
+		name string
+		want string // Empty when no club should match
+	}{
+		{"AFC Bournemouth", "AFC Bournemouth"},
+		{"Bournemouth", "AFC Bournemouth"},
+		{"Brighton and Hove Albion FC", "Brighton & Hove Albion"},
+		{"Brighton", "Brighton & Hove Albion"},
+		{"Man Utd", "Manchester United"},
+		{"Nott'm Forest", "Nottingham Forest"},
+		{"Spurs", "Tottenham Hotspur"},
+		{"Manchester", ""},
+		{"Ipswich Town", ""},
+	}
+
+	for _, tt := range tests {
+		club, ok := matcher.Match(tt.name)
+		switch {
+		case tt.want == "" && ok:
+			t.Errorf("%q: expected no match, got %s", tt.name, club.Club.Name)
+		case tt.want != "" && !ok:
+			t.Errorf("%q: expected %s, got no match", tt.name, tt.want)
+		case ok && club.Club.Name != tt.want:
+			t.Errorf("%q: expected %s, got %s", tt.name, tt.want, club.Club.Name)
+		}
+	}
+}
+
+// TestJSONFormat verifies both the season file layout and the older bare array
+func TestJSONFormat(t *testing.T) {
+	season := `{"season": "2025/26", "fixtures": [{"gameweek": 1, "homeTeam": "Liverpool", "awayTeam": "Fulham"}]}`
+	legacy := `[{"Gameweek": 2, "Home": "Fulham", "Away": "Liverpool"}]`
+
+	list, err := JSONFormat{}.Parse([]byte(season))
+	if err != nil {
+		t.Fatalf("Failed to parse season file: %v", err)
+	}
+	if list.Season != "2025/26" || len(list.Fixtures) != 1 || list.Fixtures[0] != (Fixture{Gameweek: 1, Home: "Liverpool", Away: "Fulham"}) {
+		t.Errorf("Unexpected season file parse: %+v", list)
+	}
+
+	list, err = JSONFormat{}.Parse([]byte(legacy))
+	if err != nil {
+		t.Fatalf("Failed to parse legacy file: %v", err)
+	}
+	if len(list.Fixtures) != 1 || list.Fixtures[0] != (Fixture{Gameweek: 2, Home: "Fulham", Away: "Liverpool"}) {
+		t.Errorf("Unexpected legacy file parse: %+v", list)
+	}
+}
+
+// TestCSVFormat verifies the header is skipped and gameweeks follow the dates
+func TestCSVFormat(t *testing.T) {
+	data := `date,home,away
+2025-08-16,Arsenal,Chelsea
+2025-08-15,Liverpool,Fulham
+2025-08-23,Chelsea,Liverpool
+2025-08-23,Fulham,Arsenal
+`
+
+	list, err := CSVFormat{}.Parse([]byte(data))
+	if err != nil {
+		t.Fatalf("Failed to parse CSV: %v", err)
+	}
+
+	want := []Fixture{
+		{Gameweek: 1, Date: time.Date(2025, 8, 15, 0, 0, 0, 0, time.UTC), Home: "Liverpool", Away: "Fulham"},
+		{Gameweek: 1, Date: time.Date(2025, 8, 16, 0, 0, 0, 0, time.UTC), Home: "Arsenal", Away: "Chelsea"},
+		{Gameweek: 2, Date: time.Date(2025, 8, 23, 0, 0, 0, 0, time.UTC), Home: "Chelsea", Away: "Liverpool"},
+		{Gameweek: 2, Date: time.Date(2025, 8, 23, 0, 0, 0, 0, time.UTC), Home: "Fulham", Away: "Arsenal"},
+	}
+	if len(list.Fixtures) != len(want) {
+		t.Fatalf("Expected %d fixtures, got %d", len(want), len(list.Fixtures))
+	}
+	for i, fixture := range list.Fixtures {
+		if fixture != want[i] {
+			t.Errorf("Fixture %d: expected %+v, got %+v", i, want[i], fixture)
+		}
+	}
+}
+
+// TestOpenFootballFormat verifies matchdays, dates, kick-off times and scores are handled
+func TestOpenFootballFormat(t *testing.T) {
+	data := `= English Premier League 2025/26
+
+# Teams 20
+
+» Matchday 1
+  Fri Aug 15 2025
+    20:00  Liverpool FC            v AFC Bournemouth
+  Sat Aug 16
+    15:00  Brighton & Hove Albion FC  v Fulham FC   1-1 (0-0)
+
+Matchday 38
+[Sun May/24]
+  Nott'm Forest  2-0  West Ham United
+`
+
+	list, err := OpenFootballFormat{}.Parse([]byte(data))
+	if err != nil {
+		t.Fatalf("Failed to parse openfootball text: %v", err)
+	}
+	if list.Season != "2025/26" {
+		t.Errorf("Expected season 2025/26, got %q", list.Season)
+	}
+
+	want := []Fixture{
+		{Gameweek: 1, Date: time.Date(2025, 8, 15, 0, 0, 0, 0, time.UTC), Home: "Liverpool FC", Away: "AFC Bournemouth"},
+		{Gameweek: 1, Date: time.Date(2025, 8, 16, 0, 0, 0, 0, time.UTC), Home: "Brighton & Hove Albion FC", Away: "Fulham FC"},
+		{Gameweek: 38, Date: time.Date(2026, 5, 24, 0, 0, 0, 0, time.UTC), Home: "Nott'm Forest", Away: "West Ham United"},
+	}
+	if len(list.Fixtures) != len(want) {
+		t.Fatalf("Expected %d fixtures, got %d: %+v", len(want), len(list.Fixtures), list.Fixtures)
+	}
+	for i, fixture := range list.Fixtures {
+		if fixture != want[i] {
+			t.Errorf("Fixture %d: expected %+v, got %+v", i, want[i], fixture)
+		}
+	}
+}
+
+// TestResolve verifies unmatched clubs are reported and their fixtures skipped,
+// while matched fixtures keep their dates
+func TestResolve(t *testing.T) {
+	list := &FixtureList{
+		Season: "2025/26",
+		Fixtures: []Fixture{
+			{Gameweek: 1, Date: time.Date(2025, 8, 15, 0, 0, 0, 0, time.UTC), Home: "Liverpool FC", Away: "Fulham"},
+			{Gameweek: 2, Home: "Ipswich Town", Away: "Liverpool"},
+			{Gameweek: 3, Home: "Fulham", Away: "Ipswich Town"},
+		},
+	}
+
+	fixtures, report := Resolve(list, namedClubs("Liverpool", "Fulham"))
+	if len(fixtures) != 1 || fixtures[0].HomeTeam.Club.Name != "Liverpool" {
+		t.Fatalf("Expected only Liverpool vs Fulham, got %d fixtures", len(fixtures))
+	}
+	if fixtures[0].Date.Day() != 15 {
+		t.Errorf("Expected the fixture to keep its date, got %s", fixtures[0].Date)
+	}
+	if report.OK() || report.Unmatched["Ipswich Town"] != 2 || report.Skipped != 2 {
+		t.Errorf("Expected Ipswich Town unmatched in 2 skipped fixtures, got %+v", report)
+	}
+}
+
+// TestDivisionOf verifies a list is only accepted when all its clubs share a division
+func TestDivisionOf(t *testing.T) {
+	clubs := namedClubs("Liverpool", "Fulham", "Leicester City")
+	clubs[0].Club.DivisionID = 1
+	clubs[1].Club.DivisionID = 1
+	clubs[2].Club.DivisionID = 2
+
+	fixtures := []*domain.Fixture{{HomeTeam: clubs[0], AwayTeam: clubs[1]}}
+	if divisionID, err := DivisionOf(fixtures); err != nil || divisionID != 1 {
+		t.Errorf("Expected division 1, got %d (%v)", divisionID, err)
+	}
+
+	fixtures = append(fixtures, &domain.Fixture{HomeTeam: clubs[1], AwayTeam: clubs[2]})
+	if _, err := DivisionOf(fixtures); err == nil {
+		t.Error("Expected an error for clubs from different divisions")
+	}
+}
@@ -0,0 +1,61 @@
+package importer
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// JSONFormat reads pl_25_26_fixtures.json style lists: a season name and
+// fixtures keyed gameweek/homeTeam/awayTeam. The older bare array keyed
+// Gameweek/Home/Away is read too.
+type JSONFormat struct{}
+
+type jsonFixtureList struct {
+	Season   string        `json:"season"`
+	Fixtures []jsonFixture `json:"fixtures"`
+}
+
+type jsonFixture struct {
+	Gameweek int    `json:"gameweek"`
+	Home     string `json:"homeTeam"`
+	Away     string `json:"awayTeam"`
+}
+
+type legacyJSONFixture struct {
+	Gameweek int    `json:"Gameweek"`
+	Home     string `json:"Home"`
+	Away     string `json:"Away"`
+}
+
+func (JSONFormat) Name() string {
+	return "json"
+}
+
+func (JSONFormat) Parse(data []byte) (*FixtureList, error) {
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+		var legacy []legacyJSONFixture
+		if err := json.Unmarshal(data, &legacy); err != nil {
+			return nil, err
+		}
+
+		list := &FixtureList{Fixtures: make([]Fixture, len(legacy))}
+		for i, fixture := range legacy {
+			list.Fixtures[i] = Fixture{Gameweek: fixture.Gameweek, Home: fixture.Home, Away: fixture.Away}
+		}
+		return list, nil
+	}
+
+	var parsed jsonFixtureList
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		return nil, err
+	}
+
+	list := &FixtureList{
+		Season:   parsed.Season,
+		Fixtures: make([]Fixture, len(parsed.Fixtures)),
+	}
+	for i, fixture := range parsed.Fixtures {
+		list.Fixtures[i] = Fixture{Gameweek: fixture.Gameweek, Home: fixture.Home, Away: fixture.Away}
+	}
+	return list, nil
+}
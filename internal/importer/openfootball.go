@@ -0,0 +1,121 @@
+package importer
+
+import (
+	"bufio"
+	"bytes"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	openFootballSeason   = regexp.MustCompile(`\d{4}/\d{2}`)
+	openFootballMatchday = regexp.MustCompile(`(?i)^»?\s*(?:matchday|round|week)\s+(\d+)`)
+	openFootballDate     = regexp.MustCompile(`^\[?(?:Mon|Tue|Wed|Thu|Fri|Sat|Sun)\w*\s+(\w{3})[\s/]+(\d{1,2})(?:\s+(\d{4}))?\]?$`)
+	openFootballTime     = regexp.MustCompile(`^\d{1,2}[:.]\d{2}\s+`)
+	openFootballVersus   = regexp.MustCompile(`\s+(?:v|vs\.?)\s+`)
+	openFootballScore    = regexp.MustCompile(`\s+\d+\s*-\s*\d+(?:\s*\(\d+\s*-\s*\d+\))?(?:\s+|$)`)
+	openFootballVenue    = regexp.MustCompile(`\s+@\s+.*$`)
+)
+
+// OpenFootballFormat reads the plain-text fixture lists published by the
+// openfootball project: a "= League 2025/26" title, "Matchday N" headings,
+// date lines, then one "Home v Away" or "Home 1-0 Away" line per match.
+type OpenFootballFormat struct{}
+
+func (OpenFootballFormat) Name() string {
+	return "openfootball"
+}
+
+func (OpenFootballFormat) Parse(data []byte) (*FixtureList, error) {
+	list := &FixtureList{}
+
+	gameweek := 0
+	var date time.Time
+	year := 0
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		switch {
+		case line == "" || strings.HasPrefix(line, "#"):
+			continue
+
+		case strings.HasPrefix(line, "="):
+			if season := openFootballSeason.FindString(line); season != "" {
+				list.Season = season
+				year, _ = strconv.Atoi(season[:4])
+			}
+
+		case openFootballMatchday.MatchString(line):
+			gameweek, _ = strconv.Atoi(openFootballMatchday.FindStringSubmatch(line)[1])
+
+		case openFootballDate.MatchString(line):
+			date, year = parseOpenFootballDate(openFootballDate.FindStringSubmatch(line), date, year)
+
+		default:
+			home, away, ok := parseOpenFootballMatch(line)
+			if !ok {
+				continue
+			}
+			list.Fixtures = append(list.Fixtures, Fixture{
+				Gameweek: gameweek,
+				Date:     date,
+				Home:     home,
+				Away:     away,
+			})
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	// Lists without matchday headings are numbered from their dates
+	if gameweek == 0 {
+		assignGameweeks(list.Fixtures)
+	}
+
+	return list, nil
+}
+
+// parseOpenFootballDate reads a date line. Lines often leave out the year,
+// so it carries over from the previous date and rolls on at the new year.
+func parseOpenFootballDate(match []string, previous time.Time, year int) (time.Time, int) {
+	if match[3] != "" {
+		year, _ = strconv.Atoi(match[3])
+	}
+
+	date, err := time.Parse("Jan 2 2006", match[1]+" "+match[2]+" "+strconv.Itoa(year))
+	if err != nil {
+		return previous, year
+	}
+	if match[3] == "" && !previous.IsZero() && date.Before(previous) {
+		date = date.AddDate(1, 0, 0)
+		year++
+	}
+	return date, year
+}
+
+// parseOpenFootballMatch splits a match line into its two clubs, dropping
+// any kick-off time, score and venue
+func parseOpenFootballMatch(line string) (string, string, bool) {
+	line = openFootballTime.ReplaceAllString(line, "")
+	line = openFootballVenue.ReplaceAllString(line, "")
+
+	parts := openFootballVersus.Split(line, 2)
+	if len(parts) != 2 {
+		parts = openFootballScore.Split(line, 2)
+	}
+	if len(parts) != 2 {
+		return "", "", false
+	}
+
+	home := strings.TrimSpace(parts[0])
+	away := strings.TrimSpace(openFootballScore.ReplaceAllString(parts[1], " "))
+	if home == "" || away == "" {
+		return "", "", false
+	}
+	return home, away, true
+}
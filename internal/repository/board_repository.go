@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cameronjpr/gaffer/internal/db"
+	"github.com/cameronjpr/gaffer/internal/domain"
+)
+
+type BoardRepo struct {
+	queries *db.Queries
+}
+
+func NewBoardRepository(queries *db.Queries) *BoardRepo {
+	return &BoardRepo{queries: queries}
+}
+
+// Save stores each board's objective, its confidence in the manager and when
+// it last sacked one
+func (r *BoardRepo) Save(clubs []*domain.Club) error {
+	ctx := context.Background()
+
+	for _, club := range clubs {
+		vacantSince := ""
+		if !club.VacantSince.IsZero() {
+			vacantSince = club.VacantSince.Format(domain.DateLayout)
+		}
+		err := r.queries.SetClubBoard(ctx, db.SetClubBoardParams{
+			Objective:   int64(club.Objective),
+			Confidence:  int64(club.Confidence),
+			VacantSince: vacantSince,
+			ID:          club.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to save the board of %s: %w", club.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// SetObjectives sets every board in the pyramid its objective for the season
+func (r *BoardRepo) SetObjectives(clubs []*domain.ClubWithPlayers, divisions []*domain.Division) error {
+	domain.SetObjectives(clubs, divisions)
+
+	var boards []*domain.Club
+	for _, club := range domain.ClubsInPyramid(clubs) {
+		boards = append(boards, club.Club)
+	}
+	return r.Save(boards)
+}
+
+// OpenBoardrooms sets the boards their objectives for the season the first
+// time the clubs are seen
+func (r *BoardRepo) OpenBoardrooms(clubs []*domain.ClubWithPlayers, divisions []*domain.Division) error {
+	for _, club := range domain.ClubsInPyramid(clubs) {
+		if club.Club.Objective == domain.NoObjective {
+			return r.SetObjectives(clubs, divisions)
+		}
+	}
+	return nil
+}
+
+// Appoint fills the club's job
+func (r *BoardRepo) Appoint(club *domain.Club) error {
+	club.Appoint()
+	return r.Save([]*domain.Club{club})
+}
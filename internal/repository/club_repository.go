@@ -27,19 +27,11 @@ func (r *ClubRepo) GetAll() ([]*domain.ClubWithPlayers, error) {
 
 	clubsWithPlayers := make([]*domain.ClubWithPlayers, len(dbClubs))
 	for i, dbClub := range dbClubs {
-		dbPlayers, err := r.queries.GetPlayersByClubID(ctx, dbClub.ID)
+		players, err := r.getPlayers(ctx, dbClub.ID)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get players for club %s: %w", dbClub.Name, err)
 		}
 
-		players := make([]domain.Player, len(dbPlayers))
-		for j, p := range dbPlayers {
-			players[j] = domain.Player{
-				Name:    p.Name,
-				Quality: int(p.Quality),
-			}
-		}
-
 		clubsWithPlayers[i] = &domain.ClubWithPlayers{
 			Club:    dbClubToDomain(dbClub),
 			Players: players,
@@ -70,23 +62,74 @@ func (r *ClubRepo) GetByID(clubID int64) (*domain.ClubWithPlayers, error) {
 		return nil, fmt.Errorf("failed to get club: %w", err)
 	}
 
-	dbPlayers, err := r.queries.GetPlayersByClubID(ctx, clubID)
+	players, err := r.getPlayers(ctx, clubID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get players: %w", err)
 	}
 
+	return &domain.ClubWithPlayers{
+		Club:    dbClubToDomain(dbClub),
+		Players: players,
+	}, nil
+}
+
+// getPlayers loads a club's players along with their current availability
+func (r *ClubRepo) getPlayers(ctx context.Context, clubID int64) ([]domain.Player, error) {
+	dbPlayers, err := r.queries.GetPlayersByClubID(ctx, clubID)
+	if err != nil {
+		return nil, err
+	}
+
+	suspensions, err := r.queries.GetActiveSuspensionsByClubID(ctx, clubID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get suspensions: %w", err)
+	}
+
+	suspendedMatches := make(map[int64]int)
+	for _, s := range suspensions {
+		suspendedMatches[s.PlayerID] += int(s.MatchesRemaining)
+	}
+
+	injuryRows, err := r.queries.GetActiveInjuriesByClubID(ctx, clubID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get injuries: %w", err)
+	}
+
+	injuries := make(map[int64]*domain.Injury)
+	for _, row := range injuryRows {
+		injury := dbInjuryToDomain(row)
+		injuries[row.PlayerID] = &injury
+	}
+
 	players := make([]domain.Player, len(dbPlayers))
 	for i, p := range dbPlayers {
 		players[i] = domain.Player{
-			Name:    p.Name,
-			Quality: int(p.Quality),
+			ID:               p.ID,
+			Name:             p.Name,
+			Position:         p.Position,
+			Quality:          int(p.Quality),
+			Age:              int(p.Age),
+			SuspendedMatches: suspendedMatches[p.ID],
+			Injury:           injuries[p.ID],
+			Fatigue:          int(p.Fatigue),
+			LoanedFrom:       p.LoanedFromClubID.Int64,
+			Contract: domain.Contract{
+				Wage:          p.Wage,
+				Ends:          int(p.ContractEnds),
+				ReleaseClause: p.ReleaseClause,
+				Role:          domain.SquadRole(p.SquadRole),
+			},
+			DateOfBirth:   domain.ParseDate(p.DateOfBirth),
+			Potential:     int(p.Potential),
+			Progress:      int(p.Progress),
+			Nationality:   p.Nationality,
+			Sharpness:     int(p.Sharpness),
+			TrainingFocus: domain.TrainingFocus(p.TrainingFocus),
+			Morale:        int(p.Morale),
 		}
 	}
 
-	return &domain.ClubWithPlayers{
-		Club:    dbClubToDomain(dbClub),
-		Players: players,
-	}, nil
+	return players, nil
 }
 
 // dbClubToDomain converts a database club to a domain Club
@@ -97,6 +140,23 @@ func dbClubToDomain(dbClub db.Club) *domain.Club {
 		Strength:   int(dbClub.Strength),
 		Background: dbClub.BackgroundColor,
 		Foreground: dbClub.ForegroundColor,
+		DivisionID: dbClub.DivisionID.Int64,
+
+		Balance:        dbClub.Balance,
+		TransferBudget: dbClub.TransferBudget,
+		Academy:        int(dbClub.Academy),
+
+		Training: domain.TrainingPlan{
+			Intensity: domain.TrainingIntensity(dbClub.TrainingIntensity),
+			Focus:     domain.TrainingFocus(dbClub.TrainingFocus),
+		},
+		Formation:   dbClub.Formation,
+		Familiarity: int(dbClub.Familiarity),
+		Cohesion:    int(dbClub.Cohesion),
+
+		Objective:   domain.Objective(dbClub.Objective),
+		Confidence:  int(dbClub.Confidence),
+		VacantSince: domain.ParseDate(dbClub.VacantSince),
 	}
 }
 
@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"github.com/cameronjpr/gaffer/internal/db"
+	"github.com/cameronjpr/gaffer/internal/domain"
+)
+
+type ContractRepo struct {
+	queries *db.Queries
+}
+
+func NewContractRepository(queries *db.Queries) *ContractRepo {
+	return &ContractRepo{queries: queries}
+}
+
+// DrawUpContracts puts every player without a contract on one part-way
+// through on the day, keeping any wage they're already paid
+func (r *ContractRepo) DrawUpContracts(clubs []*domain.ClubWithPlayers, today time.Time, rng *rand.Rand) error {
+	for _, club := range clubs {
+		for i, player := range club.Players {
+			if player.Contract.Ends != 0 {
+				continue
+			}
+			contract := domain.InitialContract(player, club.Players, today, rng)
+			if player.Contract.Wage != 0 {
+				contract.Wage = player.Contract.Wage
+			}
+			if err := r.Sign(player, contract); err != nil {
+				return err
+			}
+			club.Players[i].Contract = contract
+		}
+	}
+
+	return nil
+}
+
+// Renew registers each player on the new terms they've agreed with their club
+func (r *ContractRepo) Renew(renewals []domain.Renewal) error {
+	for _, renewal := range renewals {
+		if err := r.Sign(renewal.Player, renewal.Contract); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Sign registers the player on the contract
+func (r *ContractRepo) Sign(player domain.Player, contract domain.Contract) error {
+	ctx := context.Background()
+
+	if err := r.queries.SetPlayerContract(ctx, contractParams(player.ID, contract)); err != nil {
+		return fmt.Errorf("failed to sign contract for %s: %w", player.Name, err)
+	}
+
+	return nil
+}
+
+// contractParams converts a domain contract to the columns it's stored in
+func contractParams(playerID int64, contract domain.Contract) db.SetPlayerContractParams {
+	return db.SetPlayerContractParams{
+		Wage:          contract.Wage,
+		ContractEnds:  int64(contract.Ends),
+		ReleaseClause: contract.ReleaseClause,
+		SquadRole:     int64(contract.Role),
+		ID:            playerID,
+	}
+}
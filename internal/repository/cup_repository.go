@@ -0,0 +1,187 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/cameronjpr/gaffer/internal/db"
+	"github.com/cameronjpr/gaffer/internal/domain"
+)
+
+type CupRepo struct {
+	queries *db.Queries
+}
+
+func NewCupRepository(queries *db.Queries) *CupRepo {
+	return &CupRepo{queries: queries}
+}
+
+// GetCurrent fetches a cup with every round drawn so far this season
+func (r *CupRepo) GetCurrent(name string) (*domain.Cup, error) {
+	ctx := context.Background()
+
+	dbCup, err := r.queries.GetCupByName(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cup %s: %w", name, err)
+	}
+
+	dbRounds, err := r.queries.GetCurrentCupRounds(ctx, dbCup.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rounds for %s: %w", name, err)
+	}
+
+	cup := &domain.Cup{
+		ID:     dbCup.ID,
+		Name:   dbCup.Name,
+		Rounds: make([]*domain.CupRound, len(dbRounds)),
+	}
+
+	clubs := make(map[int64]*domain.Club)
+	clubByID := func(id int64) (*domain.Club, error) {
+		if club, ok := clubs[id]; ok {
+			return club, nil
+		}
+		dbClub, err := r.queries.GetClubByID(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get club %d: %w", id, err)
+		}
+		clubs[id] = dbClubToDomain(dbClub)
+		return clubs[id], nil
+	}
+
+	// Clubs entered by qualifying, grouped once the group stage is drawn
+	entries, err := r.queries.GetCurrentCupEntries(ctx, dbCup.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get entries for %s: %w", name, err)
+	}
+	for _, entry := range entries {
+		club, err := clubByID(entry.ClubID)
+		if err != nil {
+			return nil, err
+		}
+		cup.Entrants = append(cup.Entrants, club)
+
+		if entry.GroupName == "" {
+			continue
+		}
+		if len(cup.Groups) == 0 || cup.Groups[len(cup.Groups)-1].Name != entry.GroupName {
+			cup.Groups = append(cup.Groups, &domain.CupGroup{Name: entry.GroupName})
+		}
+		group := cup.Groups[len(cup.Groups)-1]
+		group.Clubs = append(group.Clubs, club)
+	}
+
+	for i, dbRound := range dbRounds {
+		round := &domain.CupRound{
+			ID:         dbRound.ID,
+			CupName:    dbCup.Name,
+			Number:     int(dbRound.Number),
+			Name:       dbRound.Name,
+			Gameweek:   int(dbRound.Gameweek),
+			Legs:       int(dbRound.Legs),
+			GroupStage: dbRound.GroupStage != 0,
+		}
+
+		rows, err := r.queries.GetCupTiesByRoundID(ctx, sql.NullInt64{Int64: dbRound.ID, Valid: true})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get ties for %s: %w", round.Name, err)
+		}
+
+		for _, row := range rows {
+			home, err := clubByID(row.HomeTeamID)
+			if err != nil {
+				return nil, err
+			}
+			away, err := clubByID(row.AwayTeamID)
+			if err != nil {
+				return nil, err
+			}
+
+			round.Ties = append(round.Ties, &domain.CupTie{
+				FixtureID:     row.ID,
+				Gameweek:      int(row.Gameweek),
+				Date:          domain.ParseDate(row.MatchDate),
+				Leg:           int(row.Leg),
+				Home:          home,
+				Away:          away,
+				Played:        row.HomeScore.Valid,
+				HomeGoals:     int(row.HomeScore.Int64),
+				AwayGoals:     int(row.AwayScore.Int64),
+				HomePenalties: int(row.HomePenalties.Int64),
+				AwayPenalties: int(row.AwayPenalties.Int64),
+			})
+		}
+
+		cup.Rounds[i] = round
+	}
+
+	cup.TallyGroups()
+
+	return cup, nil
+}
+
+// Enter puts clubs into this season's cup, in the named group if one has
+// been drawn. Entering a club already in the cup moves it to that group.
+func (r *CupRepo) Enter(cup *domain.Cup, clubs []*domain.Club, group string) error {
+	ctx := context.Background()
+
+	for _, club := range clubs {
+		err := r.queries.UpsertCupEntry(ctx, db.UpsertCupEntryParams{
+			CupID:     cup.ID,
+			ClubID:    club.ID,
+			GroupName: group,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to enter %s into %s: %w", club.Name, cup.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// CreateRound records a newly drawn round and schedules its ties in the
+// current season, each on the cup's midweek matchday of the gameweek it was
+// drawn for
+func (r *CupRepo) CreateRound(cup *domain.Cup, round *domain.CupRound, fixtures []*domain.Fixture) error {
+	ctx := context.Background()
+
+	season, err := r.queries.GetCurrentSeason(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get current season: %w", err)
+	}
+
+	groupStage := int64(0)
+	if round.GroupStage {
+		groupStage = 1
+	}
+	dbRound, err := r.queries.CreateCupRound(ctx, db.CreateCupRoundParams{
+		CupID:      cup.ID,
+		Number:     int64(round.Number),
+		Name:       round.Name,
+		Gameweek:   int64(round.Gameweek),
+		Legs:       int64(max(round.Legs, 1)),
+		GroupStage: groupStage,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create %s %s: %w", cup.Name, round.Name, err)
+	}
+	round.ID = dbRound.ID
+
+	for _, fixture := range fixtures {
+		_, err := r.queries.CreateFixture(ctx, db.CreateFixtureParams{
+			Gameweek:   int64(fixture.Gameweek),
+			HomeTeamID: fixture.HomeTeam.Club.ID,
+			AwayTeamID: fixture.AwayTeam.Club.ID,
+			SeasonID:   sql.NullInt64{Int64: season.ID, Valid: true},
+			CupRoundID: sql.NullInt64{Int64: dbRound.ID, Valid: true},
+			Leg:        int64(max(fixture.Leg, 1)),
+			MatchDate:  domain.MatchDate(int(season.StartYear), fixture.Gameweek, cup.Name).Format(domain.DateLayout),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create tie %s vs %s: %w", fixture.HomeTeam.Club.Name, fixture.AwayTeam.Club.Name, err)
+		}
+	}
+
+	return nil
+}
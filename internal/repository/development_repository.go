@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"github.com/cameronjpr/gaffer/internal/db"
+	"github.com/cameronjpr/gaffer/internal/domain"
+)
+
+type DevelopmentRepo struct {
+	queries *db.Queries
+}
+
+func NewDevelopmentRepository(queries *db.Queries) *DevelopmentRepo {
+	return &DevelopmentRepo{queries: queries}
+}
+
+// RegisterPlayers gives every player without a date of birth one to match
+// their age, and a potential to grow into
+func (r *DevelopmentRepo) RegisterPlayers(clubs []*domain.ClubWithPlayers, startYear int, rng *rand.Rand) error {
+	ctx := context.Background()
+
+	for _, club := range clubs {
+		for i, player := range club.Players {
+			if !player.DateOfBirth.IsZero() {
+				continue
+			}
+			player.DateOfBirth = domain.DateOfBirth(player.Age, startYear, rng)
+			player.Potential = domain.InitialPotential(player, domain.PreseasonStart(startYear), rng)
+			err := r.queries.SetPlayerBirthAndPotential(ctx, db.SetPlayerBirthAndPotentialParams{
+				DateOfBirth: player.DateOfBirth.Format(domain.DateLayout),
+				Potential:   int64(player.Potential),
+				ID:          player.ID,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to register %s: %w", player.Name, err)
+			}
+			club.Players[i] = player
+		}
+	}
+
+	return nil
+}
+
+// GetMinutesBetween fetches roughly how long each player has played from the
+// first day up to the second, by player ID
+func (r *DevelopmentRepo) GetMinutesBetween(from, to time.Time) (map[int64]int, error) {
+	ctx := context.Background()
+
+	rows, err := r.queries.GetMinutesBetween(ctx, db.GetMinutesBetweenParams{
+		FromDate: from.Format(domain.DateLayout),
+		ToDate:   to.Format(domain.DateLayout),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get minutes played: %w", err)
+	}
+
+	minutes := make(map[int64]int, len(rows))
+	for _, row := range rows {
+		minutes[row.PlayerID] = int(row.Minutes)
+	}
+	return minutes, nil
+}
+
+// SaveDevelopment stores each player's quality and progress
+func (r *DevelopmentRepo) SaveDevelopment(players []domain.Player) error {
+	ctx := context.Background()
+
+	for _, player := range players {
+		err := r.queries.SetPlayerDevelopment(ctx, db.SetPlayerDevelopmentParams{
+			Quality:  int64(player.Quality),
+			Progress: int64(player.Progress),
+			ID:       player.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to save development for %s: %w", player.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// Retire removes the retiring players from the game
+func (r *DevelopmentRepo) Retire(retirements []domain.Retirement) error {
+	ctx := context.Background()
+
+	for _, retirement := range retirements {
+		if err := r.queries.DeletePlayer(ctx, retirement.Player.ID); err != nil {
+			return fmt.Errorf("failed to retire %s: %w", retirement.Player.Name, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cameronjpr/gaffer/internal/db"
+	"github.com/cameronjpr/gaffer/internal/domain"
+)
+
+type DisciplineRepo struct {
+	queries *db.Queries
+}
+
+func NewDisciplineRepository(queries *db.Queries) *DisciplineRepo {
+	return &DisciplineRepo{queries: queries}
+}
+
+// RecordMatch updates the discipline ledger after a completed match.
+// Existing bans for both clubs are served first, so any suspension raised
+// by this match starts counting from the club's next fixture.
+func (r *DisciplineRepo) RecordMatch(match *domain.Match) error {
+	ctx := context.Background()
+
+	for _, participant := range []*domain.MatchParticipant{match.Home, match.Away} {
+		if err := r.queries.ServeSuspensionsByClubID(ctx, participant.Club.ID); err != nil {
+			return fmt.Errorf("failed to serve suspensions for %s: %w", participant.Club.Name, err)
+		}
+	}
+
+	for _, record := range match.DisciplineRecords() {
+		_, err := r.queries.CreatePlayerCard(ctx, db.CreatePlayerCardParams{
+			PlayerID:  record.Player.ID,
+			FixtureID: int64(match.ForFixture.ID),
+			EventType: int64(record.Card),
+			Minute:    int64(record.Minute),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record card for %s: %w", record.Player.Name, err)
+		}
+
+		totalYellows, err := r.queries.CountCardsByPlayerID(ctx, db.CountCardsByPlayerIDParams{
+			PlayerID:  record.Player.ID,
+			EventType: int64(domain.YellowCardEvent),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to count yellow cards for %s: %w", record.Player.Name, err)
+		}
+
+		suspension := domain.SuspensionFor(record, int(totalYellows))
+		if suspension == nil {
+			continue
+		}
+
+		_, err = r.queries.CreateSuspension(ctx, db.CreateSuspensionParams{
+			PlayerID:         suspension.PlayerID,
+			Reason:           suspension.Reason,
+			MatchesBanned:    int64(suspension.MatchesBanned),
+			MatchesRemaining: int64(suspension.MatchesRemaining),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to suspend %s: %w", record.Player.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// GetSuspensionsByClubID returns the club's players currently serving a ban
+func (r *DisciplineRepo) GetSuspensionsByClubID(clubID int64) ([]domain.Suspension, error) {
+	ctx := context.Background()
+
+	rows, err := r.queries.GetActiveSuspensionsByClubID(ctx, clubID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get suspensions for club %d: %w", clubID, err)
+	}
+
+	suspensions := make([]domain.Suspension, len(rows))
+	for i, row := range rows {
+		suspensions[i] = domain.Suspension{
+			PlayerID:         row.PlayerID,
+			PlayerName:       row.PlayerName,
+			Reason:           row.Reason,
+			MatchesBanned:    int(row.MatchesBanned),
+			MatchesRemaining: int(row.MatchesRemaining),
+		}
+	}
+
+	return suspensions, nil
+}
@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/cameronjpr/gaffer/internal/db"
+	"github.com/cameronjpr/gaffer/internal/domain"
+)
+
+type DivisionRepo struct {
+	queries *db.Queries
+}
+
+func NewDivisionRepository(queries *db.Queries) *DivisionRepo {
+	return &DivisionRepo{queries: queries}
+}
+
+// GetAll fetches every division in the pyramid, top flight first, with the
+// points deducted from clubs this season
+func (r *DivisionRepo) GetAll() ([]*domain.Division, error) {
+	ctx := context.Background()
+
+	dbDivisions, err := r.queries.GetAllDivisions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get divisions: %w", err)
+	}
+
+	rows, err := r.queries.GetCurrentSeasonDeductions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get points deductions: %w", err)
+	}
+	deductions := make(map[int64]int, len(rows))
+	for _, row := range rows {
+		deductions[row.ClubID] = int(row.Points)
+	}
+
+	divisions := make([]*domain.Division, len(dbDivisions))
+	for i, dbDivision := range dbDivisions {
+		rules := domain.RulesFor(dbDivision.Name)
+		rules.Deductions = maps.Clone(deductions)
+		divisions[i] = &domain.Division{
+			ID:    dbDivision.ID,
+			Name:  dbDivision.Name,
+			Tier:  int(dbDivision.Tier),
+			Rules: rules,
+		}
+	}
+
+	return divisions, nil
+}
+
+// Deduct docks the club points in the current season
+func (r *DivisionRepo) Deduct(club *domain.Club, points int, reason string) error {
+	ctx := context.Background()
+
+	err := r.queries.CreatePointsDeduction(ctx, db.CreatePointsDeductionParams{
+		ClubID: club.ID,
+		Points: int64(points),
+		Reason: reason,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to deduct points from %s: %w", club.Name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cameronjpr/gaffer/internal/db"
+	"github.com/cameronjpr/gaffer/internal/domain"
+)
+
+type FinanceRepo struct {
+	queries *db.Queries
+}
+
+func NewFinanceRepository(queries *db.Queries) *FinanceRepo {
+	return &FinanceRepo{queries: queries}
+}
+
+// OpenAccounts gives any club without accounts its starting balance and a
+// first transfer budget from the board
+func (r *FinanceRepo) OpenAccounts(clubs []*domain.ClubWithPlayers, date time.Time) error {
+	ctx := context.Background()
+
+	for _, club := range clubs {
+		count, err := r.queries.CountFinanceTransactionsByClubID(ctx, club.Club.ID)
+		if err != nil {
+			return fmt.Errorf("failed to check accounts for %s: %w", club.Club.Name, err)
+		}
+		if count > 0 {
+			continue
+		}
+
+		opening := domain.Transaction{
+			Club:        club.Club,
+			Category:    domain.OpeningBalance,
+			Amount:      domain.StartingBalance(club.Club),
+			Date:        date,
+			Description: "Money in the bank",
+		}
+		if err := r.Record([]domain.Transaction{opening}); err != nil {
+			return err
+		}
+		if err := r.SetTransferBudget(club.Club, domain.BoardTransferBudget(club.Club)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Record saves each payment to the club's accounts and applies it to the club
+func (r *FinanceRepo) Record(transactions []domain.Transaction) error {
+	ctx := context.Background()
+
+	for _, transaction := range transactions {
+		err := r.queries.AdjustClubFinances(ctx, db.AdjustClubFinancesParams{
+			Balance:        transaction.Amount,
+			TransferBudget: transaction.Budget,
+			ID:             transaction.Club.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update accounts for %s: %w", transaction.Club.Name, err)
+		}
+
+		err = r.queries.CreateFinanceTransaction(ctx, db.CreateFinanceTransactionParams{
+			ClubID:          transaction.Club.ID,
+			Category:        transaction.Category,
+			Amount:          transaction.Amount,
+			Description:     transaction.Description,
+			TransactionDate: transaction.Date.Format(domain.DateLayout),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record transaction for %s: %w", transaction.Club.Name, err)
+		}
+
+		transaction.Apply()
+	}
+
+	return nil
+}
+
+// SetTransferBudget replaces the club's transfer budget with what the board
+// has decided it can spend
+func (r *FinanceRepo) SetTransferBudget(club *domain.Club, budget int64) error {
+	ctx := context.Background()
+
+	err := r.queries.SetClubTransferBudget(ctx, db.SetClubTransferBudgetParams{
+		TransferBudget: budget,
+		ID:             club.ID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set transfer budget for %s: %w", club.Name, err)
+	}
+
+	club.TransferBudget = budget
+	return nil
+}
+
+// GetSummary fetches the club's income and spending this season under each
+// heading, and its latest transactions
+func (r *FinanceRepo) GetSummary(clubID int64) (*domain.FinanceSummary, error) {
+	ctx := context.Background()
+
+	totals, err := r.queries.GetSeasonFinanceTotals(ctx, clubID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get finance totals: %w", err)
+	}
+
+	recent, err := r.queries.GetRecentFinanceTransactions(ctx, db.GetRecentFinanceTransactionsParams{
+		ClubID: clubID,
+		Limit:  10,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transactions: %w", err)
+	}
+
+	summary := &domain.FinanceSummary{
+		Totals: make([]domain.CategoryTotal, len(totals)),
+		Recent: make([]domain.Transaction, len(recent)),
+	}
+	for i, total := range totals {
+		summary.Totals[i] = domain.CategoryTotal{Category: total.Category, Total: total.Total}
+	}
+	for i, transaction := range recent {
+		summary.Recent[i] = domain.Transaction{
+			Club:        &domain.Club{ID: transaction.ClubID},
+			Category:    transaction.Category,
+			Amount:      transaction.Amount,
+			Date:        domain.ParseDate(transaction.TransactionDate),
+			Description: transaction.Description,
+		}
+	}
+
+	return summary, nil
+}
@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cameronjpr/gaffer/internal/db"
+	"github.com/cameronjpr/gaffer/internal/domain"
+)
+
+type FitnessRepo struct {
+	queries *db.Queries
+}
+
+func NewFitnessRepository(queries *db.Queries) *FitnessRepo {
+	return &FitnessRepo{queries: queries}
+}
+
+// RecordMatch carries the tiredness of everyone who played in a completed
+// match over to their next one
+func (r *FitnessRepo) RecordMatch(match *domain.Match) error {
+	ctx := context.Background()
+
+	for _, participant := range []*domain.MatchParticipant{match.Home, match.Away} {
+		for _, player := range participant.Appeared {
+			err := r.queries.RecordPlayerFatigue(ctx, db.RecordPlayerFatigueParams{
+				Fatigue: int64(player.Fatigue()),
+				ID:      player.Player.ID,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to record fatigue for %s: %w", player.Player.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Recover lets every player rest for the given number of days
+func (r *FitnessRepo) Recover(days int) error {
+	ctx := context.Background()
+
+	if err := r.queries.RecoverPlayerFatigue(ctx, int64(days*domain.FatigueRecoveryPerDay)); err != nil {
+		return fmt.Errorf("failed to recover fatigue: %w", err)
+	}
+
+	return nil
+}
@@ -2,7 +2,10 @@ package repository
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/cameronjpr/gaffer/internal/db"
 	"github.com/cameronjpr/gaffer/internal/domain"
@@ -98,6 +101,49 @@ func (r *FixtureRepo) GetUnplayedByClubID(clubID int64) ([]*domain.Fixture, erro
 	return fixtures, nil
 }
 
+// GetUnplayed fetches every unplayed fixture in the current season
+func (r *FixtureRepo) GetUnplayed() ([]*domain.Fixture, error) {
+	ctx := context.Background()
+
+	dbFixtures, err := r.queries.GetUnplayedFixtures(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get unplayed fixtures: %w", err)
+	}
+
+	fixtures := make([]*domain.Fixture, len(dbFixtures))
+	for i, dbFixture := range dbFixtures {
+		fixture, err := r.dbFixtureToDomain(dbFixture)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert fixture %d: %w", dbFixture.ID, err)
+		}
+		fixtures[i] = fixture
+	}
+
+	return fixtures, nil
+}
+
+// GetUnplayedThrough fetches every unplayed fixture in the current season
+// dated on or before the given day
+func (r *FixtureRepo) GetUnplayedThrough(date time.Time) ([]*domain.Fixture, error) {
+	ctx := context.Background()
+
+	dbFixtures, err := r.queries.GetUnplayedFixturesThrough(ctx, date.Format(domain.DateLayout))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get fixtures due by %s: %w", date.Format(domain.DateLayout), err)
+	}
+
+	fixtures := make([]*domain.Fixture, len(dbFixtures))
+	for i, dbFixture := range dbFixtures {
+		fixture, err := r.dbFixtureToDomain(dbFixture)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert fixture %d: %w", dbFixture.ID, err)
+		}
+		fixtures[i] = fixture
+	}
+
+	return fixtures, nil
+}
+
 // GetByGameweek fetches all fixtures for a specific gameweek
 func (r *FixtureRepo) GetByGameweek(gameweek int) ([]*domain.Fixture, error) {
 	ctx := context.Background()
@@ -123,6 +169,53 @@ func (r *FixtureRepo) GetByGameweek(gameweek int) ([]*domain.Fixture, error) {
 	return fixtures, nil
 }
 
+// ReplaceCurrentSeason swaps a division's fixtures in the current season for
+// a new list. Fixtures can only be replaced before any of them have been played.
+// Fixtures without a date in the season are played on their gameweek's Saturday.
+func (r *FixtureRepo) ReplaceCurrentSeason(divisionID int64, fixtures []*domain.Fixture) error {
+	ctx := context.Background()
+	division := sql.NullInt64{Int64: divisionID, Valid: true}
+
+	played, err := r.queries.CountPlayedFixtures(ctx, division)
+	if err != nil {
+		return fmt.Errorf("failed to count played fixtures: %w", err)
+	}
+	if played > 0 {
+		return fmt.Errorf("cannot replace fixtures once the season has started (%d played)", played)
+	}
+
+	season, err := r.queries.GetCurrentSeason(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get current season: %w", err)
+	}
+
+	if err := r.queries.DeleteCurrentSeasonFixtures(ctx, division); err != nil {
+		return fmt.Errorf("failed to delete fixtures: %w", err)
+	}
+
+	opens := domain.PreseasonStart(int(season.StartYear))
+	for _, fixture := range fixtures {
+		date := fixture.Date
+		if date.Before(opens) || !date.Before(opens.AddDate(1, 0, 0)) {
+			date = domain.MatchDate(int(season.StartYear), fixture.Gameweek, "")
+		}
+		_, err := r.queries.CreateFixture(ctx, db.CreateFixtureParams{
+			Gameweek:   int64(fixture.Gameweek),
+			HomeTeamID: fixture.HomeTeam.Club.ID,
+			AwayTeamID: fixture.AwayTeam.Club.ID,
+			SeasonID:   sql.NullInt64{Int64: season.ID, Valid: true},
+			DivisionID: division,
+			Leg:        1,
+			MatchDate:  date.Format(domain.DateLayout),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create fixture %s vs %s: %w", fixture.HomeTeam.Club.Name, fixture.AwayTeam.Club.Name, err)
+		}
+	}
+
+	return nil
+}
+
 // dbFixtureToDomain converts a database fixture to a domain Fixture
 func (r *FixtureRepo) dbFixtureToDomain(dbFixture db.Fixture) (*domain.Fixture, error) {
 	// Load the home team with players
@@ -137,13 +230,56 @@ func (r *FixtureRepo) dbFixtureToDomain(dbFixture db.Fixture) (*domain.Fixture,
 		return nil, fmt.Errorf("failed to get away team %d: %w", dbFixture.AwayTeamID, err)
 	}
 
-	return &domain.Fixture{
-		ID:       int(dbFixture.ID),
-		Gameweek: int(dbFixture.Gameweek),
-		HomeTeam: homeTeam,
-		AwayTeam: awayTeam,
-		Result:   nil, // Match results would be loaded separately if needed
-	}, nil
+	fixture := &domain.Fixture{
+		ID:         int(dbFixture.ID),
+		DivisionID: dbFixture.DivisionID.Int64,
+		Gameweek:   int(dbFixture.Gameweek),
+		Date:       domain.ParseDate(dbFixture.MatchDate),
+		HomeTeam:   homeTeam,
+		AwayTeam:   awayTeam,
+		Result:     nil, // Match results would be loaded separately if needed
+		Leg:        int(dbFixture.Leg),
+	}
+
+	// Cup ties carry their round so they can be played as knockouts
+	if dbFixture.CupRoundID.Valid {
+		round, err := r.queries.GetCupRoundByID(context.Background(), dbFixture.CupRoundID.Int64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get cup round %d: %w", dbFixture.CupRoundID.Int64, err)
+		}
+		fixture.CupRound = &domain.CupRound{
+			ID:         round.ID,
+			CupName:    round.CupName,
+			Number:     int(round.Number),
+			Name:       round.Name,
+			Gameweek:   int(round.Gameweek),
+			Legs:       int(round.Legs),
+			GroupStage: round.GroupStage != 0,
+		}
+	}
+
+	// A second leg is played knowing how the first one went
+	if fixture.Leg == 2 {
+		firstLeg, err := r.queries.GetFirstLegResult(context.Background(), db.GetFirstLegResultParams{
+			CupRoundID: dbFixture.CupRoundID,
+			HomeTeamID: dbFixture.AwayTeamID,
+			AwayTeamID: dbFixture.HomeTeamID,
+		})
+		switch {
+		case err == nil:
+			fixture.FirstLeg = &domain.Result{
+				Gameweek:  int(fixture.CupRound.Gameweek),
+				Home:      awayTeam.Club,
+				Away:      homeTeam.Club,
+				HomeGoals: int(firstLeg.HomeScore),
+				AwayGoals: int(firstLeg.AwayScore),
+			}
+		case !errors.Is(err, sql.ErrNoRows):
+			return nil, fmt.Errorf("failed to get first leg of fixture %d: %w", dbFixture.ID, err)
+		}
+	}
+
+	return fixture, nil
 }
 
 // Ensure FixtureRepo implements domain.FixtureRepository
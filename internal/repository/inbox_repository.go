@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/cameronjpr/gaffer/internal/db"
+	"github.com/cameronjpr/gaffer/internal/domain"
+)
+
+type InboxRepo struct {
+	queries *db.Queries
+}
+
+func NewInboxRepository(queries *db.Queries) *InboxRepo {
+	return &InboxRepo{queries: queries}
+}
+
+// Post delivers messages to the manager's inbox
+func (r *InboxRepo) Post(messages []domain.Message) error {
+	ctx := context.Background()
+
+	for _, message := range messages {
+		err := r.queries.CreateMessage(ctx, db.CreateMessageParams{
+			MessageDate: message.Date.Format(domain.DateLayout),
+			Kind:        int64(message.Kind),
+			Subject:     message.Subject,
+			Body:        message.Body,
+			Status:      int64(message.Status),
+			PlayerID:    sql.NullInt64{Int64: message.PlayerID, Valid: message.PlayerID != 0},
+			ClubID:      sql.NullInt64{Int64: message.ClubID, Valid: message.ClubID != 0},
+			Fee:         message.Fee,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to post %q: %w", message.Subject, err)
+		}
+	}
+
+	return nil
+}
+
+// GetAll fetches every message in the inbox, latest first
+func (r *InboxRepo) GetAll() ([]domain.Message, error) {
+	ctx := context.Background()
+
+	rows, err := r.queries.GetMessages(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get messages: %w", err)
+	}
+
+	messages := make([]domain.Message, len(rows))
+	for i, row := range rows {
+		messages[i] = domain.Message{
+			ID:       row.ID,
+			Date:     domain.ParseDate(row.MessageDate),
+			Kind:     domain.MessageKind(row.Kind),
+			Subject:  row.Subject,
+			Body:     row.Body,
+			Read:     row.IsRead == 1,
+			Status:   domain.MessageStatus(row.Status),
+			PlayerID: row.PlayerID.Int64,
+			ClubID:   row.ClubID.Int64,
+			Fee:      row.Fee,
+		}
+	}
+
+	return messages, nil
+}
+
+// CountPending counts the messages waiting on an answer
+func (r *InboxRepo) CountPending() (int, error) {
+	ctx := context.Background()
+
+	count, err := r.queries.CountMessagesByStatus(ctx, int64(domain.MessagePending))
+	if err != nil {
+		return 0, fmt.Errorf("failed to count pending messages: %w", err)
+	}
+
+	return int(count), nil
+}
+
+// MarkRead marks the message as read
+func (r *InboxRepo) MarkRead(message domain.Message) error {
+	ctx := context.Background()
+
+	if err := r.queries.MarkMessageRead(ctx, message.ID); err != nil {
+		return fmt.Errorf("failed to mark %q read: %w", message.Subject, err)
+	}
+
+	return nil
+}
+
+// Resolve records the answer to a message, or that it lapsed
+func (r *InboxRepo) Resolve(message domain.Message, status domain.MessageStatus) error {
+	ctx := context.Background()
+
+	err := r.queries.SetMessageStatus(ctx, db.SetMessageStatusParams{
+		Status: int64(status),
+		ID:     message.ID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to resolve %q: %w", message.Subject, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/cameronjpr/gaffer/internal/db"
+	"github.com/cameronjpr/gaffer/internal/domain"
+)
+
+type InjuryRepo struct {
+	queries *db.Queries
+}
+
+func NewInjuryRepository(queries *db.Queries) *InjuryRepo {
+	return &InjuryRepo{queries: queries}
+}
+
+// RecordMatch persists the injuries picked up during a completed match
+func (r *InjuryRepo) RecordMatch(match *domain.Match) error {
+	ctx := context.Background()
+
+	for _, injury := range match.MatchInjuries() {
+		_, err := r.queries.CreateInjury(ctx, db.CreateInjuryParams{
+			PlayerID:      injury.PlayerID,
+			FixtureID:     sql.NullInt64{Int64: int64(match.ForFixture.ID), Valid: true},
+			InjuryType:    int64(injury.Type),
+			DaysOut:       int64(injury.DaysOut),
+			DaysRemaining: int64(injury.DaysRemaining),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record injury for %s: %w", injury.PlayerName, err)
+		}
+	}
+
+	return nil
+}
+
+// Record persists injuries picked up away from a match
+func (r *InjuryRepo) Record(injuries []domain.Injury) error {
+	ctx := context.Background()
+
+	for _, injury := range injuries {
+		_, err := r.queries.CreateInjury(ctx, db.CreateInjuryParams{
+			PlayerID:      injury.PlayerID,
+			InjuryType:    int64(injury.Type),
+			DaysOut:       int64(injury.DaysOut),
+			DaysRemaining: int64(injury.DaysRemaining),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record injury for %s: %w", injury.PlayerName, err)
+		}
+	}
+
+	return nil
+}
+
+// Recover runs down every active injury by the given number of days
+func (r *InjuryRepo) Recover(days int) error {
+	ctx := context.Background()
+
+	if err := r.queries.RecoverInjuries(ctx, int64(days)); err != nil {
+		return fmt.Errorf("failed to recover injuries: %w", err)
+	}
+
+	return nil
+}
+
+// GetInjuriesByClubID returns the club's players currently injured
+func (r *InjuryRepo) GetInjuriesByClubID(clubID int64) ([]domain.Injury, error) {
+	ctx := context.Background()
+
+	rows, err := r.queries.GetActiveInjuriesByClubID(ctx, clubID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get injuries for club %d: %w", clubID, err)
+	}
+
+	injuries := make([]domain.Injury, len(rows))
+	for i, row := range rows {
+		injuries[i] = dbInjuryToDomain(row)
+	}
+
+	return injuries, nil
+}
+
+// dbInjuryToDomain converts a database injury row to a domain Injury
+func dbInjuryToDomain(row db.GetActiveInjuriesByClubIDRow) domain.Injury {
+	return domain.Injury{
+		PlayerID:      row.PlayerID,
+		PlayerName:    row.PlayerName,
+		Type:          domain.InjuryType(row.InjuryType),
+		DaysOut:       int(row.DaysOut),
+		DaysRemaining: int(row.DaysRemaining),
+	}
+}
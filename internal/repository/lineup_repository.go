@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/cameronjpr/gaffer/internal/db"
+	"github.com/cameronjpr/gaffer/internal/domain"
+)
+
+type LineupRepo struct {
+	queries *db.Queries
+}
+
+func NewLineupRepository(queries *db.Queries) *LineupRepo {
+	return &LineupRepo{queries: queries}
+}
+
+// GetByClubID returns the club's saved lineup, or nil if none has been saved
+func (r *LineupRepo) GetByClubID(clubID int64) (*domain.Lineup, error) {
+	ctx := context.Background()
+
+	dbLineup, err := r.queries.GetLineupByClubID(ctx, clubID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get lineup for club %d: %w", clubID, err)
+	}
+
+	dbPlayers, err := r.queries.GetLineupPlayersByClubID(ctx, clubID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get lineup players for club %d: %w", clubID, err)
+	}
+
+	lineup := domain.NewLineup(domain.Formation(dbLineup.Formation))
+	lineup.CaptainID = dbLineup.CaptainID.Int64
+	lineup.SetPieceTakerID = dbLineup.SetPieceTakerID.Int64
+	for _, p := range dbPlayers {
+		if int(p.Slot) < lineup.SlotCount() {
+			lineup.SetPlayerAt(int(p.Slot), p.PlayerID)
+		}
+	}
+
+	return lineup, nil
+}
+
+// Save stores the lineup as the club's default for future matches
+func (r *LineupRepo) Save(clubID int64, lineup *domain.Lineup) error {
+	ctx := context.Background()
+
+	err := r.queries.UpsertLineup(ctx, db.UpsertLineupParams{
+		ClubID:          clubID,
+		Formation:       int64(lineup.Formation),
+		CaptainID:       sql.NullInt64{Int64: lineup.CaptainID, Valid: lineup.CaptainID != 0},
+		SetPieceTakerID: sql.NullInt64{Int64: lineup.SetPieceTakerID, Valid: lineup.SetPieceTakerID != 0},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save lineup for club %d: %w", clubID, err)
+	}
+
+	if err := r.queries.DeleteLineupPlayersByClubID(ctx, clubID); err != nil {
+		return fmt.Errorf("failed to clear lineup players for club %d: %w", clubID, err)
+	}
+
+	for slot := 0; slot < lineup.SlotCount(); slot++ {
+		playerID := lineup.PlayerAt(slot)
+		if playerID == 0 {
+			continue
+		}
+
+		err := r.queries.CreateLineupPlayer(ctx, db.CreateLineupPlayerParams{
+			ClubID:   clubID,
+			PlayerID: playerID,
+			Slot:     int64(slot),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to save lineup slot %d for club %d: %w", slot, clubID, err)
+		}
+	}
+
+	return nil
+}
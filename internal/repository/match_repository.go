@@ -2,8 +2,8 @@ package repository
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
-	"sort"
 
 	"github.com/cameronjpr/gaffer/internal/db"
 	"github.com/cameronjpr/gaffer/internal/domain"
@@ -60,6 +60,17 @@ func (r *MatchRepo) SaveResult(match *domain.Match) error {
 		return fmt.Errorf("failed to save match result: %w", err)
 	}
 
+	if match.Shootout != nil {
+		err := r.queries.RecordShootout(ctx, db.RecordShootoutParams{
+			HomePenalties: sql.NullInt64{Int64: int64(match.Shootout.HomeScored), Valid: true},
+			AwayPenalties: sql.NullInt64{Int64: int64(match.Shootout.AwayScored), Valid: true},
+			FixtureID:     int64(match.ForFixture.ID),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to save shootout: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -115,8 +126,8 @@ func (r *MatchRepo) GetAllCompletedResults() (map[int64][2]int, error) {
 	return results, nil
 }
 
-// CalculateLeagueTable computes league standings from completed matches
-func (r *MatchRepo) CalculateLeagueTable(clubs []*domain.ClubWithPlayers, fixtures []*domain.Fixture) (*domain.LeagueTable, error) {
+// GetResults returns the result of every played fixture in the list
+func (r *MatchRepo) GetResults(fixtures []*domain.Fixture) ([]domain.Result, error) {
 	ctx := context.Background()
 
 	// Get all completed matches
@@ -131,70 +142,38 @@ func (r *MatchRepo) CalculateLeagueTable(clubs []*domain.ClubWithPlayers, fixtur
 		matchResults[match.FixtureID] = match
 	}
 
-	// Calculate standings for each club
-	positions := make([]domain.LeaguePosition, 0, len(clubs))
-	for _, clubWithPlayers := range clubs {
-		club := clubWithPlayers.Club
-		position := domain.LeaguePosition{
-			Club: club,
+	results := make([]domain.Result, 0, len(matchResults))
+	for _, fixture := range fixtures {
+		// Check if this fixture has been played
+		match, played := matchResults[int64(fixture.ID)]
+		if !played {
+			continue
 		}
 
-		// Find all fixtures for this club
-		for _, fixture := range fixtures {
-			isHome := fixture.HomeTeam.Club.ID == club.ID
-			isAway := fixture.AwayTeam.Club.ID == club.ID
-
-			if !isHome && !isAway {
-				continue
-			}
-
-			// Check if this fixture has been played
-			match, played := matchResults[int64(fixture.ID)]
-			if !played {
-				continue
-			}
-
-			position.Played++
-			homeScore := int(match.HomeScore)
-			awayScore := int(match.AwayScore)
-
-			if isHome {
-				position.GoalsFor += homeScore
-				position.GoalsAgainst += awayScore
-
-				if homeScore > awayScore {
-					position.Won++
-					position.Points += 3
-				} else if homeScore == awayScore {
-					position.Drawn++
-					position.Points += 1
-				} else {
-					position.Lost++
-				}
-			} else {
-				position.GoalsFor += awayScore
-				position.GoalsAgainst += homeScore
-
-				if awayScore > homeScore {
-					position.Won++
-					position.Points += 3
-				} else if awayScore == homeScore {
-					position.Drawn++
-					position.Points += 1
-				} else {
-					position.Lost++
-				}
-			}
-		}
+		results = append(results, domain.Result{
+			Gameweek:  fixture.Gameweek,
+			Home:      fixture.HomeTeam.Club,
+			Away:      fixture.AwayTeam.Club,
+			HomeGoals: int(match.HomeScore),
+			AwayGoals: int(match.AwayScore),
+		})
+	}
 
-		position.GoalDifference = position.GoalsFor - position.GoalsAgainst
-		positions = append(positions, position)
+	return results, nil
+}
+
+// CalculateLeagueTable computes league standings from completed matches under
+// the competition's rules, counting only matches played at the given venue
+func (r *MatchRepo) CalculateLeagueTable(rules *domain.CompetitionRules, clubs []*domain.ClubWithPlayers, fixtures []*domain.Fixture, venue domain.Venue) (*domain.LeagueTable, error) {
+	results, err := r.GetResults(fixtures)
+	if err != nil {
+		return nil, err
 	}
 
-	// Sort the table
-	sort.Sort(domain.ByLeagueStanding(positions))
+	tableClubs := make([]*domain.Club, len(clubs))
+	for i, club := range clubs {
+		tableClubs[i] = club.Club
+	}
 
-	return &domain.LeagueTable{
-		Positions: positions,
-	}, nil
+	return domain.NewLeagueTable(rules, tableClubs, results, venue), nil
 }
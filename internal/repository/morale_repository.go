@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cameronjpr/gaffer/internal/db"
+	"github.com/cameronjpr/gaffer/internal/domain"
+)
+
+type MoraleRepo struct {
+	queries *db.Queries
+}
+
+func NewMoraleRepository(queries *db.Queries) *MoraleRepo {
+	return &MoraleRepo{queries: queries}
+}
+
+// Save stores each player's morale
+func (r *MoraleRepo) Save(players []domain.Player) error {
+	ctx := context.Background()
+
+	for _, player := range players {
+		err := r.queries.SetPlayerMorale(ctx, db.SetPlayerMoraleParams{
+			Morale: int64(player.Morale),
+			ID:     player.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to save morale for %s: %w", player.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// Promise promises the player a bigger role in the squad, returning them
+// with their new role and morale
+func (r *MoraleRepo) Promise(player domain.Player) (domain.Player, error) {
+	ctx := context.Background()
+
+	if !player.PromiseRole() {
+		return player, fmt.Errorf("%s is already a key player", player.Name)
+	}
+	if err := r.queries.SetPlayerContract(ctx, contractParams(player.ID, player.Contract)); err != nil {
+		return player, fmt.Errorf("failed to promise %s a role: %w", player.Name, err)
+	}
+	if err := r.Save([]domain.Player{player}); err != nil {
+		return player, err
+	}
+
+	return player, nil
+}
+
+// RecordMatch moves the morale of both squads after a completed match, and
+// each club's cohesion on how much of its side it kept from its last match
+func (r *MoraleRepo) RecordMatch(match *domain.Match) error {
+	ctx := context.Background()
+
+	sides := []struct {
+		squad              *domain.ClubWithPlayers
+		participant, other *domain.MatchParticipant
+	}{
+		{match.ForFixture.HomeTeam, match.Home, match.Away},
+		{match.ForFixture.AwayTeam, match.Away, match.Home},
+	}
+	for _, side := range sides {
+		domain.MatchMorale(side.squad, side.participant, side.other)
+		if err := r.Save(side.squad.Players); err != nil {
+			return err
+		}
+
+		club := side.participant.Club
+		previous, err := r.queries.GetLastStartersByClubID(ctx, club.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get last starters for %s: %w", club.Name, err)
+		}
+		starters := side.participant.Starters()
+		club.KeptTogether(previous, starters)
+
+		err = r.queries.SetClubCohesion(ctx, db.SetClubCohesionParams{
+			Cohesion: int64(club.Cohesion),
+			ID:       club.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to save cohesion for %s: %w", club.Name, err)
+		}
+		if err := r.queries.DeleteLastStartersByClubID(ctx, club.ID); err != nil {
+			return fmt.Errorf("failed to clear last starters for %s: %w", club.Name, err)
+		}
+		for _, playerID := range starters {
+			err := r.queries.CreateLastStarter(ctx, db.CreateLastStarterParams{
+				ClubID:   club.ID,
+				PlayerID: playerID,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to save last starters for %s: %w", club.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,165 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/cameronjpr/gaffer/internal/db"
+	"github.com/cameronjpr/gaffer/internal/domain"
+)
+
+type SeasonRepo struct {
+	queries *db.Queries
+}
+
+func NewSeasonRepository(queries *db.Queries) *SeasonRepo {
+	return &SeasonRepo{queries: queries}
+}
+
+// GetCurrent fetches the season being played
+func (r *SeasonRepo) GetCurrent() (*domain.Season, error) {
+	ctx := context.Background()
+
+	season, err := r.queries.GetCurrentSeason(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current season: %w", err)
+	}
+
+	return &domain.Season{
+		ID:        season.ID,
+		StartYear: int(season.StartYear),
+		Today:     domain.ParseDate(season.Today),
+	}, nil
+}
+
+// SetToday records the day the game has reached in the season
+func (r *SeasonRepo) SetToday(season *domain.Season) error {
+	ctx := context.Background()
+
+	err := r.queries.SetSeasonToday(ctx, db.SetSeasonTodayParams{
+		Today: season.Today.Format(domain.DateLayout),
+		ID:    season.ID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set the date in season %s: %w", season.Name(), err)
+	}
+
+	return nil
+}
+
+// Rollover closes the current season and opens the next one: every division's
+// final table is archived, promoted and relegated clubs change division,
+// everyone comes back rested and the new fixtures are scheduled. The new
+// season's calendar opens in pre-season. It all happens in one transaction,
+// joining the caller's if there is one, so a failure part way leaves the
+// finished season as it was.
+func (r *SeasonRepo) Rollover(season *domain.Season, tables map[int64]*domain.LeagueTable, movements []domain.Movement, fixtures []*domain.Fixture) (*domain.Season, error) {
+	ctx := context.Background()
+
+	var next *domain.Season
+	err := r.queries.Transact(ctx, func(queries *db.Queries) error {
+		for divisionID, table := range tables {
+			for i, position := range table.Positions {
+				err := queries.CreateSeasonStanding(ctx, db.CreateSeasonStandingParams{
+					SeasonID:     season.ID,
+					ClubID:       position.Club.ID,
+					DivisionID:   sql.NullInt64{Int64: divisionID, Valid: true},
+					Position:     int64(i + 1),
+					Played:       int64(position.Played),
+					Won:          int64(position.Won),
+					Drawn:        int64(position.Drawn),
+					Lost:         int64(position.Lost),
+					GoalsFor:     int64(position.GoalsFor),
+					GoalsAgainst: int64(position.GoalsAgainst),
+					Points:       int64(position.Points),
+				})
+				if err != nil {
+					return fmt.Errorf("failed to archive standing for club %d: %w", position.Club.ID, err)
+				}
+			}
+		}
+
+		for _, movement := range movements {
+			err := queries.UpdateClubDivision(ctx, db.UpdateClubDivisionParams{
+				DivisionID: sql.NullInt64{Int64: movement.To.ID, Valid: true},
+				ID:         movement.Club.ID,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to move %s to %s: %w", movement.Club.Name, movement.To.Name, err)
+			}
+		}
+
+		if err := queries.CompleteSeason(ctx, season.ID); err != nil {
+			return fmt.Errorf("failed to complete season %s: %w", season.Name(), err)
+		}
+
+		dbSeason, err := queries.CreateSeason(ctx, db.CreateSeasonParams{
+			StartYear: int64(season.StartYear + 1),
+			Today:     domain.PreseasonStart(season.StartYear + 1).Format(domain.DateLayout),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create season %d: %w", season.StartYear+1, err)
+		}
+		next = &domain.Season{
+			ID:        dbSeason.ID,
+			StartYear: int(dbSeason.StartYear),
+			Today:     domain.ParseDate(dbSeason.Today),
+		}
+
+		// Everyone comes back from the summer fully rested
+		if err := queries.RecoverPlayerFatigue(ctx, 100); err != nil {
+			return fmt.Errorf("failed to rest players: %w", err)
+		}
+
+		for _, fixture := range fixtures {
+			_, err := queries.CreateFixture(ctx, db.CreateFixtureParams{
+				Gameweek:   int64(fixture.Gameweek),
+				HomeTeamID: fixture.HomeTeam.Club.ID,
+				AwayTeamID: fixture.AwayTeam.Club.ID,
+				SeasonID:   sql.NullInt64{Int64: next.ID, Valid: true},
+				DivisionID: sql.NullInt64{Int64: fixture.DivisionID, Valid: true},
+				Leg:        1,
+				MatchDate:  domain.MatchDate(next.StartYear, fixture.Gameweek, "").Format(domain.DateLayout),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create fixture %s vs %s: %w", fixture.HomeTeam.Club.Name, fixture.AwayTeam.Club.Name, err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return next, nil
+}
+
+// GetHistoryByClubID fetches a club's final standings from every completed season, most recent first
+func (r *SeasonRepo) GetHistoryByClubID(clubID int64) ([]domain.SeasonRecord, error) {
+	ctx := context.Background()
+
+	rows, err := r.queries.GetSeasonHistoryByClubID(ctx, clubID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get season history for club %d: %w", clubID, err)
+	}
+
+	history := make([]domain.SeasonRecord, len(rows))
+	for i, row := range rows {
+		history[i] = domain.SeasonRecord{
+			Season:       domain.Season{StartYear: int(row.StartYear)},
+			Division:     row.DivisionName,
+			Position:     int(row.Position),
+			Played:       int(row.Played),
+			Won:          int(row.Won),
+			Drawn:        int(row.Drawn),
+			Lost:         int(row.Lost),
+			GoalsFor:     int(row.GoalsFor),
+			GoalsAgainst: int(row.GoalsAgainst),
+			Points:       int(row.Points),
+		}
+	}
+
+	return history, nil
+}
@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cameronjpr/gaffer/internal/db"
+	"github.com/cameronjpr/gaffer/internal/domain"
+)
+
+type StatsRepo struct {
+	queries *db.Queries
+}
+
+func NewStatsRepository(queries *db.Queries) *StatsRepo {
+	return &StatsRepo{queries: queries}
+}
+
+// RecordMatch persists an appearance for every player who took part in a completed match
+func (r *StatsRepo) RecordMatch(match *domain.Match) error {
+	ctx := context.Background()
+
+	for _, appearance := range match.Appearances() {
+		started := int64(0)
+		if appearance.Started {
+			started = 1
+		}
+
+		err := r.queries.CreatePlayerAppearance(ctx, db.CreatePlayerAppearanceParams{
+			PlayerID:    appearance.PlayerID,
+			FixtureID:   int64(appearance.FixtureID),
+			Started:     started,
+			Goals:       int64(appearance.Goals),
+			YellowCards: int64(appearance.YellowCards),
+			RedCards:    int64(appearance.RedCards),
+			Rating:      appearance.Rating,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record appearance for player %d: %w", appearance.PlayerID, err)
+		}
+	}
+
+	return nil
+}
+
+// GetSeasonStatsByClubID returns season stats for each of the club's players
+// who has made an appearance, keyed by player ID
+func (r *StatsRepo) GetSeasonStatsByClubID(clubID int64) (map[int64]domain.SeasonStats, error) {
+	ctx := context.Background()
+
+	rows, err := r.queries.GetAppearancesByClubID(ctx, clubID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get appearances for club %d: %w", clubID, err)
+	}
+
+	appearances := make(map[int64][]domain.Appearance)
+	for _, row := range rows {
+		appearances[row.PlayerID] = append(appearances[row.PlayerID], domain.Appearance{
+			PlayerID:    row.PlayerID,
+			FixtureID:   int(row.FixtureID),
+			Started:     row.Started == 1,
+			Goals:       int(row.Goals),
+			YellowCards: int(row.YellowCards),
+			RedCards:    int(row.RedCards),
+			Rating:      row.Rating,
+		})
+	}
+
+	stats := make(map[int64]domain.SeasonStats, len(appearances))
+	for playerID, playerAppearances := range appearances {
+		stats[playerID] = domain.NewSeasonStats(playerAppearances)
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cameronjpr/gaffer/internal/db"
+	"github.com/cameronjpr/gaffer/internal/domain"
+)
+
+type TrainingRepo struct {
+	queries *db.Queries
+}
+
+func NewTrainingRepository(queries *db.Queries) *TrainingRepo {
+	return &TrainingRepo{queries: queries}
+}
+
+// SetPlan puts the club on a new training schedule
+func (r *TrainingRepo) SetPlan(club *domain.Club, plan domain.TrainingPlan) error {
+	ctx := context.Background()
+
+	err := r.queries.SetClubTraining(ctx, db.SetClubTrainingParams{
+		TrainingIntensity: int64(plan.Intensity),
+		TrainingFocus:     int64(plan.Focus),
+		ID:                club.ID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set training for %s: %w", club.Name, err)
+	}
+
+	club.Training = plan
+	return nil
+}
+
+// SetFocus gives the player a focus of their own in training
+func (r *TrainingRepo) SetFocus(player domain.Player, focus domain.TrainingFocus) error {
+	ctx := context.Background()
+
+	err := r.queries.SetPlayerTrainingFocus(ctx, db.SetPlayerTrainingFocusParams{
+		TrainingFocus: int64(focus),
+		ID:            player.ID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set training focus for %s: %w", player.Name, err)
+	}
+
+	return nil
+}
+
+// SaveWeek stores each player's development and condition after a week's
+// training
+func (r *TrainingRepo) SaveWeek(players []domain.Player) error {
+	ctx := context.Background()
+
+	for _, player := range players {
+		err := r.queries.SetPlayerTraining(ctx, db.SetPlayerTrainingParams{
+			Quality:   int64(player.Quality),
+			Progress:  int64(player.Progress),
+			Fatigue:   int64(player.Fatigue),
+			Sharpness: int64(player.Sharpness),
+			ID:        player.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to save training for %s: %w", player.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// RecordMatch builds each side's familiarity with the formation it played
+// in a completed match
+func (r *TrainingRepo) RecordMatch(match *domain.Match) error {
+	ctx := context.Background()
+
+	for _, participant := range []*domain.MatchParticipant{match.Home, match.Away} {
+		club := participant.Club
+		club.PlayedFormation(participant.Formation)
+		err := r.queries.SetClubFamiliarity(ctx, db.SetClubFamiliarityParams{
+			Formation:   club.Formation,
+			Familiarity: int64(club.Familiarity),
+			ID:          club.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record formation for %s: %w", club.Name, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cameronjpr/gaffer/internal/db"
+	"github.com/cameronjpr/gaffer/internal/domain"
+)
+
+type TransferRepo struct {
+	queries *db.Queries
+}
+
+func NewTransferRepository(queries *db.Queries) *TransferRepo {
+	return &TransferRepo{queries: queries}
+}
+
+// Complete moves the player to their new club, or out on loan, registers
+// them on any new contract, and records the deal
+func (r *TransferRepo) Complete(transfer *domain.Transfer) error {
+	ctx := context.Background()
+
+	var err error
+	if transfer.Loan {
+		err = r.queries.LoanPlayer(ctx, db.LoanPlayerParams{
+			ClubID: transfer.To.ID,
+			ID:     transfer.Player.ID,
+		})
+	} else {
+		err = r.queries.UpdatePlayerClub(ctx, db.UpdatePlayerClubParams{
+			ClubID: transfer.To.ID,
+			ID:     transfer.Player.ID,
+		})
+	}
+	if err != nil {
+		return fmt.Errorf("failed to move %s to %s: %w", transfer.Player.Name, transfer.To.Name, err)
+	}
+
+	if transfer.Contract != nil {
+		err = r.queries.SetPlayerContract(ctx, contractParams(transfer.Player.ID, *transfer.Contract))
+		if err != nil {
+			return fmt.Errorf("failed to sign contract for %s: %w", transfer.Player.Name, err)
+		}
+	}
+
+	isLoan := int64(0)
+	if transfer.Loan {
+		isLoan = 1
+	}
+	err = r.queries.CreateTransfer(ctx, db.CreateTransferParams{
+		PlayerID:     transfer.Player.ID,
+		FromClubID:   transfer.From.ID,
+		ToClubID:     transfer.To.ID,
+		Fee:          transfer.Fee,
+		TransferDate: transfer.Date.Format(domain.DateLayout),
+		IsLoan:       isLoan,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record transfer of %s: %w", transfer.Player.Name, err)
+	}
+
+	return nil
+}
+
+// GetCurrentSeason fetches every transfer made this season, latest first
+func (r *TransferRepo) GetCurrentSeason() ([]domain.Transfer, error) {
+	ctx := context.Background()
+
+	rows, err := r.queries.GetCurrentSeasonTransfers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transfers: %w", err)
+	}
+
+	transfers := make([]domain.Transfer, len(rows))
+	for i, row := range rows {
+		transfers[i] = domain.Transfer{
+			Player: domain.Player{
+				ID:       row.PlayerID,
+				Name:     row.PlayerName,
+				Position: row.Position,
+				Quality:  int(row.Quality),
+				Age:      int(row.Age),
+			},
+			From: &domain.Club{ID: row.FromClubID, Name: row.FromClubName},
+			To:   &domain.Club{ID: row.ToClubID, Name: row.ToClubName},
+			Fee:  row.Fee,
+			Date: domain.ParseDate(row.TransferDate),
+			Loan: row.IsLoan == 1,
+		}
+	}
+
+	return transfers, nil
+}
+
+// ReturnLoans sends every player out on loan back to their own club
+func (r *TransferRepo) ReturnLoans() error {
+	ctx := context.Background()
+
+	if err := r.queries.ReturnLoanedPlayers(ctx); err != nil {
+		return fmt.Errorf("failed to return loaned players: %w", err)
+	}
+	return nil
+}
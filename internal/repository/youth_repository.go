@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+
+	"github.com/cameronjpr/gaffer/internal/db"
+	"github.com/cameronjpr/gaffer/internal/domain"
+)
+
+type YouthRepo struct {
+	queries *db.Queries
+}
+
+func NewYouthRepository(queries *db.Queries) *YouthRepo {
+	return &YouthRepo{queries: queries}
+}
+
+// OpenAcademies rates the youth setup of every club that doesn't have one yet
+func (r *YouthRepo) OpenAcademies(clubs []*domain.ClubWithPlayers, rng *rand.Rand) error {
+	ctx := context.Background()
+
+	for _, club := range clubs {
+		if club.Club.Academy != 0 {
+			continue
+		}
+		academy := domain.AcademyRating(club.Club, rng)
+		err := r.queries.SetClubAcademy(ctx, db.SetClubAcademyParams{
+			Academy: int64(academy),
+			ID:      club.Club.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to open academy at %s: %w", club.Club.Name, err)
+		}
+		club.Club.Academy = academy
+	}
+
+	return nil
+}
+
+// Sign registers each graduate with their club, setting the ID each is
+// given
+func (r *YouthRepo) Sign(graduates []domain.Graduate) error {
+	ctx := context.Background()
+
+	for i, graduate := range graduates {
+		player := graduate.Player
+		dbPlayer, err := r.queries.CreatePlayer(ctx, db.CreatePlayerParams{
+			ClubID:   graduate.Club.ID,
+			Name:     player.Name,
+			Quality:  int64(player.Quality),
+			Position: player.Position,
+			Age:      int64(player.Age),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create %s: %w", player.Name, err)
+		}
+		player.ID = dbPlayer.ID
+
+		err = r.queries.SetPlayerBirthAndPotential(ctx, db.SetPlayerBirthAndPotentialParams{
+			DateOfBirth: player.DateOfBirth.Format(domain.DateLayout),
+			Potential:   int64(player.Potential),
+			ID:          player.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to register %s: %w", player.Name, err)
+		}
+		err = r.queries.SetPlayerNationality(ctx, db.SetPlayerNationalityParams{
+			Nationality: player.Nationality,
+			ID:          player.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to set nationality for %s: %w", player.Name, err)
+		}
+		if err := r.queries.SetPlayerContract(ctx, contractParams(player.ID, player.Contract)); err != nil {
+			return fmt.Errorf("failed to sign contract for %s: %w", player.Name, err)
+		}
+
+		graduates[i].Player = player
+	}
+
+	return nil
+}
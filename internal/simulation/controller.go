@@ -61,8 +61,24 @@ func (mc *MatchController) Run() {
 				continue // Skip simulation while paused
 			}
 
-			// Simulate one minute
-			mc.engine.SimulateMinute()
+			// A knockout match level after 90 minutes pauses before extra time
+			if mc.engine.AdvancePeriod() {
+				mc.eventChan <- ExtraTimeMsg{Match: mc.match}
+				mc.paused = true
+				continue
+			}
+
+			switch {
+			case mc.match.NeedsShootout():
+				// Still level after extra time, so it's penalties
+				mc.match.StartShootout()
+			case mc.match.IsShootout():
+				// One kick per tick so the shootout plays out live
+				mc.engine.SimulatePenalty()
+			default:
+				// Simulate one minute
+				mc.engine.SimulateMinute()
+			}
 
 			// Get the latest event if one occurred this phase
 			var latestEvent *domain.Event
@@ -24,6 +24,27 @@ const zoneProgressionScaling = 2.0
 const shotOnTargetThreshold = 0.1 // 10% of shots miss the target entirely
 const saveThreshold = 0.4         // 40% of on-target shots are saved (30% overall)
 
+// Discipline probabilities
+// Each tackle that wins the ball back has a chance of being penalised as a foul,
+// and each foul has a chance of earning the offender a card
+const foulChance = 0.3          // 30% of tackles are fouls
+const bookingChance = 0.2       // 20% of fouls are booked
+const straightRedChance = 0.004 // 0.4% of fouls are straight reds
+
+// Injury probability for the player losing the ball in a tackle, before
+// fatigue and foul modifiers are applied
+const tackleInjuryChance = 0.005
+
+// Shootout penalty duels
+// Between an evenly matched taker and keeper about three in four penalties
+// are scored. Each point of quality the taker has over the keeper shifts
+// that a little, and a taker out on their feet loses some composure.
+const penaltyConversionChance = 0.75
+const penaltyQualityEdge = 0.015  // Conversion gained per point of quality over the keeper
+const penaltyFatigueEffect = 0.15 // Conversion lost by a taker with no stamina left
+const penaltySaveShare = 0.6      // Share of failed kicks an average keeper saves
+const standInKeeperQuality = 5    // An outfielder in goal after the keeper is sent off
+
 // Engine runs the match simulation
 type Engine struct {
 	Match *domain.Match
@@ -152,11 +173,108 @@ func (e *Engine) AttemptShot(powerDiff int) int {
 	return 1
 }
 
-// TODO – add SimulateMatch to handle calling PlayPhase, instead of TUI
+// ResolveTackle checks whether the team winning the ball did so with a foul,
+// and if so books or dismisses the offending player. The tackled player may
+// be injured, more likely if they are tired or were fouled.
+func (e *Engine) ResolveTackle(tacklingTeam, tackledTeam *domain.MatchParticipant) {
+	fouled := rand.Float64() < foulChance
+
+	if fouled {
+		if offender := tacklingTeam.GetRandomOutfielder(); offender != nil {
+			e.Match.AddEvent(domain.NewEvent(
+				domain.FoulEvent,
+				e.Match.CurrentMinute,
+				tacklingTeam,
+				offender,
+			))
+
+			cardRoll := rand.Float64()
+			if cardRoll < straightRedChance {
+				e.Match.SendOff(tacklingTeam, offender)
+			} else if cardRoll < bookingChance {
+				e.Match.BookPlayer(tacklingTeam, offender)
+			}
+		}
+	}
+
+	victim := tackledTeam.GetRandomOutfielder()
+	if victim == nil {
+		return
+	}
+	if rand.Float64() < domain.InjuryChance(tackleInjuryChance, victim.Stamina, fouled) {
+		e.Match.InjurePlayer(tackledTeam, victim)
+	}
+}
+
+// SimulateMatch plays the match through to the end, including extra time
+// and penalties if a knockout match needs them
 func (e *Engine) SimulateMatch() {
 	for !e.Match.IsFullTime() {
-		e.SimulateMinute()
+		e.AdvancePeriod()
+		switch {
+		case e.Match.NeedsShootout():
+			e.Match.StartShootout()
+		case e.Match.IsShootout():
+			e.SimulatePenalty()
+		default:
+			e.SimulateMinute()
+		}
+	}
+}
+
+// AdvancePeriod moves a knockout match level after normal time into extra
+// time, and extra time into its second period. Returns true if the match
+// went to extra time.
+func (e *Engine) AdvancePeriod() bool {
+	switch {
+	case e.Match.NeedsExtraTime():
+		e.Match.StartExtraTime()
+		return true
+	case e.Match.IsExtraTimeHalfTime():
+		e.Match.StartExtraTimeSecondHalf()
 	}
+	return false
+}
+
+// SimulatePenalty takes the next kick of the shootout as a duel between the
+// side's next taker and the other side's keeper
+func (e *Engine) SimulatePenalty() {
+	shootout := e.Match.Shootout
+	kicking, defending := e.Match.Away, e.Match.Home
+	if shootout.HomeToKick() {
+		kicking, defending = e.Match.Home, e.Match.Away
+	}
+
+	kick := domain.PenaltyKick{
+		For:     kicking,
+		Taker:   shootout.NextTaker(),
+		Keeper:  defending.Goalkeeper(),
+		Outcome: domain.PenaltyScored,
+	}
+	keeperQuality := standInKeeperQuality
+	if kick.Keeper != nil {
+		keeperQuality = kick.Keeper.Player.Quality
+	}
+
+	chance := math.Min(0.95, penaltyScoringChance(kick.Taker, keeperQuality)+kicking.PenaltyEdge(kick.Taker))
+	if rand.Float64() >= chance {
+		kick.Outcome = domain.PenaltyMissed
+		saveShare := penaltySaveShare + float64(keeperQuality-10)*penaltyQualityEdge*2
+		if rand.Float64() < saveShare {
+			kick.Outcome = domain.PenaltySaved
+		}
+	}
+
+	e.Match.TakePenalty(kick)
+}
+
+// penaltyScoringChance returns how likely a taker is to score against a
+// keeper of the given quality
+func penaltyScoringChance(taker *domain.MatchPlayerParticipant, keeperQuality int) float64 {
+	chance := penaltyConversionChance +
+		float64(taker.Player.Quality-keeperQuality)*penaltyQualityEdge -
+		penaltyFatigueEffect*(1-taker.Stamina/100)
+	return math.Max(0.5, math.Min(0.95, chance))
 }
 
 // SimulateMinute simulates one phase of play (roughly one minute)
@@ -166,8 +284,8 @@ func (e *Engine) SimulateMinute() {
 	homeRoll := rand.IntN(20)
 	awayRoll := rand.IntN(20)
 
-	homePhasePower := int(e.Match.Home.GetAverageQuality()) + homeRoll
-	awayPhasePower := int(e.Match.Away.GetAverageQuality()) + awayRoll
+	homePhasePower := int(e.Match.Home.PhaseStrength()) + homeRoll
+	awayPhasePower := int(e.Match.Away.PhaseStrength()) + awayRoll
 	powerDiff := int(math.Abs(float64(homePhasePower - awayPhasePower)))
 
 	morePowerfulTeam := e.Match.Home
@@ -183,7 +301,9 @@ func (e *Engine) SimulateMinute() {
 			morePowerfulTeam,
 			nil,
 		))
+		tackledTeam := e.Match.TeamInPossession
 		e.Match.TeamInPossession = morePowerfulTeam
+		e.ResolveTackle(morePowerfulTeam, tackledTeam)
 
 		// When possession changes, ball likely moves backward for new team
 		// The new team's attacking direction determines what "backward" means
@@ -418,3 +418,55 @@ func TestStrongerTeamsPenetrateMore(t *testing.T) {
 		t.Errorf("Weaker team shouldn't consistently outperform stronger team in zone penetration")
 	}
 }
+
+// TestKnockoutMatchesAlwaysHaveAWinner verifies cup ties go to extra time and
+// penalties rather than ending level
+func TestKnockoutMatchesAlwaysHaveAWinner(t *testing.T) {
+	testDB, queries := setupTestDB(t)
+	defer testDB.Close()
+
+	homeClub, awayClub := getTestClubs(t, queries)
+
+	for i := 0; i < 50; i++ {
+		fixture := &domain.Fixture{HomeTeam: homeClub, AwayTeam: awayClub, CupRound: &domain.CupRound{Name: "Round 1"}}
+		match := domain.NewMatchFromFixture(fixture)
+		NewEngine(match).SimulateMatch()
+
+		if !match.IsFullTime() {
+			t.Fatalf("Match %d did not finish", i)
+		}
+		if match.GetWinner() == nil {
+			t.Fatalf("Match %d ended without a winner", i)
+		}
+		if match.Shootout != nil {
+			if !match.Shootout.IsOver() {
+				t.Errorf("Match %d ended before its shootout was decided", i)
+			}
+			if len(match.Shootout.Kicks) != match.Shootout.HomeKicks+match.Shootout.AwayKicks {
+				t.Errorf("Match %d recorded %d kicks, expected %d", i, len(match.Shootout.Kicks), match.Shootout.HomeKicks+match.Shootout.AwayKicks)
+			}
+		}
+	}
+}
+
+// TestPenaltyScoringChance verifies better takers score more against weaker
+// keepers, and tired takers less
+func TestPenaltyScoringChance(t *testing.T) {
+	taker := func(quality int, stamina float64) *domain.MatchPlayerParticipant {
+		return &domain.MatchPlayerParticipant{Player: &domain.Player{Quality: quality}, Stamina: stamina}
+	}
+
+	even := penaltyScoringChance(taker(15, 100), 15)
+	if even != penaltyConversionChance {
+		t.Errorf("Expected %.2f for an even duel, got %.2f", penaltyConversionChance, even)
+	}
+	if better := penaltyScoringChance(taker(19, 100), 12); better <= even {
+		t.Errorf("Expected a better taker to score more often than %.2f, got %.2f", even, better)
+	}
+	if tired := penaltyScoringChance(taker(15, 20), 15); tired >= even {
+		t.Errorf("Expected a tired taker to score less often than %.2f, got %.2f", even, tired)
+	}
+	if worst := penaltyScoringChance(taker(1, 0), 20); worst < 0.5 {
+		t.Errorf("Expected scoring chance to be at least 0.5, got %.2f", worst)
+	}
+}
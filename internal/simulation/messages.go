@@ -53,6 +53,11 @@ type HalftimeMsg struct {
 	Match *domain.Match
 }
 
+// ExtraTimeMsg is sent when a knockout match goes to extra time
+type ExtraTimeMsg struct {
+	Match *domain.Match
+}
+
 // FulltimeMsg is sent when match ends
 type FulltimeMsg struct {
 	Match *domain.Match
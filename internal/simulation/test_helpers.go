@@ -28,7 +28,19 @@ func setupTestDB(t *testing.T) (*sql.DB, *db.Queries) {
 			strength INTEGER NOT NULL CHECK(strength >= 0 AND strength <= 20),
 			background_color TEXT NOT NULL,
 			foreground_color TEXT NOT NULL,
-			created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			division_id INTEGER,
+			balance INTEGER NOT NULL DEFAULT 0,
+			transfer_budget INTEGER NOT NULL DEFAULT 0,
+			academy INTEGER NOT NULL DEFAULT 0,
+			training_intensity INTEGER NOT NULL DEFAULT 1,
+			training_focus INTEGER NOT NULL DEFAULT 0,
+			formation TEXT NOT NULL DEFAULT '',
+			familiarity INTEGER NOT NULL DEFAULT 0,
+			cohesion INTEGER NOT NULL DEFAULT 50,
+			objective INTEGER NOT NULL DEFAULT 0,
+			confidence INTEGER NOT NULL DEFAULT 60,
+			vacant_since TEXT NOT NULL DEFAULT ''
 		);
 
 		CREATE TABLE IF NOT EXISTS players (
@@ -37,8 +49,44 @@ func setupTestDB(t *testing.T) (*sql.DB, *db.Queries) {
 			name TEXT NOT NULL,
 			quality INTEGER NOT NULL CHECK(quality >= 0 AND quality <= 20),
 			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			position TEXT NOT NULL DEFAULT '',
+			age INTEGER NOT NULL DEFAULT 0,
+			fatigue INTEGER NOT NULL DEFAULT 0,
+			loaned_from_club_id INTEGER REFERENCES clubs(id),
+			wage INTEGER NOT NULL DEFAULT 0,
+			contract_ends INTEGER NOT NULL DEFAULT 0,
+			release_clause INTEGER NOT NULL DEFAULT 0,
+			squad_role INTEGER NOT NULL DEFAULT 0,
+			date_of_birth TEXT NOT NULL DEFAULT '',
+			potential INTEGER NOT NULL DEFAULT 0,
+			progress INTEGER NOT NULL DEFAULT 0,
+			nationality TEXT NOT NULL DEFAULT '',
+			sharpness INTEGER NOT NULL DEFAULT 100,
+			training_focus INTEGER NOT NULL DEFAULT 0,
+			morale INTEGER NOT NULL DEFAULT 50,
 			FOREIGN KEY (club_id) REFERENCES clubs(id) ON DELETE CASCADE
 		);
+
+		CREATE TABLE IF NOT EXISTS suspensions (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			player_id INTEGER NOT NULL,
+			reason TEXT NOT NULL,
+			matches_banned INTEGER NOT NULL CHECK(matches_banned > 0),
+			matches_remaining INTEGER NOT NULL CHECK(matches_remaining >= 0),
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			FOREIGN KEY (player_id) REFERENCES players(id) ON DELETE CASCADE
+		);
+
+		CREATE TABLE IF NOT EXISTS injuries (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			player_id INTEGER NOT NULL,
+			fixture_id INTEGER,
+			injury_type INTEGER NOT NULL,
+			days_out INTEGER NOT NULL CHECK(days_out > 0),
+			days_remaining INTEGER NOT NULL CHECK(days_remaining >= 0),
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			FOREIGN KEY (player_id) REFERENCES players(id) ON DELETE CASCADE
+		);
 	`
 
 	if _, err := database.Exec(schema); err != nil {
@@ -1,6 +1,8 @@
 package tui
 
 import (
+	"math/rand/v2"
+
 	"github.com/cameronjpr/gaffer/internal/db"
 	"github.com/cameronjpr/gaffer/internal/domain"
 	"github.com/cameronjpr/gaffer/internal/repository"
@@ -15,77 +17,199 @@ const (
 	ManagerHubMode
 	PreMatchMode
 	MatchMode
+	SeasonSummaryMode
+	TransferMarketMode
+	VacanciesMode
 )
 
 type AppModel struct {
-	gameStateRepo *repository.GameStateRepo
-	clubRepo      domain.ClubRepository // address this
-	fixtureRepo   domain.FixtureRepository
-	matchRepo     *repository.MatchRepo
-	mode          Mode
-	clubs         []*domain.ClubWithPlayers
-	fixtures      []*domain.Fixture
-	currentMatch  *domain.Match
-	menu          *MenuModel
-	onboarding    *OnboardingModel
-	managerHub    *ManagerHubModel
-	prematch      *PreMatchModel
-	match         *MatchModel
-	width         int
-	height        int
+	queries        *db.Queries
+	gameStateRepo  *repository.GameStateRepo
+	clubRepo       domain.ClubRepository // address this
+	fixtureRepo    domain.FixtureRepository
+	matchRepo      *repository.MatchRepo
+	disciplineRepo *repository.DisciplineRepo
+	injuryRepo     *repository.InjuryRepo
+	fitnessRepo    *repository.FitnessRepo
+	lineupRepo     *repository.LineupRepo
+	statsRepo      *repository.StatsRepo
+	seasonRepo     *repository.SeasonRepo
+	divisionRepo   *repository.DivisionRepo
+	cupRepo        *repository.CupRepo
+	transferRepo   *repository.TransferRepo
+	financeRepo    *repository.FinanceRepo
+	contractRepo   *repository.ContractRepo
+	developRepo    *repository.DevelopmentRepo
+	youthRepo      *repository.YouthRepo
+	trainingRepo   *repository.TrainingRepo
+	moraleRepo     *repository.MoraleRepo
+	inboxRepo      *repository.InboxRepo
+	boardRepo      *repository.BoardRepo
+	mode           Mode
+	season         *domain.Season
+	divisions      []*domain.Division
+	clubs          []*domain.ClubWithPlayers
+	fixtures       []*domain.Fixture
+	currentMatch   *domain.Match
+	menu           *MenuModel
+	onboarding     *OnboardingModel
+	managerHub     *ManagerHubModel
+	prematch       *PreMatchModel
+	match          *MatchModel
+	seasonSummary  *SeasonSummaryModel
+	market         *TransferMarketModel
+	vacancies      *VacanciesModel
+	status         string // The last error, shown under the screen until a key is pressed
+	width          int
+	height         int
 }
 
 func NewModel(queries *db.Queries) *AppModel {
-	// Create repositories
-	gameStateRepo := repository.NewGameStateRepository(queries)
-	clubRepo := repository.NewClubRepository(queries)
-	fixtureRepo := repository.NewFixtureRepository(queries, clubRepo)
-	matchRepo := repository.NewMatchRepository(queries)
+	m := &AppModel{queries: queries}
+	m.bindRepositories(queries)
+
+	season, err := m.seasonRepo.GetCurrent()
+	if err != nil {
+		panic(err)
+	}
+
+	divisions, err := m.divisionRepo.GetAll()
+	if err != nil {
+		panic(err)
+	}
 
 	// Get all clubs with players from repository
-	clubs, err := clubRepo.GetAll()
+	clubs, err := m.clubRepo.GetAll()
 	if err != nil {
 		panic(err)
 	}
 
+	// Give every player a birthday and a potential and sign them to a
+	// contract, rate every club's academy, put every club on a sound
+	// financial footing and set every board its objective, the first time
+	// each is seen
+	rng := rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))
+	if err := m.developRepo.RegisterPlayers(clubs, season.StartYear, rng); err != nil {
+		panic(err)
+	}
+	if err := m.contractRepo.DrawUpContracts(clubs, season.Today, rng); err != nil {
+		panic(err)
+	}
+	if err := m.youthRepo.OpenAcademies(clubs, rng); err != nil {
+		panic(err)
+	}
+	if err := m.financeRepo.OpenAccounts(clubs, season.Today); err != nil {
+		panic(err)
+	}
+	if err := m.boardRepo.OpenBoardrooms(clubs, divisions); err != nil {
+		panic(err)
+	}
+
 	// Get all fixtures
-	fixtures, err := fixtureRepo.GetAll()
+	fixtures, err := m.fixtureRepo.GetAll()
 	if err != nil {
 		panic(err)
 	}
 
-	return &AppModel{
-		gameStateRepo: gameStateRepo,
-		clubRepo:      clubRepo,
-		fixtureRepo:   fixtureRepo,
-		matchRepo:     matchRepo,
-		mode:          MenuMode,
-		clubs:         clubs,
-		fixtures:      fixtures,
-		currentMatch:  nil,
-		menu: NewMenuModel([]list.Item{
-			item("New game"),
-			item("Settings"),
-		}),
-		onboarding: NewOnboardingModel(clubs),
-		managerHub: NewManagerHubModel(nil, nil, nil),
-		prematch:   NewPreMatchModel(nil),
-		match:      NewMatchModel(nil, -1),
-		width:      0,
-		height:     0,
-	}
+	m.mode = MenuMode
+	m.season = season
+	m.divisions = divisions
+	m.clubs = clubs
+	m.fixtures = fixtures
+	m.menu = NewMenuModel([]list.Item{
+		item("New game"),
+		item("Settings"),
+	})
+	m.onboarding = NewOnboardingModel(clubs, divisions)
+	m.managerHub = NewManagerHubModel(nil, nil, nil, nil, nil, nil)
+	m.prematch = NewPreMatchModel(nil, nil, nil)
+	m.match = NewMatchModel(nil, -1)
+	return m
+}
+
+// bindRepositories points every repository at the queries, so they can all
+// be run inside one transaction
+func (m *AppModel) bindRepositories(queries *db.Queries) {
+	m.gameStateRepo = repository.NewGameStateRepository(queries)
+	clubRepo := repository.NewClubRepository(queries)
+	m.clubRepo = clubRepo
+	m.fixtureRepo = repository.NewFixtureRepository(queries, clubRepo)
+	m.matchRepo = repository.NewMatchRepository(queries)
+	m.disciplineRepo = repository.NewDisciplineRepository(queries)
+	m.injuryRepo = repository.NewInjuryRepository(queries)
+	m.fitnessRepo = repository.NewFitnessRepository(queries)
+	m.lineupRepo = repository.NewLineupRepository(queries)
+	m.statsRepo = repository.NewStatsRepository(queries)
+	m.seasonRepo = repository.NewSeasonRepository(queries)
+	m.divisionRepo = repository.NewDivisionRepository(queries)
+	m.cupRepo = repository.NewCupRepository(queries)
+	m.transferRepo = repository.NewTransferRepository(queries)
+	m.financeRepo = repository.NewFinanceRepository(queries)
+	m.contractRepo = repository.NewContractRepository(queries)
+	m.developRepo = repository.NewDevelopmentRepository(queries)
+	m.youthRepo = repository.NewYouthRepository(queries)
+	m.trainingRepo = repository.NewTrainingRepository(queries)
+	m.moraleRepo = repository.NewMoraleRepository(queries)
+	m.inboxRepo = repository.NewInboxRepository(queries)
+	m.boardRepo = repository.NewBoardRepository(queries)
 }
 
+type goToMenuMsg struct{}
+
 type goToOnboardingMsg struct{}
 
 type goToManagerHubMsg struct {
 	ClubID int64
 }
 
-type startPreMatchMsg struct{}
+type appointManagerMsg struct {
+	ClubID int64
+}
+
+type applyForJobMsg struct {
+	club *domain.ClubWithPlayers
+}
+
+type continueMsg struct{}
 
-type startMatchMsg struct{}
+type startMatchMsg struct {
+	lineup *domain.Lineup
+}
 
 type matchFinishedMsg struct {
 	match *domain.Match
 }
+
+type rolloverSeasonMsg struct{}
+
+type goToTransferMarketMsg struct{}
+
+type completeTransferMsg struct {
+	transfer *domain.Transfer
+}
+
+type renewContractMsg struct {
+	renewal domain.Renewal
+}
+
+type setTrainingPlanMsg struct {
+	plan domain.TrainingPlan
+}
+
+type setTrainingFocusMsg struct {
+	player domain.Player
+	focus  domain.TrainingFocus
+}
+
+type promiseRoleMsg struct {
+	player domain.Player
+}
+
+type readMessageMsg struct {
+	message domain.Message
+}
+
+type answerMessageMsg struct {
+	message domain.Message
+	accept  bool
+}
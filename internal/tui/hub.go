@@ -1,27 +1,108 @@
 package tui
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/cameronjpr/gaffer/internal/components"
 	"github.com/cameronjpr/gaffer/internal/domain"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
 
+// HubTab represents the different tabs in the manager hub
+type HubTab int
+
+const (
+	SquadTab HubTab = iota
+	TrainingTab
+	FixturesTab
+	TableTab
+	StatsTab
+	CupTab
+	ContinentalTab
+	FinancesTab
+	InboxTab
+)
+
+var hubTabNames = []string{"Squad", "Training", "Fixtures", "Table", "Stats", "Cup", "Europe", "Finances", "Inbox"}
+
 type ManagerHubModel struct {
-	ChosenClub  *domain.Club
-	Fixtures    []*domain.Fixture
-	LeagueTable *domain.LeagueTable
-	width       int
-	height      int
+	ChosenClub    *domain.Club
+	Division      *domain.Division
+	Season        *domain.Season
+	Players       []domain.Player
+	SeasonStats   map[int64]domain.SeasonStats
+	Fixtures      []*domain.Fixture
+	LeagueTables  map[domain.Venue]*domain.LeagueTable
+	Suspensions   []domain.Suspension
+	Injuries      []domain.Injury
+	Cup           *domain.Cup
+	Continental   *domain.Cup
+	Transfers     []domain.Transfer
+	Finances      *domain.FinanceSummary
+	Messages      []domain.Message
+	currentTab    HubTab
+	squadModel    *SquadModel
+	trainingModel *TrainingModel
+	inboxModel    *InboxModel
+	tableModel    *LeagueTableModel
+	width         int
+	height        int
+}
+
+func NewManagerHubModel(club *domain.ClubWithPlayers, fixtures []*domain.Fixture, leagueTables map[domain.Venue]*domain.LeagueTable, suspensions []domain.Suspension, injuries []domain.Injury, seasonStats map[int64]domain.SeasonStats) *ManagerHubModel {
+	hub := &ManagerHubModel{
+		SeasonStats:  seasonStats,
+		Fixtures:     fixtures,
+		LeagueTables: leagueTables,
+		Suspensions:  suspensions,
+		Injuries:     injuries,
+		currentTab:   SquadTab,
+	}
+	if club != nil {
+		hub.ChosenClub = club.Club
+		hub.Players = club.Players
+	}
+	hub.squadModel = NewSquadModel(hub.Players, seasonStats)
+	hub.trainingModel = NewTrainingModel()
+	hub.inboxModel = NewInboxModel()
+	if hub.ChosenClub != nil {
+		hub.tableModel = NewLeagueTableModel(leagueTables, hub.ChosenClub.ID)
+	}
+
+	return hub
 }
 
-func NewManagerHubModel(club *domain.Club, fixtures []*domain.Fixture, leagueTable *domain.LeagueTable) *ManagerHubModel {
-	return &ManagerHubModel{
-		ChosenClub:  club,
-		Fixtures:    fixtures,
-		LeagueTable: leagueTable,
+// SetSquad refreshes the squad after a match, keeping the squad tab's sort and filter
+func (m *ManagerHubModel) SetSquad(players []domain.Player, seasonStats map[int64]domain.SeasonStats) {
+	m.Players = players
+	m.SeasonStats = seasonStats
+	m.squadModel.SetSquad(players, seasonStats)
+}
+
+// SetLeagueTables refreshes the standings after a match
+func (m *ManagerHubModel) SetLeagueTables(leagueTables map[domain.Venue]*domain.LeagueTable) {
+	m.LeagueTables = leagueTables
+	m.tableModel.SetTables(leagueTables)
+}
+
+// SetMessages refreshes the inbox
+func (m *ManagerHubModel) SetMessages(messages []domain.Message) {
+	m.Messages = messages
+	m.inboxModel.SetMessages(messages)
+}
+
+// switchTab moves to the tab, reading the message under the cursor on
+// opening the inbox
+func (m *ManagerHubModel) switchTab(tab HubTab) (tea.Model, tea.Cmd) {
+	m.currentTab = tab
+	if tab == InboxTab {
+		return m, m.inboxModel.readSelected()
 	}
+	return m, nil
 }
+
 func (m *ManagerHubModel) Init() tea.Cmd {
 	return nil
 }
@@ -34,11 +115,43 @@ func (m *ManagerHubModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, nil
 
 	case tea.KeyMsg:
-		switch msg.Type {
-		case tea.KeyEnter:
+		// Contract talks on the squad tab take every key until they're over
+		if m.currentTab == SquadTab && m.squadModel.talks != nil {
+			_, cmd := m.squadModel.Update(msg)
+			return m, cmd
+		}
+
+		switch msg.String() {
+		case "enter":
+			return m, func() tea.Msg {
+				return continueMsg{}
+			}
+		case "t":
 			return m, func() tea.Msg {
-				return startPreMatchMsg{}
+				return goToTransferMarketMsg{}
 			}
+		case "tab":
+			return m.switchTab((m.currentTab + 1) % HubTab(len(hubTabNames)))
+		case "shift+tab":
+			return m.switchTab((m.currentTab + HubTab(len(hubTabNames)) - 1) % HubTab(len(hubTabNames)))
+		case "1", "2", "3", "4", "5", "6", "7", "8", "9":
+			return m.switchTab(HubTab(msg.Runes[0] - '1'))
+		}
+
+		// Forward remaining keys to the active tab
+		switch m.currentTab {
+		case SquadTab:
+			_, cmd := m.squadModel.Update(msg)
+			return m, cmd
+		case TrainingTab:
+			_, cmd := m.trainingModel.Update(msg)
+			return m, cmd
+		case TableTab:
+			_, cmd := m.tableModel.Update(msg)
+			return m, cmd
+		case InboxTab:
+			_, cmd := m.inboxModel.Update(msg)
+			return m, cmd
 		}
 	}
 
@@ -58,41 +171,159 @@ func (m *ManagerHubModel) View() string {
 		Bold(true).
 		Background(lipgloss.Color(m.ChosenClub.Background)).
 		Foreground(lipgloss.Color(m.ChosenClub.Foreground)).
-		Render(m.ChosenClub.Name)
+		Render(m.headerTitle())
 
-	// Footer with instructions
-	footer := lipgloss.NewStyle().
-		Align(lipgloss.Center).
-		Width(m.width).
-		Render("Press [Enter] to start pre-match")
+	// Show how many messages are waiting to be read on the inbox tab
+	tabNames := append([]string(nil), hubTabNames...)
+	if unread := domain.UnreadCount(m.Messages); unread > 0 {
+		tabNames[InboxTab] = fmt.Sprintf("Inbox (%d)", unread)
+	}
+	tabs := components.Tabs(m.width, tabNames, int(m.currentTab))
+
+	hotkeys := []components.HotkeyBinding{
+		{Key: "Tab/1-9", Description: "Switch tab"},
+	}
+	switch m.currentTab {
+	case SquadTab:
+		hotkeys = append(hotkeys,
+			components.HotkeyBinding{Key: "↑/↓", Description: "Select"},
+			components.HotkeyBinding{Key: "O", Description: "Sort"},
+			components.HotkeyBinding{Key: "F", Description: "Filter"},
+			components.HotkeyBinding{Key: "R", Description: "Renew contract"},
+			components.HotkeyBinding{Key: "P", Description: "Promise role"},
+		)
+	case TrainingTab:
+		hotkeys = append(hotkeys,
+			components.HotkeyBinding{Key: "↑/↓", Description: "Select"},
+			components.HotkeyBinding{Key: "←/→", Description: "Change"},
+		)
+	case TableTab:
+		hotkeys = append(hotkeys,
+			components.HotkeyBinding{Key: "↑/↓", Description: "Scroll"},
+			components.HotkeyBinding{Key: "V", Description: "Overall/Home/Away"},
+		)
+	case InboxTab:
+		hotkeys = append(hotkeys,
+			components.HotkeyBinding{Key: "↑/↓", Description: "Select"},
+			components.HotkeyBinding{Key: "A", Description: "Accept"},
+			components.HotkeyBinding{Key: "X", Description: "Reject"},
+		)
+	}
+	hotkeys = append(hotkeys,
+		components.HotkeyBinding{Key: "T", Description: "Transfers"},
+		components.HotkeyBinding{Key: "Enter", Description: "Continue"},
+	)
+	if m.currentTab == SquadTab && m.squadModel.talks != nil {
+		hotkeys = m.squadModel.talksHotkeys()
+	}
+	footer := components.HotkeyGuide(m.width, hotkeys)
 
 	// Main content area - calculate flexible content height
-	headerHeight := lipgloss.Height(header)
+	headerHeight := lipgloss.Height(header) + lipgloss.Height(tabs)
 	footerHeight := lipgloss.Height(footer)
 	contentHeight := m.height - headerHeight - footerHeight
 
-	leagueTableView := ""
-	if m.LeagueTable != nil {
-		leagueTableView = components.Table(*m.LeagueTable)
-	}
-	fixturesView := components.Fixtures(m.Fixtures)
+	var content string
+	switch m.currentTab {
+	case SquadTab:
+		// Size the squad tab to the space left inside the padding
+		m.squadModel.width = m.width - 4
+		m.squadModel.height = contentHeight
+		m.squadModel.club = m.ChosenClub
+		if m.Season != nil {
+			m.squadModel.today = m.Season.Today
+		}
+		content = lipgloss.NewStyle().Padding(1, 2).Render(m.squadModel.View())
 
-	content := components.ThreeColumnLayout(
-		m.width,
-		fixturesView,
-		"",
-		leagueTableView,
-	)
+	case TrainingTab:
+		m.trainingModel.club = m.ChosenClub
+		m.trainingModel.players = m.Players
+		content = lipgloss.NewStyle().Padding(1, 2).Render(m.trainingModel.View())
+
+	case FixturesTab:
+		content = m.renderFixturesView()
+
+	case TableTab:
+		m.tableModel.width = m.width
+		m.tableModel.height = contentHeight
+		content = m.tableModel.View()
+
+	case StatsTab:
+		content = components.ClubStats(m.Players, m.SeasonStats)
+
+	case CupTab:
+		if m.Cup != nil {
+			content = components.CupBracket(m.Cup, m.ChosenClub.ID)
+		}
+
+	case ContinentalTab:
+		if m.Continental != nil {
+			content = components.ContinentalCup(m.Continental, m.ChosenClub.ID)
+		}
+
+	case FinancesTab:
+		content = components.Finances(&domain.ClubWithPlayers{Club: m.ChosenClub, Players: m.Players}, m.Finances)
+
+	case InboxTab:
+		m.inboxModel.width = m.width - 4
+		m.inboxModel.height = contentHeight - 2
+		content = lipgloss.NewStyle().Padding(1, 2).Render(m.inboxModel.View())
+	}
 
 	// Center content in available space
 	centeredContent := components.Centered(m.width, contentHeight, content)
 
 	// Use ScreenLayout to organize header, content, footer
 	sections := []components.ScreenSection{
-		{Height: headerHeight, Content: header},
+		{Height: headerHeight, Content: lipgloss.JoinVertical(lipgloss.Left, header, tabs)},
 		{Height: contentHeight, Content: centeredContent},
 		{Height: footerHeight, Content: footer},
 	}
 
 	return components.ScreenLayout(m.height, sections)
 }
+
+// headerTitle names the club and, once known, its division, the season being
+// played and today's date
+func (m *ManagerHubModel) headerTitle() string {
+	title := m.ChosenClub.Name
+	if m.Division != nil {
+		title += " · " + m.Division.Name
+	}
+	if m.Season != nil {
+		title += " · " + m.Season.Name()
+		if !m.Season.Today.IsZero() {
+			title += " · " + components.Date(m.Season.Today)
+		}
+	}
+	return title
+}
+
+// renderFixturesView renders upcoming fixtures alongside squad availability and the top of the table
+func (m *ManagerHubModel) renderFixturesView() string {
+	leagueTableView := ""
+	if table := m.LeagueTables[domain.AllVenues]; table != nil {
+		leagueTableView = components.Table(*table)
+	}
+	fixturesView := components.Fixtures(m.Fixtures)
+	var today time.Time
+	if m.Season != nil {
+		today = m.Season.Today
+	}
+	news := []string{
+		components.Medical(m.Injuries, today),
+		components.Suspensions(m.Suspensions),
+	}
+	if m.Season != nil {
+		news = append(news, components.TransferWindowStatus(m.Season.StartYear, today))
+	}
+	news = append(news, components.Transfers("Transfer news", m.Transfers, m.ChosenClub.ID, 5))
+	availabilityView := components.Stack(news, 1)
+
+	return components.ThreeColumnLayout(
+		m.width,
+		fixturesView,
+		availabilityView,
+		leagueTableView,
+	)
+}
@@ -0,0 +1,93 @@
+package tui
+
+import (
+	"github.com/cameronjpr/gaffer/internal/components"
+	"github.com/cameronjpr/gaffer/internal/domain"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// InboxModel handles the inbox tab of the manager hub: reading messages and
+// answering those that wait on the manager
+type InboxModel struct {
+	width    int
+	height   int
+	messages []domain.Message
+	cursor   int
+	offset   int
+}
+
+// NewInboxModel creates a new inbox model
+func NewInboxModel() *InboxModel {
+	return &InboxModel{}
+}
+
+// SetMessages refreshes the messages, keeping the cursor where it was
+func (m *InboxModel) SetMessages(messages []domain.Message) {
+	m.messages = messages
+	m.cursor = max(0, min(m.cursor, len(messages)-1))
+}
+
+func (m *InboxModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m *InboxModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok || len(m.messages) == 0 {
+		return m, nil
+	}
+
+	switch keyMsg.String() {
+	case "up", "k":
+		m.cursor = max(0, m.cursor-1)
+		return m, m.readSelected()
+	case "down", "j":
+		m.cursor = min(len(m.messages)-1, m.cursor+1)
+		return m, m.readSelected()
+	case "a", "x":
+		message := m.messages[m.cursor]
+		if !message.Pending() {
+			return m, nil
+		}
+		accept := keyMsg.String() == "a"
+		return m, func() tea.Msg {
+			return answerMessageMsg{message: message, accept: accept}
+		}
+	}
+
+	return m, nil
+}
+
+// readSelected marks the message under the cursor read, if it isn't already
+func (m *InboxModel) readSelected() tea.Cmd {
+	if m.cursor >= len(m.messages) || m.messages[m.cursor].Read {
+		return nil
+	}
+	message := m.messages[m.cursor]
+	return func() tea.Msg {
+		return readMessageMsg{message: message}
+	}
+}
+
+func (m *InboxModel) View() string {
+	// Scroll the list to keep the cursor in view, leaving room for the header
+	rows := max(1, m.height-3)
+	m.offset = min(m.offset, m.cursor)
+	if m.cursor >= m.offset+rows {
+		m.offset = m.cursor - rows + 1
+	}
+	page := m.messages[m.offset:min(len(m.messages), m.offset+rows)]
+
+	detail := ""
+	if m.cursor < len(m.messages) {
+		detail = components.Panel(components.DefaultPanelConfig(m.width/3, 0),
+			components.MessageDetail(m.messages[m.cursor]))
+	}
+
+	return lipgloss.JoinHorizontal(
+		lipgloss.Top,
+		lipgloss.NewStyle().Width(m.width*2/3).Render(components.Inbox(page, m.cursor-m.offset)),
+		detail,
+	)
+}
@@ -0,0 +1,91 @@
+package tui
+
+import (
+	"fmt"
+
+	"github.com/cameronjpr/gaffer/internal/components"
+	"github.com/cameronjpr/gaffer/internal/domain"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// LeagueTableModel handles the scrollable standings on the hub's table tab
+type LeagueTableModel struct {
+	width      int
+	height     int
+	tables     map[domain.Venue]*domain.LeagueTable
+	venue      domain.Venue
+	userClubID int64
+	offset     int
+}
+
+// NewLeagueTableModel creates a new league table model
+func NewLeagueTableModel(tables map[domain.Venue]*domain.LeagueTable, userClubID int64) *LeagueTableModel {
+	return &LeagueTableModel{
+		tables:     tables,
+		venue:      domain.AllVenues,
+		userClubID: userClubID,
+	}
+}
+
+// SetTables refreshes the standings, keeping the current venue and scroll position
+func (m *LeagueTableModel) SetTables(tables map[domain.Venue]*domain.LeagueTable) {
+	m.tables = tables
+	m.offset = min(m.offset, m.maxOffset())
+}
+
+func (m *LeagueTableModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m *LeagueTableModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.offset = min(m.offset, m.maxOffset())
+		return m, nil
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "up", "k":
+			m.offset = max(0, m.offset-1)
+		case "down", "j":
+			m.offset = min(m.offset+1, m.maxOffset())
+		case "v":
+			m.venue = m.venue.Next()
+			m.offset = min(m.offset, m.maxOffset())
+		}
+	}
+
+	return m, nil
+}
+
+// visibleRows returns how many clubs fit on screen below the venue title
+func (m *LeagueTableModel) visibleRows(table *domain.LeagueTable) int {
+	return max(1, m.height-components.StandingsChrome(table)-2)
+}
+
+func (m *LeagueTableModel) maxOffset() int {
+	table := m.tables[m.venue]
+	if table == nil {
+		return 0
+	}
+	return max(0, len(table.Positions)-m.visibleRows(table))
+}
+
+func (m *LeagueTableModel) View() string {
+	table := m.tables[m.venue]
+	if table == nil {
+		return "No table available"
+	}
+
+	title := lipgloss.NewStyle().Bold(true).Render(fmt.Sprintf("%s table", m.venue))
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		title,
+		"",
+		components.Standings(table, m.userClubID, m.offset, m.visibleRows(table)),
+	)
+}
@@ -132,8 +132,11 @@ func (m *MatchModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return m, nil
 			case 's':
 				// Switch to substitutions tab
-				if m.currentTab != SubstitutionsTab {
+				if m.currentTab != SubstitutionsTab && m.userTeam != nil {
 					m.currentTab = SubstitutionsTab
+					// List the players as they are now, after any changes
+					m.substitutionModel = NewSubstitutionModel(m.userTeam.CurrentXI, m.userTeam.Bench)
+					m.substitutionModel.Update(tea.WindowSizeMsg{Width: m.width, Height: m.height})
 					// Pause match when entering subs tab
 					m.controller.SendCommand(simulation.PauseMatchCmd{})
 				}
@@ -189,6 +192,11 @@ func (m *MatchModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Continue listening - user will press space to resume
 		return m, waitForMatchEvent(m.controller)
 
+	case simulation.ExtraTimeMsg:
+		// Controller auto-paused before extra time, giving a chance to make changes
+		m.match = msg.Match
+		return m, waitForMatchEvent(m.controller)
+
 	case simulation.FulltimeMsg:
 		// Match finished
 		m.match = msg.Match
@@ -11,6 +11,7 @@ type OnboardingModel struct {
 	form     *huh.Form
 	formData *OnboardingFormData
 	keys     *menuKeyMap
+	chosen   bool // the appointment has been sent, so it only goes once
 	width    int
 	height   int
 }
@@ -19,14 +20,16 @@ type OnboardingFormData struct {
 	ClubID int64
 }
 
-func NewOnboardingModel(clubs []*domain.ClubWithPlayers) *OnboardingModel {
+func NewOnboardingModel(clubs []*domain.ClubWithPlayers, divisions []*domain.Division) *OnboardingModel {
 	formData := &OnboardingFormData{}
 	keys := defaultMenuKeyMap()
 
-	// Build club options from clubs
-	clubOptions := make([]huh.Option[int64], len(clubs))
-	for i, club := range clubs {
-		clubOptions[i] = huh.NewOption(club.Club.Name, club.Club.ID)
+	// Build club options from clubs, a division at a time from the top
+	clubOptions := make([]huh.Option[int64], 0, len(clubs))
+	for _, division := range divisions {
+		for _, club := range domain.ClubsInDivision(clubs, division.ID) {
+			clubOptions = append(clubOptions, huh.NewOption(club.Club.Name+" ("+division.Name+")", club.Club.ID))
+		}
 	}
 
 	form := huh.NewForm(
@@ -73,9 +76,10 @@ func (m *OnboardingModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		cmds = append(cmds, cmd)
 	}
 
-	if m.form.State == huh.StateCompleted {
+	if m.form.State == huh.StateCompleted && !m.chosen {
+		m.chosen = true
 		cmds = append(cmds, func() tea.Msg {
-			return goToManagerHubMsg{
+			return appointManagerMsg{
 				ClubID: m.formData.ClubID,
 			}
 		})
@@ -2,57 +2,38 @@ package tui
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/cameronjpr/gaffer/internal/components"
 	"github.com/cameronjpr/gaffer/internal/domain"
 	tea "github.com/charmbracelet/bubbletea"
-	"github.com/charmbracelet/huh"
 	"github.com/charmbracelet/lipgloss"
 )
 
+// PreMatchModel is the team selection screen shown before kick-off.
+// Rows are the starting XI slots, then the bench, then the rest of the squad.
 type PreMatchModel struct {
-	form     *huh.Form
-	formData *PreMatchFormData
-	keys     *menuKeyMap
-	match    *domain.Match
-	width    int
-	height   int
+	match     *domain.Match
+	userSquad *domain.ClubWithPlayers
+	lineup    *domain.Lineup
+	cursor    int
+	picked    int // Row picked up for a swap, -1 when none
+	err       error
+	width     int
+	height    int
 }
 
-type PreMatchFormData struct {
-	Formation string
-}
-
-func NewPreMatchModel(match *domain.Match) *PreMatchModel {
-
-	formData := &PreMatchFormData{}
-	keys := defaultMenuKeyMap()
-
-	// Build club options from season
-	formationOptions := []huh.Option[string]{
-		{Key: "4-4-2", Value: "4-4-2"},
-		{Key: "4-3-3", Value: "4-3-3"},
-	}
-
-	form := huh.NewForm(
-		huh.NewGroup(
-			huh.NewSelect[string]().
-				Value(&formData.Formation).
-				Title("Choose your formation:").
-				Options(formationOptions...),
-		),
-	).WithWidth(60).WithKeyMap(keys.formKeys)
-
+func NewPreMatchModel(match *domain.Match, userSquad *domain.ClubWithPlayers, lineup *domain.Lineup) *PreMatchModel {
 	return &PreMatchModel{
-		match:    match,
-		form:     form,
-		formData: formData,
-		keys:     keys,
+		match:     match,
+		userSquad: userSquad,
+		lineup:    lineup,
+		picked:    -1,
 	}
 }
 
 func (m *PreMatchModel) Init() tea.Cmd {
-	return m.form.Init()
+	return nil
 }
 
 func (m *PreMatchModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
@@ -60,66 +41,253 @@ func (m *PreMatchModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
-		formWidth := min(60, msg.Width-4)
-		m.form = m.form.WithWidth(formWidth)
 		return m, nil
 
 	case tea.KeyMsg:
-		switch msg.Type {
-		case tea.KeyCtrlC:
+		switch msg.String() {
+		case "ctrl+c":
 			return m, tea.Quit
-		case tea.KeyEnter:
-			return m, func() tea.Msg { return startMatchMsg{} }
+
+		case "up", "k":
+			if m.cursor > 0 {
+				m.cursor--
+			}
+
+		case "down", "j":
+			if m.cursor < m.rowCount()-1 {
+				m.cursor++
+			}
+
+		case " ":
+			// Pick up a row, then drop it on another to swap them
+			if m.picked == -1 {
+				m.picked = m.cursor
+			} else {
+				m.swapRows(m.picked, m.cursor)
+				m.picked = -1
+			}
+
+		case "esc":
+			m.picked = -1
+
+		case "f":
+			m.lineup.Formation = m.lineup.Formation.Next()
+
+		case "a":
+			// Replace the selection with the strongest XI for the current formation
+			m.lineup = domain.AutoPickLineup(m.lineup.Formation, m.userSquad.Players)
+			m.picked = -1
+			m.cursor = min(m.cursor, m.rowCount()-1)
+
+		case "c":
+			if id := m.rowPlayerID(m.cursor); m.lineup.IsStarter(id) {
+				m.lineup.CaptainID = id
+			}
+
+		case "p":
+			if id := m.rowPlayerID(m.cursor); m.lineup.IsStarter(id) {
+				m.lineup.SetPieceTakerID = id
+			}
+
+		case "enter":
+			m.err = m.lineup.Validate(m.userSquad.Players)
+			if m.err != nil {
+				return m, nil
+			}
+			lineup := m.lineup
+			return m, func() tea.Msg {
+				return startMatchMsg{lineup: lineup}
+			}
+		}
+
+		// Re-check after every edit so problems clear as they are fixed
+		if m.err != nil {
+			m.err = m.lineup.Validate(m.userSquad.Players)
 		}
 	}
 
-	var cmds []tea.Cmd
+	return m, nil
+}
+
+// reserves returns the squad players not in the lineup, in squad order
+func (m *PreMatchModel) reserves() []domain.Player {
+	var reserves []domain.Player
+	for _, player := range m.userSquad.Players {
+		if !m.lineup.Contains(player.ID) {
+			reserves = append(reserves, player)
+		}
+	}
+	return reserves
+}
+
+func (m *PreMatchModel) rowCount() int {
+	return m.lineup.SlotCount() + len(m.reserves())
+}
+
+// rowPlayerID returns the player ID shown on a row, 0 for an empty slot
+func (m *PreMatchModel) rowPlayerID(row int) int64 {
+	if row < m.lineup.SlotCount() {
+		return m.lineup.PlayerAt(row)
+	}
+	reserves := m.reserves()
+	if i := row - m.lineup.SlotCount(); i < len(reserves) {
+		return reserves[i].ID
+	}
+	return 0
+}
+
+// swapRows exchanges two rows. Swapping a reserve into a slot moves whoever
+// was there back to the reserves; swapping two reserves does nothing.
+func (m *PreMatchModel) swapRows(a, b int) {
+	slots := m.lineup.SlotCount()
+	switch {
+	case a < slots && b < slots:
+		m.lineup.Swap(a, b)
+	case a < slots:
+		m.lineup.SetPlayerAt(a, m.rowPlayerID(b))
+	case b < slots:
+		m.lineup.SetPlayerAt(b, m.rowPlayerID(a))
+	}
+
+	// Captain and set-piece taker must stay on the pitch
+	if !m.lineup.IsStarter(m.lineup.CaptainID) {
+		m.lineup.CaptainID = 0
+	}
+	if !m.lineup.IsStarter(m.lineup.SetPieceTakerID) {
+		m.lineup.SetPieceTakerID = 0
+	}
+
+	// Keep the cursor within the list as the reserves change
+	m.cursor = min(m.cursor, m.rowCount()-1)
+}
 
-	form, cmd := m.form.Update(msg)
-	if f, ok := form.(*huh.Form); ok {
-		m.form = f
-		cmds = append(cmds, cmd)
+func (m *PreMatchModel) selectionView() string {
+	squad := make(map[int64]domain.Player, len(m.userSquad.Players))
+	for _, player := range m.userSquad.Players {
+		squad[player.ID] = player
 	}
 
-	if m.form.State == huh.StateCompleted {
-		cmds = append(cmds, func() tea.Msg {
-			return goToManagerHubMsg{
-				ClubID: m.match.Home.Club.ID,
-				/// ??????? makes no sense
+	headingStyle := lipgloss.NewStyle().Bold(true).MarginTop(1)
+	cursorStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("170")).Bold(true)
+	pickedStyle := lipgloss.NewStyle().Background(lipgloss.Color("236")).Foreground(lipgloss.Color("170"))
+	mutedStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("241"))
+
+	slots := m.lineup.Formation.Slots()
+	var lines []string
+	for row := 0; row < m.rowCount(); row++ {
+		switch row {
+		case 0:
+			lines = append(lines, headingStyle.Render("Starting XI"))
+		case len(m.lineup.Starters):
+			lines = append(lines, headingStyle.Render("Bench"))
+		case m.lineup.SlotCount():
+			lines = append(lines, headingStyle.Render("Reserves"))
+		}
+
+		label := "SUB"
+		if row < len(slots) {
+			label = slots[row]
+		}
+
+		line := fmt.Sprintf("%-3s  %s", label, mutedStyle.Render("— empty —"))
+		if player, ok := squad[m.rowPlayerID(row)]; ok {
+			if row >= m.lineup.SlotCount() {
+				label = player.Position
 			}
-		})
+			line = fmt.Sprintf("%-3s  %-22s %-3s %3d%s", label, player.Name, player.Position, player.Quality, m.playerTags(player))
+		}
+
+		prefix := "  "
+		if row == m.cursor {
+			prefix = cursorStyle.Render("> ")
+		}
+		if row == m.picked {
+			line = pickedStyle.Render(line)
+		}
+		lines = append(lines, prefix+line)
 	}
 
-	return m, tea.Batch(cmds...)
+	return strings.Join(lines, "\n")
+}
+
+// playerTags marks the captain, set-piece taker and anyone unavailable
+func (m *PreMatchModel) playerTags(player domain.Player) string {
+	var tags []string
+	if player.ID == m.lineup.CaptainID {
+		tags = append(tags, "C")
+	}
+	if player.ID == m.lineup.SetPieceTakerID {
+		tags = append(tags, "SP")
+	}
+	if player.Injury != nil {
+		tags = append(tags, "INJ")
+	}
+	if player.SuspendedMatches > 0 {
+		tags = append(tags, "SUS")
+	}
+	if len(tags) == 0 {
+		return ""
+	}
+	return " (" + strings.Join(tags, ", ") + ")"
+}
+
+func (m *PreMatchModel) summaryView() string {
+	summary := []string{
+		lipgloss.NewStyle().Bold(true).Render(fmt.Sprintf("%s vs %s", m.match.Home.Club.Name, m.match.Away.Club.Name)),
+		"",
+		fmt.Sprintf("Formation: %s", m.lineup.Formation),
+	}
+
+	if m.err != nil {
+		errorStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("196"))
+		summary = append(summary, "", "Fix before kick-off:")
+		for _, problem := range strings.Split(m.err.Error(), "\n") {
+			summary = append(summary, errorStyle.Render("• "+problem))
+		}
+	} else {
+		summary = append(summary, "", "Press Enter to start")
+	}
+
+	return strings.Join(summary, "\n")
 }
 
 func (m *PreMatchModel) View() string {
 	colWidth := m.width / 3
 
-	header := " PREMATCH "
+	header := lipgloss.NewStyle().
+		Align(lipgloss.Center).
+		Width(m.width).
+		Padding(1, 2).
+		Bold(true).
+		Background(lipgloss.Color(m.userSquad.Club.Background)).
+		Foreground(lipgloss.Color(m.userSquad.Club.Foreground)).
+		Render("TEAM SELECTION")
 
-	// Footer with controls
-	footer := " PREMATCH "
+	footer := components.HotkeyGuide(m.width, []components.HotkeyBinding{
+		{Key: "↑/↓", Description: "Move"},
+		{Key: "Space", Description: "Pick up / swap"},
+		{Key: "F", Description: "Formation"},
+		{Key: "A", Description: "Auto-pick"},
+		{Key: "C", Description: "Captain"},
+		{Key: "P", Description: "Set pieces"},
+		{Key: "Enter", Description: "Kick off"},
+	})
 
 	// Calculate heights
 	headerHeight := lipgloss.Height(header)
 	footerHeight := lipgloss.Height(footer)
 	contentHeight := m.height - headerHeight - footerHeight
 
-	// Main match content - three columns
-	homeTeamSheet := components.TeamSheet(m.match.Home)
-	awayTeamSheet := components.TeamSheet(m.match.Away)
-	prematchMessaage := lipgloss.JoinVertical(lipgloss.Center,
-		fmt.Sprintf("%s vs %s\n\nPress Enter to start", m.match.Home.Club.Name, m.match.Away.Club.Name),
-		// m.form.View(),
-	)
+	opponent := m.match.Away
+	if opponent.Club.ID == m.userSquad.Club.ID {
+		opponent = m.match.Home
+	}
 
-	// Center content vertically and horizontally in available space
 	mainContent := lipgloss.JoinHorizontal(
 		lipgloss.Top,
-		components.Centered(colWidth, m.height, homeTeamSheet),
-		components.Centered(colWidth, m.height, prematchMessaage),
-		components.Centered(colWidth, m.height, awayTeamSheet),
+		components.Centered(colWidth, contentHeight, m.selectionView()),
+		components.Centered(colWidth, contentHeight, m.summaryView()),
+		components.Centered(colWidth, contentHeight, components.TeamSheet(opponent)),
 	)
 
 	// Use ScreenLayout to organize header, content, footer
@@ -0,0 +1,89 @@
+package tui
+
+import (
+	"github.com/cameronjpr/gaffer/internal/components"
+	"github.com/cameronjpr/gaffer/internal/domain"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// SeasonSummaryModel is the end-of-season review shown once every fixture is played
+type SeasonSummaryModel struct {
+	club    *domain.Club
+	summary *domain.SeasonSummary
+	history []domain.SeasonRecord
+	width   int
+	height  int
+}
+
+func NewSeasonSummaryModel(club *domain.Club, summary *domain.SeasonSummary, history []domain.SeasonRecord) *SeasonSummaryModel {
+	return &SeasonSummaryModel{
+		club:    club,
+		summary: summary,
+		history: history,
+	}
+}
+
+func (m *SeasonSummaryModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m *SeasonSummaryModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		return m, nil
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "enter":
+			return m, func() tea.Msg {
+				return rolloverSeasonMsg{}
+			}
+		}
+	}
+
+	return m, nil
+}
+
+func (m *SeasonSummaryModel) View() string {
+	header := lipgloss.NewStyle().
+		Align(lipgloss.Center).
+		Width(m.width).
+		Padding(1, 2).
+		Bold(true).
+		Background(lipgloss.Color(m.club.Background)).
+		Foreground(lipgloss.Color(m.club.Foreground)).
+		Render("END OF SEASON")
+
+	footer := components.HotkeyGuide(m.width, []components.HotkeyBinding{
+		{Key: "Enter", Description: "Start next season"},
+	})
+
+	headerHeight := lipgloss.Height(header)
+	footerHeight := lipgloss.Height(footer)
+	contentHeight := m.height - headerHeight - footerHeight
+
+	review := lipgloss.JoinVertical(
+		lipgloss.Left,
+		components.SeasonReview(m.summary, m.club),
+		"",
+		components.SeasonHistory(m.history),
+	)
+	table := components.Standings(m.summary.Table, m.club.ID, 0, len(m.summary.Table.Positions))
+
+	mainContent := lipgloss.JoinHorizontal(
+		lipgloss.Top,
+		components.Centered(m.width/3, contentHeight, review),
+		components.Centered(m.width-m.width/3, contentHeight, table),
+	)
+
+	sections := []components.ScreenSection{
+		{Height: headerHeight, Content: header},
+		{Height: contentHeight, Content: mainContent},
+		{Height: footerHeight, Content: footer},
+	}
+
+	return components.ScreenLayout(m.height, sections)
+}
@@ -0,0 +1,308 @@
+package tui
+
+import (
+	"sort"
+	"time"
+
+	"github.com/cameronjpr/gaffer/internal/components"
+	"github.com/cameronjpr/gaffer/internal/domain"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// SquadSort is the order players are listed in on the squad screen
+type SquadSort int
+
+const (
+	SortBySquadOrder SquadSort = iota
+	SortByPosition
+	SortByQuality
+	SortByForm
+	SortByGoals
+	SortByAppearances
+)
+
+var squadSortNames = map[SquadSort]string{
+	SortBySquadOrder:  "Squad no.",
+	SortByPosition:    "Position",
+	SortByQuality:     "Quality",
+	SortByForm:        "Form",
+	SortByGoals:       "Goals",
+	SortByAppearances: "Appearances",
+}
+
+// SquadFilter narrows the squad screen to part of the squad
+type SquadFilter int
+
+const (
+	ShowAllPlayers SquadFilter = iota
+	ShowGoalkeepers
+	ShowDefenders
+	ShowMidfielders
+	ShowAttackers
+	ShowAvailable
+)
+
+var squadFilterNames = map[SquadFilter]string{
+	ShowAllPlayers:  "All",
+	ShowGoalkeepers: "Goalkeepers",
+	ShowDefenders:   "Defenders",
+	ShowMidfielders: "Midfielders",
+	ShowAttackers:   "Attackers",
+	ShowAvailable:   "Available",
+}
+
+// positionOrder lists position codes from the back of the pitch forwards
+var positionOrder = map[string]int{
+	"GK": 0, "RB": 1, "CB": 2, "LB": 3, "RM": 4, "CM": 5, "LM": 6, "RW": 7, "ST": 8, "LW": 9,
+}
+
+// SquadModel handles the squad tab of the manager hub, including contract
+// talks with the players in it
+type SquadModel struct {
+	width   int
+	height  int
+	club    *domain.Club
+	today   time.Time
+	players []domain.Player
+	stats   map[int64]domain.SeasonStats
+	sortBy  SquadSort
+	filter  SquadFilter
+	cursor  int
+	talks   *domain.ContractTalks
+	offer   domain.Contract
+}
+
+// NewSquadModel creates a new squad model
+func NewSquadModel(players []domain.Player, stats map[int64]domain.SeasonStats) *SquadModel {
+	return &SquadModel{
+		players: players,
+		stats:   stats,
+	}
+}
+
+// SetSquad refreshes the players and their stats, keeping the current sort and filter
+func (m *SquadModel) SetSquad(players []domain.Player, stats map[int64]domain.SeasonStats) {
+	m.players = players
+	m.stats = stats
+	m.cursor = max(0, min(m.cursor, len(m.visiblePlayers())-1))
+}
+
+func (m *SquadModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m *SquadModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		return m, nil
+
+	case tea.KeyMsg:
+		if m.talks != nil {
+			return m.updateTalks(msg)
+		}
+
+		switch msg.String() {
+		case "up", "k":
+			if m.cursor > 0 {
+				m.cursor--
+			}
+		case "down", "j":
+			if m.cursor < len(m.visiblePlayers())-1 {
+				m.cursor++
+			}
+		case "o":
+			m.sortBy = (m.sortBy + 1) % SquadSort(len(squadSortNames))
+			m.cursor = 0
+		case "f":
+			m.filter = (m.filter + 1) % SquadFilter(len(squadFilterNames))
+			m.cursor = 0
+		case "r":
+			players := m.visiblePlayers()
+			if m.club == nil || m.cursor >= len(players) {
+				return m, nil
+			}
+			squad := &domain.ClubWithPlayers{Club: m.club, Players: m.players}
+			m.talks = domain.NewContractTalks(players[m.cursor], squad, m.today)
+			m.offer = m.talks.StartingOffer()
+		case "p":
+			players := m.visiblePlayers()
+			if m.cursor >= len(players) || players[m.cursor].Contract.Role >= domain.RoleKeyPlayer {
+				return m, nil
+			}
+			player := players[m.cursor]
+			return m, func() tea.Msg {
+				return promiseRoleMsg{player: player}
+			}
+		}
+	}
+
+	return m, nil
+}
+
+// updateTalks adjusts and puts terms to the player while contract talks are
+// under way
+func (m *SquadModel) updateTalks(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	talks := m.talks
+	if talks.Status != domain.NegotiationOpen {
+		// Any key closes talks that have ended
+		m.talks = nil
+		return m, nil
+	}
+
+	switch msg.String() {
+	case "esc":
+		m.talks = nil
+	case "up", "k", "+", "=":
+		m.offer.Wage += wageStep(m.offer.Wage)
+	case "down", "j", "-":
+		m.offer.Wage = max(wageStep(m.offer.Wage), m.offer.Wage-wageStep(m.offer.Wage))
+	case "right", "l":
+		if talks.Years(m.offer) < talks.MaxYears() {
+			m.offer.Ends++
+		}
+	case "left", "h":
+		if talks.Years(m.offer) > domain.MinContractYears {
+			m.offer.Ends--
+		}
+	case "p":
+		m.offer.Role = (m.offer.Role + 1) % domain.SquadRole(domain.RoleKeyPlayer+1)
+	case "c":
+		if m.offer.ReleaseClause > 0 {
+			m.offer.ReleaseClause = 0
+		} else {
+			m.offer.ReleaseClause = domain.RoundFee(2 * talks.Player.Valuation(m.today))
+		}
+	case "enter":
+		if talks.Offer(m.offer) == domain.BidAccepted {
+			renewal := domain.Renewal{Player: talks.Player, Club: talks.Club, Contract: *talks.Agreed}
+			return m, func() tea.Msg {
+				return renewContractMsg{renewal: renewal}
+			}
+		}
+		if talks.Demand > 0 {
+			m.offer.Wage = talks.Demand
+		}
+	}
+
+	return m, nil
+}
+
+// talksHotkeys lists the keys for contract talks, or for leaving them once
+// they're over
+func (m *SquadModel) talksHotkeys() []components.HotkeyBinding {
+	if m.talks.Status != domain.NegotiationOpen {
+		return []components.HotkeyBinding{
+			{Key: "Any key", Description: "Back to squad"},
+		}
+	}
+	return []components.HotkeyBinding{
+		{Key: "+/-", Description: "Wage"},
+		{Key: "←/→", Description: "Length"},
+		{Key: "P", Description: "Role"},
+		{Key: "C", Description: "Release clause"},
+		{Key: "Enter", Description: "Offer"},
+		{Key: "Esc", Description: "Walk away"},
+	}
+}
+
+// wageStep is how much a wage offer goes up or down by, in proportion to its
+// size
+func wageStep(wage int64) int64 {
+	switch {
+	case wage >= 50_000:
+		return 2_500
+	case wage >= 10_000:
+		return 500
+	}
+	return 100
+}
+
+// visiblePlayers returns the players that pass the filter, in sort order
+func (m *SquadModel) visiblePlayers() []domain.Player {
+	players := make([]domain.Player, 0, len(m.players))
+	for _, player := range m.players {
+		if matchesSquadFilter(player, m.filter) {
+			players = append(players, player)
+		}
+	}
+
+	sort.SliceStable(players, func(i, j int) bool {
+		a, b := m.stats[players[i].ID], m.stats[players[j].ID]
+		switch m.sortBy {
+		case SortByPosition:
+			return positionOrder[players[i].Position] < positionOrder[players[j].Position]
+		case SortByQuality:
+			return players[i].Quality > players[j].Quality
+		case SortByForm:
+			return a.Form > b.Form
+		case SortByGoals:
+			return a.Goals > b.Goals
+		case SortByAppearances:
+			return a.Appearances > b.Appearances
+		default:
+			return false
+		}
+	})
+
+	return players
+}
+
+// matchesSquadFilter reports whether the player belongs in the part of the
+// squad the filter picks out
+func matchesSquadFilter(player domain.Player, filter SquadFilter) bool {
+	switch filter {
+	case ShowGoalkeepers:
+		return player.Position == "GK"
+	case ShowDefenders:
+		return player.Position == "RB" || player.Position == "CB" || player.Position == "LB"
+	case ShowMidfielders:
+		return player.Position == "RM" || player.Position == "CM" || player.Position == "LM"
+	case ShowAttackers:
+		return player.Position == "RW" || player.Position == "ST" || player.Position == "LW"
+	case ShowAvailable:
+		return player.IsAvailable()
+	default:
+		return true
+	}
+}
+
+// summary describes how the squad is listed and, once known, how settled
+// the side is
+func (m *SquadModel) summary() string {
+	summary := "Sort: " + squadSortNames[m.sortBy] + "   Filter: " + squadFilterNames[m.filter]
+	if m.club != nil {
+		summary += "   Cohesion: " + components.Cohesion(m.club)
+	}
+	return summary
+}
+
+func (m *SquadModel) View() string {
+	players := m.visiblePlayers()
+
+	listing := lipgloss.JoinVertical(
+		lipgloss.Left,
+		lipgloss.NewStyle().Foreground(lipgloss.Color("241")).Render(m.summary()),
+		"",
+		components.SquadList(players, m.stats, m.cursor, domain.SeasonStartYear(m.today)),
+	)
+
+	detail := ""
+	switch {
+	case m.talks != nil:
+		detail = components.Panel(components.DefaultPanelConfig(m.width/3, 0),
+			components.ContractTalks(m.talks, m.offer))
+	case m.cursor < len(players):
+		selected := players[m.cursor]
+		detail = components.Panel(components.DefaultPanelConfig(m.width/3, 0),
+			components.PlayerDetail(selected, m.stats[selected.ID], m.today))
+	}
+
+	return lipgloss.JoinHorizontal(
+		lipgloss.Top,
+		lipgloss.NewStyle().Width(m.width*2/3).Render(listing),
+		detail,
+	)
+}
@@ -37,10 +37,13 @@ func NewSubstitutionModel(currentXI, bench []*domain.MatchPlayerParticipant) *Su
 	fieldedList.SetShowHelp(false)
 	fieldedList.SetFilteringEnabled(false)
 
-	// Create bench players list
-	benchItems := make([]list.Item, len(bench))
-	for i, p := range bench {
-		benchItems[i] = playerItem{player: p, isBench: true}
+	// Create bench players list, leaving out anyone injured during the match
+	benchItems := make([]list.Item, 0, len(bench))
+	for _, p := range bench {
+		if p.Injury != nil {
+			continue
+		}
+		benchItems = append(benchItems, playerItem{player: p, isBench: true})
 	}
 
 	benchDelegate := playerDelegate{}
@@ -0,0 +1,79 @@
+package tui
+
+import (
+	"github.com/cameronjpr/gaffer/internal/components"
+	"github.com/cameronjpr/gaffer/internal/domain"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// trainingSettings is how many rows of the schedule come before the players
+const trainingSettings = 2
+
+// TrainingModel handles the training tab of the manager hub: the squad's
+// weekly schedule and what each player works on
+type TrainingModel struct {
+	club    *domain.Club
+	players []domain.Player
+	cursor  int
+}
+
+// NewTrainingModel creates a new training model
+func NewTrainingModel() *TrainingModel {
+	return &TrainingModel{}
+}
+
+func (m *TrainingModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m *TrainingModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok || m.club == nil {
+		return m, nil
+	}
+
+	step := 0
+	switch keyMsg.String() {
+	case "up", "k":
+		m.cursor = max(0, m.cursor-1)
+	case "down", "j":
+		m.cursor = min(trainingSettings+len(m.players)-1, m.cursor+1)
+	case "right", "l":
+		step = 1
+	case "left", "h":
+		step = -1
+	}
+	if step == 0 {
+		return m, nil
+	}
+
+	plan := m.club.Training
+	switch m.cursor {
+	case 0:
+		plan.Intensity = domain.TrainingIntensity(cycle(int(plan.Intensity), step, int(domain.IntensityIntense)+1))
+	case 1:
+		plan.Focus = domain.TrainingFocus(cycle(int(plan.Focus), step, int(domain.FocusFitness)+1))
+	default:
+		player := m.players[m.cursor-trainingSettings]
+		focus := domain.TrainingFocus(cycle(int(player.TrainingFocus), step, int(domain.FocusFitness)+1))
+		return m, func() tea.Msg {
+			return setTrainingFocusMsg{player: player, focus: focus}
+		}
+	}
+	return m, func() tea.Msg {
+		return setTrainingPlanMsg{plan: plan}
+	}
+}
+
+// cycle steps a setting on or back through its n options, wrapping around
+func cycle(value, step, n int) int {
+	return (value + step + n) % n
+}
+
+func (m *TrainingModel) View() string {
+	if m.club == nil {
+		return ""
+	}
+	m.cursor = min(m.cursor, trainingSettings+len(m.players)-1)
+	return components.TrainingPlanner(m.club, m.players, m.cursor)
+}
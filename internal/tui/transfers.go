@@ -0,0 +1,342 @@
+package tui
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/cameronjpr/gaffer/internal/components"
+	"github.com/cameronjpr/gaffer/internal/domain"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// MarketSort is the order players are listed in on the transfer market
+type MarketSort int
+
+const (
+	SortMarketByValue MarketSort = iota
+	SortMarketByQuality
+	SortMarketByAge
+)
+
+var marketSortNames = map[MarketSort]string{
+	SortMarketByValue:   "Value",
+	SortMarketByQuality: "Quality",
+	SortMarketByAge:     "Age",
+}
+
+// TransferMarketModel is the screen for finding players at other clubs and
+// bidding for them
+type TransferMarketModel struct {
+	club        *domain.ClubWithPlayers
+	clubs       []*domain.ClubWithPlayers
+	season      *domain.Season
+	transfers   []domain.Transfer
+	search      textinput.Model
+	searching   bool
+	sortBy      MarketSort
+	filter      SquadFilter
+	cursor      int
+	offset      int
+	negotiation *domain.Negotiation
+	offer       int64
+	notice      string
+	width       int
+	height      int
+}
+
+func NewTransferMarketModel(clubID int64, clubs []*domain.ClubWithPlayers, season *domain.Season, transfers []domain.Transfer) *TransferMarketModel {
+	search := textinput.New()
+	search.Placeholder = "Player or club"
+	search.Prompt = "Search: "
+
+	m := &TransferMarketModel{
+		search: search,
+	}
+	m.SetMarket(clubID, clubs, season, transfers)
+	return m
+}
+
+// SetMarket refreshes the clubs and deals after a transfer, keeping the
+// search, sort and filter
+func (m *TransferMarketModel) SetMarket(clubID int64, clubs []*domain.ClubWithPlayers, season *domain.Season, transfers []domain.Transfer) {
+	m.clubs = clubs
+	m.season = season
+	m.transfers = transfers
+	for _, club := range clubs {
+		if club.Club.ID == clubID {
+			m.club = club
+		}
+	}
+	m.clampCursor()
+}
+
+func (m *TransferMarketModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m *TransferMarketModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.clampCursor()
+		return m, nil
+
+	case tea.KeyMsg:
+		switch {
+		case m.searching:
+			return m.updateSearch(msg)
+		case m.negotiation != nil:
+			return m.updateNegotiation(msg)
+		}
+
+		switch msg.String() {
+		case "esc":
+			clubID := m.club.Club.ID
+			return m, func() tea.Msg {
+				return goToManagerHubMsg{ClubID: clubID}
+			}
+		case "up", "k":
+			m.cursor = max(0, m.cursor-1)
+			m.clampCursor()
+		case "down", "j":
+			m.cursor++
+			m.clampCursor()
+		case "/":
+			m.searching = true
+			return m, m.search.Focus()
+		case "o":
+			m.sortBy = (m.sortBy + 1) % MarketSort(len(marketSortNames))
+			m.cursor, m.offset = 0, 0
+		case "f":
+			m.filter = (m.filter + 1) % SquadFilter(len(squadFilterNames))
+			m.cursor, m.offset = 0, 0
+		case "enter":
+			entries := m.entries()
+			if m.cursor >= len(entries) {
+				return m, nil
+			}
+			if domain.OpenTransferWindow(m.season.StartYear, m.season.Today) == nil {
+				m.notice = "The transfer window is shut"
+				return m, nil
+			}
+			entry := entries[m.cursor]
+			m.negotiation = domain.NewNegotiation(entry.Player, entry.Club, m.club, m.season.Today)
+			m.offer = entry.Player.Valuation(m.season.Today)
+			m.notice = ""
+		}
+	}
+
+	return m, nil
+}
+
+// updateSearch types into the search box until it's confirmed or cleared
+func (m *TransferMarketModel) updateSearch(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "enter":
+		m.searching = false
+		m.search.Blur()
+		return m, nil
+	case "esc":
+		m.searching = false
+		m.search.Blur()
+		m.search.SetValue("")
+		m.cursor, m.offset = 0, 0
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.search, cmd = m.search.Update(msg)
+	m.cursor, m.offset = 0, 0
+	return m, cmd
+}
+
+// updateNegotiation adjusts and submits bids while talks are under way
+func (m *TransferMarketModel) updateNegotiation(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	negotiation := m.negotiation
+	if negotiation.Status != domain.NegotiationOpen {
+		// Any key closes talks that have ended
+		m.negotiation = nil
+		return m, nil
+	}
+
+	switch msg.String() {
+	case "esc":
+		m.negotiation = nil
+		m.notice = ""
+	case "up", "k", "+", "=":
+		m.offer += bidStep(m.offer)
+	case "down", "j", "-":
+		m.offer = max(bidStep(m.offer), m.offer-bidStep(m.offer))
+	case "enter":
+		if m.offer > m.club.Club.TransferBudget {
+			m.notice = "The board won't fund a bid beyond your budget of " + components.Money(m.club.Club.TransferBudget)
+			return m, nil
+		}
+		m.notice = ""
+		if negotiation.Bid(m.offer) == domain.BidAccepted {
+			transfer := negotiation.Transfer(m.season.Today)
+			m.negotiation = nil
+			return m, func() tea.Msg {
+				return completeTransferMsg{transfer: transfer}
+			}
+		}
+		if negotiation.Counter > 0 {
+			m.offer = negotiation.Counter
+		}
+	}
+
+	return m, nil
+}
+
+// bidStep is how much an offer goes up or down by, in proportion to its size
+func bidStep(offer int64) int64 {
+	if offer >= 1_000_000 {
+		return 250_000
+	}
+	return 50_000
+}
+
+// entries returns the players at other clubs that match the search and
+// filter, in sort order
+func (m *TransferMarketModel) entries() []domain.MarketEntry {
+	query := strings.ToLower(strings.TrimSpace(m.search.Value()))
+
+	var entries []domain.MarketEntry
+	for _, club := range m.clubs {
+		if m.club != nil && club.Club.ID == m.club.Club.ID {
+			continue
+		}
+		clubMatches := strings.Contains(strings.ToLower(club.Club.Name), query)
+		for _, player := range club.Players {
+			if !matchesSquadFilter(player, m.filter) {
+				continue
+			}
+			if !clubMatches && !strings.Contains(strings.ToLower(player.Name), query) {
+				continue
+			}
+			entries = append(entries, domain.MarketEntry{Player: player, Club: club})
+		}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		a, b := entries[i].Player, entries[j].Player
+		switch m.sortBy {
+		case SortMarketByQuality:
+			return a.Quality > b.Quality
+		case SortMarketByAge:
+			return a.AgeOn(m.season.Today) < b.AgeOn(m.season.Today)
+		default:
+			return a.Valuation(m.season.Today) > b.Valuation(m.season.Today)
+		}
+	})
+
+	return entries
+}
+
+// visibleRows returns how many players fit on screen below the search box
+func (m *TransferMarketModel) visibleRows() int {
+	return max(1, m.height-12)
+}
+
+// clampCursor keeps the cursor on the list and scrolls it into view
+func (m *TransferMarketModel) clampCursor() {
+	m.cursor = max(0, min(m.cursor, len(m.entries())-1))
+	rows := m.visibleRows()
+	if m.cursor < m.offset {
+		m.offset = m.cursor
+	}
+	if m.cursor >= m.offset+rows {
+		m.offset = m.cursor - rows + 1
+	}
+}
+
+func (m *TransferMarketModel) View() string {
+	header := lipgloss.NewStyle().
+		Align(lipgloss.Center).
+		Width(m.width).
+		Padding(1, 2).
+		Bold(true).
+		Background(lipgloss.Color(m.club.Club.Background)).
+		Foreground(lipgloss.Color(m.club.Club.Foreground)).
+		Render("TRANSFER MARKET · " + components.Date(m.season.Today))
+
+	var hotkeys []components.HotkeyBinding
+	switch {
+	case m.searching:
+		hotkeys = []components.HotkeyBinding{
+			{Key: "Enter", Description: "Done"},
+			{Key: "Esc", Description: "Clear"},
+		}
+	case m.negotiation != nil && m.negotiation.Status == domain.NegotiationOpen:
+		hotkeys = []components.HotkeyBinding{
+			{Key: "+/-", Description: "Change offer"},
+			{Key: "Enter", Description: "Bid"},
+			{Key: "Esc", Description: "Walk away"},
+		}
+	case m.negotiation != nil:
+		hotkeys = []components.HotkeyBinding{
+			{Key: "Any key", Description: "Back to market"},
+		}
+	default:
+		hotkeys = []components.HotkeyBinding{
+			{Key: "↑/↓", Description: "Select"},
+			{Key: "/", Description: "Search"},
+			{Key: "O", Description: "Sort"},
+			{Key: "F", Description: "Filter"},
+			{Key: "Enter", Description: "Make an offer"},
+			{Key: "Esc", Description: "Back"},
+		}
+	}
+	footer := components.HotkeyGuide(m.width, hotkeys)
+
+	headerHeight := lipgloss.Height(header)
+	footerHeight := lipgloss.Height(footer)
+	contentHeight := m.height - headerHeight - footerHeight
+
+	entries := m.entries()
+	mutedStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("241"))
+	listing := lipgloss.JoinVertical(
+		lipgloss.Left,
+		m.search.View(),
+		mutedStyle.Render("Sort: "+marketSortNames[m.sortBy]+"   Filter: "+squadFilterNames[m.filter]),
+		"",
+		components.MarketList(entries, m.cursor, m.offset, m.visibleRows(), m.season.Today),
+	)
+
+	var detail string
+	switch {
+	case m.negotiation != nil:
+		sections := []string{components.Negotiation(m.negotiation, m.offer, m.season.Today)}
+		if m.notice != "" {
+			sections = append(sections, lipgloss.NewStyle().Bold(true).Render(m.notice))
+		}
+		detail = components.Stack(sections, 1)
+	default:
+		sections := []string{
+			components.TransferWindowStatus(m.season.StartYear, m.season.Today),
+			"Transfer budget: " + components.Money(m.club.Club.TransferBudget),
+		}
+		if m.notice != "" {
+			sections = append(sections, lipgloss.NewStyle().Bold(true).Render(m.notice))
+		}
+		sections = append(sections, components.Transfers("Done deals", m.transfers, m.club.Club.ID, 10))
+		detail = components.Stack(sections, 1)
+	}
+
+	content := lipgloss.NewStyle().Padding(1, 2).Render(lipgloss.JoinHorizontal(
+		lipgloss.Top,
+		lipgloss.NewStyle().Width((m.width-4)*3/5).Render(listing),
+		components.Panel(components.DefaultPanelConfig((m.width-4)*2/5, 0), detail),
+	))
+
+	sections := []components.ScreenSection{
+		{Height: headerHeight, Content: header},
+		{Height: contentHeight, Content: content},
+		{Height: footerHeight, Content: footer},
+	}
+
+	return components.ScreenLayout(m.height, sections)
+}
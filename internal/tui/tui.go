@@ -1,11 +1,17 @@
 package tui
 
 import (
+	"context"
 	"fmt"
+	"math/rand/v2"
 	"time"
 
+	"github.com/cameronjpr/gaffer/internal/components"
+	"github.com/cameronjpr/gaffer/internal/db"
 	"github.com/cameronjpr/gaffer/internal/domain"
+	"github.com/cameronjpr/gaffer/internal/simulation"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 )
 
 type tickMsg time.Time
@@ -25,6 +31,14 @@ func (m *AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if msg.Type == tea.KeyCtrlC {
 			return m, tea.Quit
 		}
+		// Any key dismisses the last error
+		m.status = ""
+
+	case goToMenuMsg:
+		// Start afresh, free to take over any club
+		m.onboarding = NewOnboardingModel(m.clubs, m.divisions)
+		m.mode = MenuMode
+		return m, tick()
 
 	case goToOnboardingMsg:
 		m.mode = OnboardingMode
@@ -40,25 +54,86 @@ func (m *AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, tea.Quit
 		}
 
+		// Make the first cup draws of the season if they're still to come
+		m.advanceCups()
+		cup, err := m.cupRepo.GetCurrent(domain.DomesticCupName)
+		if err != nil {
+			return m, tea.Quit
+		}
+		continental, err := m.cupRepo.GetCurrent(domain.ContinentalCupName)
+		if err != nil {
+			return m, tea.Quit
+		}
+
 		// Get only unplayed fixtures for the hub display
 		fixtures, err := m.fixtureRepo.GetUnplayedByClubID(club.Club.ID)
 		if err != nil {
 			return m, tea.Quit
 		}
 
-		// Calculate league table from database
-		leagueTable, err := m.matchRepo.CalculateLeagueTable(m.clubs, m.fixtures)
+		// Calculate the club's league tables from database
+		division := m.divisionByID(club.Club.DivisionID)
+		leagueTables, err := m.calculateLeagueTables(division)
+		if err != nil {
+			return m, tea.Quit
+		}
+
+		suspensions, err := m.disciplineRepo.GetSuspensionsByClubID(club.Club.ID)
+		if err != nil {
+			return m, tea.Quit
+		}
+
+		injuries, err := m.injuryRepo.GetInjuriesByClubID(club.Club.ID)
+		if err != nil {
+			return m, tea.Quit
+		}
+
+		seasonStats, err := m.statsRepo.GetSeasonStatsByClubID(club.Club.ID)
+		if err != nil {
+			return m, tea.Quit
+		}
+
+		transfers, err := m.transferRepo.GetCurrentSeason()
+		if err != nil {
+			return m, tea.Quit
+		}
+
+		finances, err := m.financeRepo.GetSummary(club.Club.ID)
 		if err != nil {
 			return m, tea.Quit
 		}
 
-		m.managerHub = NewManagerHubModel(club.Club, fixtures, leagueTable)
+		messages, err := m.inboxRepo.GetAll()
+		if err != nil {
+			return m, tea.Quit
+		}
+
+		m.managerHub = NewManagerHubModel(club, fixtures, leagueTables, suspensions, injuries, seasonStats)
+		m.managerHub.Division = division
+		m.managerHub.Season = m.season
+		m.managerHub.Cup = cup
+		m.managerHub.Continental = continental
+		m.managerHub.Transfers = transfers
+		m.managerHub.Finances = finances
+		m.managerHub.SetMessages(messages)
 		m.mode = ManagerHubMode
 		m.managerHub.width = m.width
 		m.managerHub.height = m.height
 		return m, tick()
 
-	case startPreMatchMsg:
+	case appointManagerMsg:
+		m.appoint(msg.ClubID)
+		return m.Update(goToManagerHubMsg{ClubID: msg.ClubID})
+
+	case applyForJobMsg:
+		if err := domain.Apply(msg.club.Club, m.vacancies.former, m.clubs); err != nil {
+			m.vacancies.notice = "Application turned down: " + err.Error()
+			return m, nil
+		}
+		m.appoint(msg.club.Club.ID)
+		return m.Update(goToManagerHubMsg{ClubID: msg.club.Club.ID})
+
+	case continueMsg:
 		// Get the next fixture for the selected club
 		unplayedFixtures, err := m.fixtureRepo.GetUnplayedByClubID(m.managerHub.ChosenClub.ID)
 		if err != nil {
@@ -66,15 +141,51 @@ func (m *AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 		if len(unplayedFixtures) == 0 {
-			return m, tea.Quit
+			return m.finishSeason()
 		}
 
-		nextMatch := domain.NewMatchFromFixture(unplayedFixtures[0])
+		// Play out the days until our next match, stopping early to show
+		// any cup draw made along the way, the transfer window opening or
+		// shutting, a message that needs an answer or the user's sacking
+		nextFixture := unplayedFixtures[0]
+		if nextFixture.Date.After(m.season.Today) {
+			for nextFixture.Date.After(m.season.Today) {
+				stop, sacked := m.advanceDay()
+				if sacked {
+					return m.lookForWork()
+				}
+				if stop {
+					return m.refreshHub()
+				}
+			}
+
+			// Pick the squads up as they are after the days' rest
+			nextFixture, err = m.fixtureRepo.GetByID(int64(nextFixture.ID))
+			if err != nil {
+				return m, tea.Quit
+			}
+		}
+
+		nextMatch := domain.NewMatchFromFixture(nextFixture)
 		m.currentMatch = nextMatch
 
-		// Update the prematch and match models with the new match
-		m.prematch = NewPreMatchModel(m.currentMatch)
-		m.match = NewMatchModel(m.currentMatch, m.managerHub.ChosenClub.ID)
+		userSquad := nextFixture.HomeTeam
+		if userSquad.Club.ID != m.managerHub.ChosenClub.ID {
+			userSquad = nextFixture.AwayTeam
+		}
+
+		// Start from last match's selection, minus anyone now unavailable
+		lineup, err := m.lineupRepo.GetByClubID(userSquad.Club.ID)
+		if err != nil {
+			return m, tea.Quit
+		}
+		if lineup == nil {
+			lineup = domain.AutoPickLineup(domain.FourThreeThree, userSquad.Players)
+		} else {
+			lineup.DropUnavailable(userSquad.Players)
+		}
+
+		m.prematch = NewPreMatchModel(m.currentMatch, userSquad, lineup)
 
 		m.mode = PreMatchMode
 		// Send WindowSizeMsg to newly activated model
@@ -83,6 +194,13 @@ func (m *AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, tick()
 
 	case startMatchMsg:
+		// Field the chosen lineup and remember it as the default for next match
+		userSquad := m.prematch.userSquad
+		m.currentMatch.ReplaceParticipant(domain.NewMatchParticipantFromLineup(userSquad.Club, userSquad.Players, msg.lineup))
+		if err := m.lineupRepo.Save(userSquad.Club.ID, msg.lineup); err != nil {
+			m.report("saving lineup", err)
+		}
+		m.match = NewMatchModel(m.currentMatch, userSquad.Club.ID)
 
 		// Create the match record in the database
 		err := m.matchRepo.Create(m.currentMatch)
@@ -102,43 +220,119 @@ func (m *AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		match := msg.match
 		match.ForFixture.Result = msg.match
 
-		// Save the match result to the database
-		err := m.matchRepo.SaveResult(match)
-		if err != nil {
-			fmt.Println("Error saving match result:", err)
+		m.recordMatch(match)
+
+		// Play out the rest of the day and draw the next cup rounds if
+		// these ones are done, then let the boards judge the day's results
+		played := m.simulateFixtures(m.season.Today)
+		m.refreshClubs(append(played, match.Home.Club.ID, match.Away.Club.ID)...)
+		m.advanceCups()
+		if m.judgeManagers(m.season.Today) {
+			return m.lookForWork()
 		}
 
-		// Refresh the unplayed fixtures list
+		// Once our season is over, finish everyone else's and review it
 		unplayedFixtures, err := m.fixtureRepo.GetUnplayedByClubID(m.managerHub.ChosenClub.ID)
 		if err != nil {
 			return m, tea.Quit
 		}
-
 		if len(unplayedFixtures) == 0 {
+			return m.finishSeason()
+		}
+
+		return m.refreshHub()
+
+	case goToTransferMarketMsg:
+		transfers, err := m.transferRepo.GetCurrentSeason()
+		if err != nil {
 			return m, tea.Quit
 		}
 
-		nextMatch := domain.NewMatchFromFixture(unplayedFixtures[0])
-		m.currentMatch = nextMatch
+		m.market = NewTransferMarketModel(m.managerHub.ChosenClub.ID, m.clubs, m.season, transfers)
+		m.mode = TransferMarketMode
+		// Send WindowSizeMsg to newly activated model
+		m.market.width = m.width
+		m.market.height = m.height
+		return m, tick()
 
-		// Update the child models to point to the new match
-		m.prematch = NewPreMatchModel(m.currentMatch)
-		m.match = NewMatchModel(m.currentMatch, m.managerHub.ChosenClub.ID)
+	case completeTransferMsg:
+		transfer := msg.transfer
+		if err := m.transferRepo.Complete(transfer); err != nil {
+			m.market.notice = "The deal fell through: " + err.Error()
+			return m, nil
+		}
+		if err := m.financeRepo.Record(domain.TransferPayments(transfer)); err != nil {
+			m.report("paying transfer fee", err)
+		}
 
-		// Recalculate league table with latest results
-		leagueTable, err := m.matchRepo.CalculateLeagueTable(m.clubs, m.fixtures)
+		// Reload the squads either side of the deal
+		m.refreshClubs(transfer.From.ID, transfer.To.ID)
+		transfers, err := m.transferRepo.GetCurrentSeason()
 		if err != nil {
-			// Log error but continue
-		} else {
-			m.managerHub.LeagueTable = leagueTable
+			return m, tea.Quit
 		}
 
-		// Update the hub's fixture list to remove completed fixtures
-		m.managerHub.Fixtures = unplayedFixtures
+		m.market.SetMarket(m.managerHub.ChosenClub.ID, m.clubs, m.season, transfers)
+		m.market.notice = fmt.Sprintf("Signed %s from %s for %s", transfer.Player.Name, transfer.From.Name, components.Money(transfer.Fee))
+		return m, nil
 
-		// Go back to the hub
-		m.mode = ManagerHubMode
-		return m, tick()
+	case renewContractMsg:
+		if err := m.contractRepo.Renew([]domain.Renewal{msg.renewal}); err != nil {
+			m.report("renewing contract", err)
+		}
+		return m.refreshHub()
+
+	case setTrainingPlanMsg:
+		if err := m.trainingRepo.SetPlan(m.managerHub.ChosenClub, msg.plan); err != nil {
+			m.report("setting training plan", err)
+		}
+		return m.refreshHub()
+
+	case setTrainingFocusMsg:
+		if err := m.trainingRepo.SetFocus(msg.player, msg.focus); err != nil {
+			m.report("setting training focus", err)
+		}
+		return m.refreshHub()
+
+	case promiseRoleMsg:
+		if _, err := m.moraleRepo.Promise(msg.player); err != nil {
+			m.report("promising a role", err)
+		}
+		return m.refreshHub()
+
+	case readMessageMsg:
+		if err := m.inboxRepo.MarkRead(msg.message); err != nil {
+			m.report("reading message", err)
+		}
+		return m.refreshHub()
+
+	case answerMessageMsg:
+		m.answerMessage(msg.message, msg.accept)
+		return m.refreshHub()
+
+	case rolloverSeasonMsg:
+		// The summer's business all goes through together, or not at all so
+		// the season can be rolled over again
+		clubID := m.managerHub.ChosenClub.ID
+		var sacked bool
+		err := m.inTransaction(func() (err error) {
+			sacked, err = m.rolloverSeason(m.seasonSummary.summary)
+			return err
+		})
+		if err != nil {
+			m.report("rolling the season over", err)
+			if err := m.reload(); err != nil {
+				m.report("reloading the season", err)
+			}
+			return m, nil
+		}
+
+		if sacked {
+			return m.lookForWork()
+		}
+		return m, func() tea.Msg {
+			return goToManagerHubMsg{ClubID: clubID}
+		}
 	}
 
 	var cmd tea.Cmd
@@ -173,23 +367,1224 @@ func (m *AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			// m.mode = ResultsMode
 		}
 
+	case SeasonSummaryMode:
+		var newSeasonSummary tea.Model
+		newSeasonSummary, cmd = m.seasonSummary.Update(msg)
+		m.seasonSummary = newSeasonSummary.(*SeasonSummaryModel)
+
+	case TransferMarketMode:
+		var newMarket tea.Model
+		newMarket, cmd = m.market.Update(msg)
+		m.market = newMarket.(*TransferMarketModel)
+
+	case VacanciesMode:
+		var newVacancies tea.Model
+		newVacancies, cmd = m.vacancies.Update(msg)
+		m.vacancies = newVacancies.(*VacanciesModel)
 	}
 
 	return m, cmd
 }
 
+// refreshHub brings the hub up to date with the latest results, draws and
+// squad news, then returns to it
+func (m *AppModel) refreshHub() (tea.Model, tea.Cmd) {
+	// Recalculate league table with latest results
+	leagueTables, err := m.calculateLeagueTables(m.managerHub.Division)
+	if err != nil {
+		// Log error but continue
+	} else {
+		m.managerHub.SetLeagueTables(leagueTables)
+	}
+
+	// Update the hub's fixture list to remove completed fixtures and add
+	// any newly drawn cup ties
+	unplayedFixtures, err := m.fixtureRepo.GetUnplayedByClubID(m.managerHub.ChosenClub.ID)
+	if err != nil {
+		return m, tea.Quit
+	}
+	m.managerHub.Fixtures = unplayedFixtures
+
+	cup, err := m.cupRepo.GetCurrent(domain.DomesticCupName)
+	if err == nil {
+		m.managerHub.Cup = cup
+	}
+	continental, err := m.cupRepo.GetCurrent(domain.ContinentalCupName)
+	if err == nil {
+		m.managerHub.Continental = continental
+	}
+
+	suspensions, err := m.disciplineRepo.GetSuspensionsByClubID(m.managerHub.ChosenClub.ID)
+	if err == nil {
+		m.managerHub.Suspensions = suspensions
+	}
+
+	injuries, err := m.injuryRepo.GetInjuriesByClubID(m.managerHub.ChosenClub.ID)
+	if err == nil {
+		m.managerHub.Injuries = injuries
+	}
+
+	transfers, err := m.transferRepo.GetCurrentSeason()
+	if err == nil {
+		m.managerHub.Transfers = transfers
+	}
+
+	// Refresh the squad with its latest availability and stats, and the
+	// club's accounts
+	club, err := m.clubRepo.GetByID(m.managerHub.ChosenClub.ID)
+	if err == nil {
+		m.managerHub.ChosenClub = club.Club
+		seasonStats, err := m.statsRepo.GetSeasonStatsByClubID(club.Club.ID)
+		if err == nil {
+			m.managerHub.SetSquad(club.Players, seasonStats)
+		}
+	}
+
+	finances, err := m.financeRepo.GetSummary(m.managerHub.ChosenClub.ID)
+	if err == nil {
+		m.managerHub.Finances = finances
+	}
+
+	messages, err := m.inboxRepo.GetAll()
+	if err == nil {
+		m.managerHub.SetMessages(messages)
+	}
+
+	// Go back to the hub
+	m.mode = ManagerHubMode
+	return m, tick()
+}
+
+// advanceDay plays out the rest of today's fixtures, making any cup draws
+// they complete and letting the boards judge the results, and the AI clubs'
+// day of transfer business, then moves the calendar on to tomorrow with a
+// day's rest for every player, settling the month's accounts when a new one
+// begins and lapsing stale transfer offers. The inbox is loaded once for the
+// day, and only the squads a match or a deal changed are reloaded. It reports
+// whether to stop and show the hub, because a cup draw was made, the transfer
+// window opened or shut overnight or a message arrived that needs an answer,
+// and whether the user was sacked.
+func (m *AppModel) advanceDay() (stop, sacked bool) {
+	wasOpen := domain.OpenTransferWindow(m.season.StartYear, m.season.Today) != nil
+	messages, err := m.inboxRepo.GetAll()
+	if err != nil {
+		m.report("loading messages", err)
+	}
+	waiting := 0
+	for _, message := range messages {
+		if message.Pending() {
+			waiting++
+		}
+	}
+
+	drawn := false
+	if played := m.simulateFixtures(m.season.Today); len(played) > 0 {
+		m.refreshClubs(played...)
+		drawn = m.advanceCups()
+		sacked = m.judgeManagers(m.season.Today)
+	}
+
+	if err := m.injuryRepo.Recover(1); err != nil {
+		m.report("recovering injuries", err)
+	}
+	if err := m.fitnessRepo.Recover(1); err != nil {
+		m.report("recovering fatigue", err)
+	}
+	for _, club := range m.clubs {
+		for i := range club.Players {
+			club.Players[i].Rest(1)
+		}
+	}
+
+	m.tradePlayers(messages)
+
+	yesterday := m.season.Today
+	m.season.Today = m.season.Today.AddDate(0, 0, 1)
+	if err := m.seasonRepo.SetToday(m.season); err != nil {
+		m.report("saving the date", err)
+	}
+	if err := m.settleAccounts(m.clubs, yesterday, m.season.Today); err != nil {
+		m.report("settling accounts", err)
+	}
+	if err := m.developPlayers(m.clubs, yesterday, m.season.Today); err != nil {
+		m.report("developing players", err)
+	}
+	m.trainPlayers(m.clubs, messages, yesterday, m.season.Today)
+	m.lapseOffers(messages)
+
+	pending, err := m.inboxRepo.CountPending()
+	if err != nil {
+		m.report("counting messages", err)
+	}
+	isOpen := domain.OpenTransferWindow(m.season.StartYear, m.season.Today) != nil
+	return drawn || wasOpen != isOpen || pending > waiting, sacked
+}
+
+// tradePlayers lets the AI clubs do a day's business while the transfer
+// window is open, saving each deal they make and any offer for the user's
+// players, given the day's inbox. The squads either side of each deal are
+// reloaded.
+func (m *AppModel) tradePlayers(messages []domain.Message) {
+	if domain.OpenTransferWindow(m.season.StartYear, m.season.Today) == nil {
+		return
+	}
+
+	transfers, err := m.transferRepo.GetCurrentSeason()
+	if err != nil {
+		m.report("loading transfers", err)
+		return
+	}
+
+	rng := rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))
+	deals := domain.MarketDay(m.clubs, transfers, m.managerHub.ChosenClub.ID, m.season.Today, rng)
+	for _, deal := range deals {
+		if err := m.transferRepo.Complete(deal); err != nil {
+			m.report("completing transfer", err)
+			continue
+		}
+		if err := m.financeRepo.Record(domain.TransferPayments(deal)); err != nil {
+			m.report("paying transfer fee", err)
+		}
+		m.refreshClubs(deal.From.ID, deal.To.ID)
+	}
+
+	m.receiveOffers(messages, transfers, rng)
+}
+
+// receiveOffers gives the AI clubs the chance to bid for one of the user's
+// players, posting any offer to the inbox
+func (m *AppModel) receiveOffers(messages []domain.Message, transfers []domain.Transfer, rng *rand.Rand) {
+	club := m.clubByID(m.managerHub.ChosenClub.ID)
+	if club == nil {
+		return
+	}
+
+	offers := domain.TransferOffers(club, m.clubs, messages, transfers, m.season.Today, rng)
+	if err := m.inboxRepo.Post(offers); err != nil {
+		m.report("posting transfer offers", err)
+	}
+}
+
+// lapseOffers marks transfer offers in the day's inbox left unanswered for
+// too long, or caught by the window shutting, as expired
+func (m *AppModel) lapseOffers(messages []domain.Message) {
+	for _, message := range messages {
+		if !message.Lapsed(m.season.StartYear, m.season.Today) {
+			continue
+		}
+		if err := m.inboxRepo.Resolve(message, domain.MessageExpired); err != nil {
+			m.report("expiring offer", err)
+		}
+	}
+}
+
+// answerMessage carries out the manager's answer to a message waiting on
+// one: selling the player for a transfer offer they accept, or promising a
+// complaining player a bigger role or rebuffing them. An offer that can no
+// longer go through expires, with word of why.
+func (m *AppModel) answerMessage(message domain.Message, accept bool) {
+	status := domain.MessageRejected
+	if accept {
+		status = domain.MessageAccepted
+	}
+
+	switch message.Kind {
+	case domain.TransferOfferMessage:
+		if !accept {
+			break
+		}
+		if err := m.sellPlayer(message); err != nil {
+			status = domain.MessageExpired
+			notice := domain.Message{
+				Date:     m.season.Today,
+				Kind:     domain.TransferOfferMessage,
+				Subject:  "Deal off: " + message.Subject,
+				Body:     "The deal fell through: " + err.Error() + ".",
+				PlayerID: message.PlayerID,
+			}
+			if err := m.inboxRepo.Post([]domain.Message{notice}); err != nil {
+				m.report("posting message", err)
+			}
+		}
+
+	case domain.ComplaintMessage:
+		for _, player := range m.managerHub.Players {
+			if player.ID != message.PlayerID {
+				continue
+			}
+			if accept {
+				if _, err := m.moraleRepo.Promise(player); err != nil {
+					m.report("promising a role", err)
+				}
+				continue
+			}
+			player.RebuffComplaint()
+			if err := m.moraleRepo.Save([]domain.Player{player}); err != nil {
+				m.report("saving morale", err)
+			}
+		}
+	}
+
+	if err := m.inboxRepo.Resolve(message, status); err != nil {
+		m.report("answering message", err)
+	}
+}
+
+// sellPlayer completes the sale a transfer offer proposes, paying the fee
+func (m *AppModel) sellPlayer(offer domain.Message) error {
+	seller, err := m.clubRepo.GetByID(m.managerHub.ChosenClub.ID)
+	if err != nil {
+		return err
+	}
+	buyer, err := m.clubRepo.GetByID(offer.ClubID)
+	if err != nil {
+		return err
+	}
+	if domain.OpenTransferWindow(m.season.StartYear, m.season.Today) == nil {
+		return fmt.Errorf("the transfer window has shut")
+	}
+
+	transfer, err := domain.AcceptOffer(offer, seller, buyer, m.season.Today)
+	if err != nil {
+		return err
+	}
+	if err := m.transferRepo.Complete(transfer); err != nil {
+		return err
+	}
+	if err := m.financeRepo.Record(domain.TransferPayments(transfer)); err != nil {
+		m.report("paying transfer fee", err)
+	}
+
+	m.refreshClubs(seller.Club.ID, buyer.Club.ID)
+	return nil
+}
+
+// reportInjuries tells the manager about any of the injuries that are to
+// their own players
+func (m *AppModel) reportInjuries(injuries []domain.Injury) {
+	squad := make(map[int64]bool, len(m.managerHub.Players))
+	for _, player := range m.managerHub.Players {
+		squad[player.ID] = true
+	}
+
+	var reports []domain.Message
+	for _, injury := range injuries {
+		if squad[injury.PlayerID] {
+			reports = append(reports, domain.InjuryReport(injury, m.season.Today))
+		}
+	}
+	if err := m.inboxRepo.Post(reports); err != nil {
+		m.report("reporting injuries", err)
+	}
+}
+
+// weeklyMessages posts the week's round-up of the user's results and any
+// complaints from unhappy players on the day, given what's in their inbox
+func (m *AppModel) weeklyMessages(day time.Time, messages []domain.Message) {
+	userClubID := m.managerHub.ChosenClub.ID
+	var posts []domain.Message
+	for _, club := range m.clubs {
+		if club.Club.ID == userClubID {
+			posts = append(posts, domain.Complaints(club, messages, m.season.StartYear, day)...)
+		}
+	}
+
+	fixtures, err := m.fixtureRepo.GetByClubID(userClubID)
+	if err != nil {
+		m.report("loading fixtures", err)
+		return
+	}
+	var week []*domain.Fixture
+	for _, fixture := range fixtures {
+		if !fixture.Date.Before(day.AddDate(0, 0, -7)) && fixture.Date.Before(day) {
+			week = append(week, fixture)
+		}
+	}
+	results, err := m.matchRepo.GetResults(week)
+	if err != nil {
+		m.report("loading results", err)
+		return
+	}
+	table := m.managerHub.LeagueTables[domain.AllVenues]
+	if roundUp := domain.RoundUp(userClubID, m.managerHub.Division, results, table, day); roundUp != nil {
+		posts = append(posts, *roundUp)
+	}
+
+	if err := m.inboxRepo.Post(posts); err != nil {
+		m.report("posting weekly messages", err)
+	}
+}
+
+// settleAccounts pays every club in the pyramid its wages and TV money on
+// the first of each month between the two days, after the first. The board
+// freezes the transfer budget of any club that ends up in the red, and writes
+// to the manager when its view of their own club's finances changes.
+func (m *AppModel) settleAccounts(clubs []*domain.ClubWithPlayers, from, to time.Time) error {
+	for day := from.AddDate(0, 0, 1); !day.After(to); day = day.AddDate(0, 0, 1) {
+		if day.Day() != 1 {
+			continue
+		}
+
+		for _, club := range domain.ClubsInPyramid(clubs) {
+			stance := club.BoardStance()
+			if err := m.financeRepo.Record(domain.MonthlyAccounts(club, m.divisionByID(club.Club.DivisionID), day)); err != nil {
+				return err
+			}
+			if club.Club.ID == m.managerHub.ChosenClub.ID && club.BoardStance() != stance {
+				if err := m.inboxRepo.Post([]domain.Message{domain.BoardReport(club.BoardStance(), day)}); err != nil {
+					return err
+				}
+			}
+			if club.BoardStance() != domain.BoardSatisfied && club.Club.TransferBudget > 0 {
+				if err := m.financeRepo.SetTransferBudget(club.Club, 0); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// developPlayers brings every player's development up to date on each
+// first of the month between the days, from the game time they had over
+// the month before
+func (m *AppModel) developPlayers(clubs []*domain.ClubWithPlayers, from, to time.Time) error {
+	for day := from.AddDate(0, 0, 1); !day.After(to); day = day.AddDate(0, 0, 1) {
+		if day.Day() != 1 {
+			continue
+		}
+
+		minutes, err := m.developRepo.GetMinutesBetween(day.AddDate(0, -1, 0), day)
+		if err != nil {
+			return err
+		}
+
+		var changed []domain.Player
+		for _, club := range clubs {
+			for i := range club.Players {
+				player := &club.Players[i]
+				quality, progress := player.Quality, player.Progress
+				player.Develop(minutes[player.ID], day)
+				if player.Quality != quality || player.Progress != progress {
+					changed = append(changed, *player)
+				}
+			}
+		}
+		if err := m.developRepo.SaveDevelopment(changed); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// trainPlayers puts every squad in the pyramid through a week's training on
+// each Monday between the days, after the first, saving how each player came
+// out of it, how their morale settled over the week and any injuries picked
+// up, then posts the manager's weekly messages against their inbox. Clubs
+// abroad play in leagues of their own and are kept match-sharp.
+func (m *AppModel) trainPlayers(clubs []*domain.ClubWithPlayers, messages []domain.Message, from, to time.Time) {
+	for day := from.AddDate(0, 0, 1); !day.After(to); day = day.AddDate(0, 0, 1) {
+		if day.Weekday() != time.Monday {
+			continue
+		}
+
+		minutes, err := m.developRepo.GetMinutesBetween(day.AddDate(0, 0, -7), day)
+		if err != nil {
+			m.report("loading minutes played", err)
+			return
+		}
+
+		rng := rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))
+		var players []domain.Player
+		var injuries []domain.Injury
+		for _, club := range domain.ClubsInPyramid(clubs) {
+			injuries = append(injuries, domain.TrainingWeek(club, minutes, day, rng)...)
+			domain.MoraleWeek(club, m.season.StartYear)
+			players = append(players, club.Players...)
+		}
+		if err := m.trainingRepo.SaveWeek(players); err != nil {
+			m.report("saving training", err)
+		}
+		if err := m.moraleRepo.Save(players); err != nil {
+			m.report("saving morale", err)
+		}
+		if err := m.injuryRepo.Record(injuries); err != nil {
+			m.report("recording training injuries", err)
+		}
+		m.reportInjuries(injuries)
+		m.weeklyMessages(day, messages)
+	}
+}
+
+// retirePlayers takes the players retiring over the summer out of the game
+// and returns who they were
+func (m *AppModel) retirePlayers(rng *rand.Rand) ([]domain.Retirement, error) {
+	clubs, err := m.clubRepo.GetAll()
+	if err != nil {
+		return nil, err
+	}
+	m.clubs = clubs
+
+	retirements := domain.Retirements(m.clubs, m.season.Today, rng)
+	if err := m.developRepo.Retire(retirements); err != nil {
+		return nil, err
+	}
+	return retirements, nil
+}
+
+// expireContracts renews the contracts AI clubs want to keep now they've run
+// out, and lets everyone else out of contract leave on a free
+func (m *AppModel) expireContracts(rng *rand.Rand) error {
+	clubs, err := m.clubRepo.GetAll()
+	if err != nil {
+		return err
+	}
+	m.clubs = clubs
+
+	renewals, transfers := domain.ContractExpiries(m.clubs, m.managerHub.ChosenClub.ID, m.season.StartYear, m.season.Today, rng)
+	if err := m.contractRepo.Renew(renewals); err != nil {
+		return err
+	}
+	for _, transfer := range transfers {
+		if err := m.transferRepo.Complete(transfer); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// youthIntake brings a youngster into the game in place of each retired
+// player, then every academy's graduates into their club's squad
+func (m *AppModel) youthIntake(retirements []domain.Retirement, rng *rand.Rand) error {
+	clubs, err := m.clubRepo.GetAll()
+	if err != nil {
+		return err
+	}
+	m.clubs = clubs
+
+	userClubID := m.managerHub.ChosenClub.ID
+	graduates := domain.Regens(retirements, m.clubs, userClubID, m.season.StartYear, rng)
+	graduates = append(graduates, domain.YouthIntakes(m.clubs, userClubID, m.season.StartYear, rng)...)
+	return m.youthRepo.Sign(graduates)
+}
+
+// payPrizeMoney pays out each division's merit payments and the cups' prize
+// money at the end of the season
+func (m *AppModel) payPrizeMoney(summary *domain.SeasonSummary) error {
+	var transactions []domain.Transaction
+	for _, division := range m.divisions {
+		if table, ok := summary.Tables[division.ID]; ok {
+			transactions = append(transactions, domain.MeritPayments(division, table, m.season.Today)...)
+		}
+	}
+	for _, cup := range summary.Cups {
+		transactions = append(transactions, domain.CupPrizeMoney(cup, m.season.Today)...)
+	}
+	return m.financeRepo.Record(transactions)
+}
+
+// rolloverSeason closes the season and opens the next: the prize money goes
+// out, the final tables are archived, clubs move between divisions and each
+// division gets a fresh schedule, then the summer plays out before the new
+// season's first day. It reports whether the user was sacked, and stops at
+// the first thing that goes wrong.
+func (m *AppModel) rolloverSeason(summary *domain.SeasonSummary) (bool, error) {
+	if err := m.payPrizeMoney(summary); err != nil {
+		return false, err
+	}
+	domain.ApplyMovements(m.clubs, summary.Movements)
+
+	rng := rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))
+	var fixtures []*domain.Fixture
+	for _, division := range m.divisions {
+		divisionFixtures := domain.GenerateFixtures(domain.ClubsInDivision(m.clubs, division.ID), division.Rules.Derbies, rng)
+		for _, fixture := range divisionFixtures {
+			fixture.DivisionID = division.ID
+		}
+		fixtures = append(fixtures, divisionFixtures...)
+	}
+	// Settle the months up to the new season in this season's accounts,
+	// with every club still in the division it finished in
+	clubs, err := m.clubRepo.GetAll()
+	if err != nil {
+		return false, err
+	}
+	preseason := domain.PreseasonStart(m.season.StartYear + 1)
+	if err := m.settleAccounts(clubs, m.season.Today, preseason.AddDate(0, 0, -1)); err != nil {
+		return false, err
+	}
+	if err := m.developPlayers(clubs, m.season.Today, preseason.AddDate(0, 0, -1)); err != nil {
+		return false, err
+	}
+
+	next, err := m.seasonRepo.Rollover(m.season, summary.Tables, summary.Movements, fixtures)
+	if err != nil {
+		return false, err
+	}
+
+	// Players out on loan go back to their own clubs
+	if err := m.transferRepo.ReturnLoans(); err != nil {
+		return false, err
+	}
+
+	// Injured players carry on recovering over the summer
+	summer := int(next.Today.Sub(m.season.Today).Hours() / 24)
+	if err := m.injuryRepo.Recover(max(summer, 0)); err != nil {
+		return false, err
+	}
+	m.season = next
+
+	// The boards review their managers' seasons against their objectives
+	sacked, err := m.reviewSeasons(summary.Tables)
+	if err != nil {
+		return false, err
+	}
+
+	// Veterans retire, then contracts that ran out at the end of June are
+	// renewed or the players leave on a free, and the academies' graduates
+	// and the retirees' replacements join their squads before anyone is
+	// paid for July
+	retirements, err := m.retirePlayers(rng)
+	if err != nil {
+		return false, err
+	}
+	if err := m.expireContracts(rng); err != nil {
+		return false, err
+	}
+	if err := m.youthIntake(retirements, rng); err != nil {
+		return false, err
+	}
+
+	// Reload clubs for the new squads, then the new season's first wages go
+	// out and the boards set their transfer budgets for the season ahead
+	if m.clubs, err = m.clubRepo.GetAll(); err != nil {
+		return false, err
+	}
+	if err := m.settleAccounts(m.clubs, preseason.AddDate(0, 0, -1), next.Today); err != nil {
+		return false, err
+	}
+	if err := m.developPlayers(m.clubs, preseason.AddDate(0, 0, -1), next.Today); err != nil {
+		return false, err
+	}
+	for _, club := range domain.ClubsInPyramid(m.clubs) {
+		if err := m.financeRepo.SetTransferBudget(club.Club, domain.BoardTransferBudget(club.Club)); err != nil {
+			return false, err
+		}
+	}
+	// Load the new season's fixtures
+	if m.fixtures, err = m.fixtureRepo.GetAll(); err != nil {
+		return false, err
+	}
+	// Deductions only stand for the season they were made in
+	if m.divisions, err = m.divisionRepo.GetAll(); err != nil {
+		return false, err
+	}
+
+	// The boards set their objectives for the season ahead
+	if err := m.boardRepo.SetObjectives(m.clubs, m.divisions); err != nil {
+		return false, err
+	}
+
+	// The top flight's leading clubs go into next season's continental cup
+	if table, ok := summary.Tables[m.divisions[0].ID]; ok {
+		if err := m.enterContinentalCup(domain.ContinentalQualifiers(table)); err != nil {
+			return false, err
+		}
+	}
+
+	if sacked {
+		return true, nil
+	}
+	if club := m.clubByID(m.managerHub.ChosenClub.ID); club != nil {
+		if err := m.inboxRepo.Post([]domain.Message{domain.ObjectiveReport(club.Club, m.season.Today)}); err != nil {
+			return false, err
+		}
+	}
+	return false, nil
+}
+
+// inTransaction runs fn with every repository bound to one transaction, so
+// the writes it makes land together or not at all
+func (m *AppModel) inTransaction(fn func() error) error {
+	defer m.bindRepositories(m.queries)
+	return m.queries.Transact(context.Background(), func(queries *db.Queries) error {
+		m.bindRepositories(queries)
+		return fn()
+	})
+}
+
+// reload picks the season, divisions, clubs and fixtures back up from the
+// database, dropping any changes to them that weren't saved
+func (m *AppModel) reload() error {
+	season, err := m.seasonRepo.GetCurrent()
+	if err != nil {
+		return err
+	}
+	divisions, err := m.divisionRepo.GetAll()
+	if err != nil {
+		return err
+	}
+	clubs, err := m.clubRepo.GetAll()
+	if err != nil {
+		return err
+	}
+	fixtures, err := m.fixtureRepo.GetAll()
+	if err != nil {
+		return err
+	}
+
+	m.season, m.divisions, m.clubs, m.fixtures = season, divisions, clubs, fixtures
+	return nil
+}
+
+// finishSeason plays every fixture left once our season is over, drawing
+// and playing out the cups' remaining rounds, then reviews the season
+func (m *AppModel) finishSeason() (tea.Model, tea.Cmd) {
+	closes := domain.PreseasonStart(m.season.StartYear + 1)
+	m.refreshClubs(m.simulateFixtures(closes)...)
+	for m.advanceCups() {
+		m.refreshClubs(m.simulateFixtures(closes)...)
+	}
+	return m.endSeason()
+}
+
+// recordMatch persists a completed match: the result, the home club's gate
+// receipts, cards, injuries and player stats
+func (m *AppModel) recordMatch(match *domain.Match) {
+	// Save the match result to the database
+	if err := m.matchRepo.SaveResult(match); err != nil {
+		m.report("saving match result", err)
+	}
+
+	// Pay the home club its takings on the gate
+	home, away := match.ForFixture.HomeTeam.Club, match.ForFixture.AwayTeam.Club
+	takings := domain.MatchdayTakings(home, away, m.divisionByID(home.DivisionID), match.ForFixture.Date)
+	if err := m.financeRepo.Record([]domain.Transaction{takings}); err != nil {
+		m.report("recording gate receipts", err)
+	}
+
+	// Serve existing bans and record any cards from this match
+	if err := m.disciplineRepo.RecordMatch(match); err != nil {
+		m.report("recording discipline", err)
+	}
+
+	// Record new injuries, and let the manager know about their own players'
+	if err := m.injuryRepo.RecordMatch(match); err != nil {
+		m.report("recording injuries", err)
+	}
+	var injuries []domain.Injury
+	for _, injury := range match.MatchInjuries() {
+		injuries = append(injuries, *injury)
+	}
+	m.reportInjuries(injuries)
+
+	// Carry tired legs over to the next match
+	if err := m.fitnessRepo.RecordMatch(match); err != nil {
+		m.report("recording fatigue", err)
+	}
+
+	// Each side grows more used to the formation it played
+	if err := m.trainingRepo.RecordMatch(match); err != nil {
+		m.report("recording formations", err)
+	}
+
+	// Lift or dent each squad's morale, and each side's cohesion
+	if err := m.moraleRepo.RecordMatch(match); err != nil {
+		m.report("recording morale", err)
+	}
+
+	// Record appearances, goals and ratings for season stats and form
+	if err := m.statsRepo.RecordMatch(match); err != nil {
+		m.report("recording player stats", err)
+	}
+}
+
+// judgeManagers has every board judge its manager on the day's league
+// results, sacking any who have lost its confidence, and writes to the user
+// when their own job looks more or less safe. It reports whether the user
+// was sacked.
+func (m *AppModel) judgeManagers(day time.Time) bool {
+	var fixtures []*domain.Fixture
+	for _, fixture := range m.fixtures {
+		if fixture.DivisionID != 0 && fixture.Date.Equal(day) {
+			fixtures = append(fixtures, fixture)
+		}
+	}
+	results, err := m.matchRepo.GetResults(fixtures)
+	if err != nil {
+		m.report("loading results", err)
+		return false
+	}
+	if len(results) == 0 {
+		return false
+	}
+
+	tables := make(map[int64]*domain.LeagueTable, len(m.divisions))
+	for _, division := range m.divisions {
+		table, err := m.calculateLeagueTable(division, domain.AllVenues)
+		if err != nil {
+			m.report("calculating league table", err)
+			return false
+		}
+		tables[division.ID] = table
+	}
+
+	sacked, err := m.judge(func() []*domain.Club {
+		return domain.JudgeResults(m.clubs, results, tables, day)
+	})
+	if err != nil {
+		m.report("judging managers", err)
+	}
+	return sacked
+}
+
+// reviewSeasons has every board review its manager's season from the final
+// tables, keyed by division, reporting whether the user was sacked
+func (m *AppModel) reviewSeasons(tables map[int64]*domain.LeagueTable) (bool, error) {
+	return m.judge(func() []*domain.Club {
+		return domain.ReviewSeasons(m.clubs, tables, m.season.Today)
+	})
+}
+
+// judge saves the boards' verdicts on their managers and writes to the user
+// if their own board's view of them has changed. It reports whether the
+// user was sacked.
+func (m *AppModel) judge(verdicts func() []*domain.Club) (bool, error) {
+	user := m.clubByID(m.managerHub.ChosenClub.ID)
+	if user == nil {
+		return false, nil
+	}
+	before := user.Club.JobSecurity()
+
+	if err := m.boardRepo.Save(verdicts()); err != nil {
+		return false, err
+	}
+
+	after := user.Club.JobSecurity()
+	sacked := user.Club.Vacant(m.season.Today)
+	if sacked {
+		after = domain.JobLost
+	}
+	if after != before {
+		if err := m.inboxRepo.Post([]domain.Message{domain.JobReport(user.Club, after, m.season.Today)}); err != nil {
+			return false, err
+		}
+	}
+	return sacked, nil
+}
+
+// lookForWork shows the user the jobs open to them after a sacking. Any
+// offers and complaints waiting on them lapse, as they're no longer theirs
+// to answer.
+func (m *AppModel) lookForWork() (tea.Model, tea.Cmd) {
+	messages, err := m.inboxRepo.GetAll()
+	if err != nil {
+		m.report("loading messages", err)
+	}
+	for _, message := range messages {
+		if !message.Pending() {
+			continue
+		}
+		if err := m.inboxRepo.Resolve(message, domain.MessageExpired); err != nil {
+			m.report("lapsing message", err)
+		}
+	}
+
+	former := m.managerHub.ChosenClub
+	m.vacancies = NewVacanciesModel(former, domain.Vacancies(m.clubs, former, m.season.Today), m.divisions)
+	m.mode = VacanciesMode
+	// Send WindowSizeMsg to newly activated model
+	m.vacancies.width = m.width
+	m.vacancies.height = m.height
+	return m, tick()
+}
+
+// appoint makes the user the club's manager and has the board tell them
+// what it expects this season
+func (m *AppModel) appoint(clubID int64) {
+	club := m.clubByID(clubID)
+	if club == nil {
+		return
+	}
+	if err := m.boardRepo.Appoint(club.Club); err != nil {
+		m.report("appointing manager", err)
+	}
+	if err := m.inboxRepo.Post([]domain.Message{domain.ObjectiveReport(club.Club, m.season.Today)}); err != nil {
+		m.report("posting objective", err)
+	}
+}
+
+// refreshClubs reloads the given clubs' squads in place of those loaded,
+// once a match or a deal has changed them
+func (m *AppModel) refreshClubs(clubIDs ...int64) {
+	for _, clubID := range clubIDs {
+		club, err := m.clubRepo.GetByID(clubID)
+		if err != nil {
+			m.report("reloading club", err)
+			continue
+		}
+		for i := range m.clubs {
+			if m.clubs[i].Club.ID == clubID {
+				m.clubs[i] = club
+			}
+		}
+	}
+}
+
+// clubByID finds a club, with its squad, among those loaded
+func (m *AppModel) clubByID(clubID int64) *domain.ClubWithPlayers {
+	for _, club := range m.clubs {
+		if club.Club.ID == clubID {
+			return club
+		}
+	}
+	return nil
+}
+
+// simulateFixtures plays every other unplayed fixture dated up to and
+// including the day in the background, so the whole league keeps pace with
+// the user. The user's own fixtures are always left for them to play. It
+// returns the clubs that played.
+func (m *AppModel) simulateFixtures(through time.Time) []int64 {
+	fixtures, err := m.fixtureRepo.GetUnplayedThrough(through)
+	if err != nil {
+		m.report("loading fixtures to simulate", err)
+		return nil
+	}
+
+	userClubID := m.managerHub.ChosenClub.ID
+	played := make(map[int64]bool)
+	var clubIDs []int64
+	for _, fixture := range fixtures {
+		home, away := fixture.HomeTeam.Club.ID, fixture.AwayTeam.Club.ID
+		if home == userClubID || away == userClubID {
+			continue
+		}
+
+		// A second leg, or a club's second match in this batch, picks up the
+		// first leg's result and how tired the squads are now
+		if fixture.Leg == 2 || played[home] || played[away] {
+			fixture, err = m.fixtureRepo.GetByID(int64(fixture.ID))
+			if err != nil {
+				m.report("reloading fixture", err)
+				continue
+			}
+		}
+		if !played[home] {
+			clubIDs = append(clubIDs, home)
+		}
+		if !played[away] {
+			clubIDs = append(clubIDs, away)
+		}
+		played[home], played[away] = true, true
+
+		match := domain.NewMatchFromFixture(fixture)
+		if err := m.matchRepo.Create(match); err != nil {
+			m.report("creating match", err)
+			continue
+		}
+		simulation.NewEngine(match).SimulateMatch()
+		fixture.Result = match
+
+		m.recordMatch(match)
+	}
+	return clubIDs
+}
+
+// advanceCup draws the next round of the domestic cup once the last one has
+// been played, slotting its ties into the gameweek set aside for it. Only
+// clubs in the pyramid enter. It reports whether a round was drawn.
+func (m *AppModel) advanceCup() bool {
+	cup, err := m.cupRepo.GetCurrent(domain.DomesticCupName)
+	if err != nil {
+		m.report("loading cup", err)
+		return false
+	}
+	clubs := domain.ClubsInPyramid(m.clubs)
+	if !cup.NextRoundDue(clubs) {
+		return false
+	}
+
+	gameweeks := m.cupGameweeks()
+	number := len(cup.Rounds) + 1
+	if number > len(gameweeks) {
+		return false
+	}
+
+	entrants := cup.Remaining(clubs)
+	rng := rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))
+	round := &domain.CupRound{
+		CupName:  cup.Name,
+		Number:   number,
+		Name:     domain.CupRoundName(number, len(entrants)),
+		Gameweek: gameweeks[number-1],
+		Legs:     1,
+	}
+	ties := domain.ScheduleLegs(domain.DrawCupRound(entrants, m.divisions, rng), []int{round.Gameweek})
+	if err := m.cupRepo.CreateRound(cup, round, ties); err != nil {
+		m.report("drawing cup round", err)
+		return false
+	}
+	return true
+}
+
+// cupGameweeks returns the gameweeks set aside for the domestic cup's rounds.
+// They are spread through the shortest league season so every club's cup run
+// ends with its league campaign.
+func (m *AppModel) cupGameweeks() []int {
+	return domain.CupGameweeks(domain.CupRounds(len(domain.ClubsInPyramid(m.clubs))), m.shortestSeason())
+}
+
+// advanceContinental draws the continental cup's group stage, or its next
+// knockout round once the stage before is complete. It reports whether a
+// round was drawn.
+func (m *AppModel) advanceContinental() bool {
+	cup, err := m.cupRepo.GetCurrent(domain.ContinentalCupName)
+	if err != nil {
+		m.report("loading continental cup", err)
+		return false
+	}
+
+	// With no final table to qualify from in a first season, the strongest
+	// top-flight clubs take the places
+	if len(cup.Entrants) == 0 && len(cup.Rounds) == 0 {
+		topFlight := m.divisions[0]
+		var qualifiers []*domain.Club
+		for _, club := range domain.StrongestClubs(domain.ClubsInDivision(m.clubs, topFlight.ID), topFlight.Rules.ChampionsLeaguePlaces) {
+			qualifiers = append(qualifiers, club.Club)
+		}
+		if err := m.enterContinentalCup(qualifiers); err != nil {
+			m.report("entering continental cup", err)
+			return false
+		}
+		if cup, err = m.cupRepo.GetCurrent(domain.ContinentalCupName); err != nil {
+			m.report("loading continental cup", err)
+			return false
+		}
+	}
+	if !cup.NextRoundDue(m.clubs) {
+		return false
+	}
+
+	stages := domain.ContinentalStages(m.shortestSeason(), m.cupGameweeks())
+	number := len(cup.Rounds) + 1
+	if number > len(stages) {
+		return false
+	}
+	stage := stages[number-1]
+
+	rng := rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))
+	round := &domain.CupRound{
+		CupName:    cup.Name,
+		Number:     number,
+		Name:       stage.Name,
+		Gameweek:   stage.Gameweeks[0],
+		Legs:       stage.Legs,
+		GroupStage: stage.GroupStage,
+	}
+
+	var ties []*domain.Fixture
+	if stage.GroupStage {
+		entrants := cup.Remaining(m.clubs)
+		groups := domain.DrawGroups(entrants, rng)
+		for _, group := range groups {
+			if err := m.cupRepo.Enter(cup, group.Clubs, group.Name); err != nil {
+				m.report("drawing continental groups", err)
+				return false
+			}
+		}
+		ties = domain.GroupStageFixtures(groups, entrants, stage.Gameweeks, rng)
+	} else {
+		ties = domain.ScheduleLegs(cup.DrawKnockoutRound(m.clubs, rng), stage.Gameweeks)
+	}
+	if err := m.cupRepo.CreateRound(cup, round, ties); err != nil {
+		m.report("drawing continental round", err)
+		return false
+	}
+	return true
+}
+
+// enterContinentalCup enters the qualifiers into this season's continental
+// cup, making up the numbers with the strongest clubs from abroad
+func (m *AppModel) enterContinentalCup(qualifiers []*domain.Club) error {
+	cup, err := m.cupRepo.GetCurrent(domain.ContinentalCupName)
+	if err != nil {
+		return err
+	}
+
+	field := domain.ContinentalField(qualifiers, m.clubs)
+	entrants := make([]*domain.Club, len(field))
+	for i, club := range field {
+		entrants[i] = club.Club
+	}
+	return m.cupRepo.Enter(cup, entrants, "")
+}
+
+// advanceCups draws the next round of every cup that has one due, reporting
+// whether any were drawn
+func (m *AppModel) advanceCups() bool {
+	domestic := m.advanceCup()
+	continental := m.advanceContinental()
+	return domestic || continental
+}
+
+// shortestSeason returns the number of gameweeks in the shortest division's season
+func (m *AppModel) shortestSeason() int {
+	lastGameweek := make(map[int64]int, len(m.divisions))
+	for _, fixture := range m.fixtures {
+		if fixture.DivisionID != 0 {
+			lastGameweek[fixture.DivisionID] = max(lastGameweek[fixture.DivisionID], fixture.Gameweek)
+		}
+	}
+
+	shortest := 0
+	for _, gameweeks := range lastGameweek {
+		if shortest == 0 || gameweeks < shortest {
+			shortest = gameweeks
+		}
+	}
+	return shortest
+}
+
+// endSeason shows the season review once every fixture has been played. Each
+// division's final table is worked out and any ties on the title or
+// relegation line played off, then any promotion play-offs are played to
+// decide who goes up.
+func (m *AppModel) endSeason() (tea.Model, tea.Cmd) {
+	// Reload clubs so the play-offs are picked from fit, available players
+	clubs, err := m.clubRepo.GetAll()
+	if err != nil {
+		return m, tea.Quit
+	}
+	m.clubs = clubs
+
+	tables := make(map[int64]*domain.LeagueTable, len(m.divisions))
+	playOffs := make(map[int64]*domain.PromotionPlayOff)
+	for _, division := range m.divisions {
+		table, err := m.calculateLeagueTable(division, domain.AllVenues)
+		if err != nil {
+			return m, tea.Quit
+		}
+		tables[division.ID] = table
+
+		table.SettlePlayOffs(domain.ClubsInDivision(m.clubs, division.ID), deciderMatch)
+		if playOff := domain.PlayPromotionPlayOff(table, domain.ClubsInDivision(m.clubs, division.ID), playOffMatch); playOff != nil {
+			playOffs[division.ID] = playOff
+		}
+	}
+
+	club := m.managerHub.ChosenClub
+	history, err := m.seasonRepo.GetHistoryByClubID(club.ID)
+	if err != nil {
+		return m, tea.Quit
+	}
+
+	summary := domain.NewSeasonSummary(m.season, m.divisions, tables, playOffs, club)
+	for _, name := range []string{domain.DomesticCupName, domain.ContinentalCupName} {
+		cup, err := m.cupRepo.GetCurrent(name)
+		if err != nil {
+			return m, tea.Quit
+		}
+		summary.Cups = append(summary.Cups, cup)
+	}
+	m.seasonSummary = NewSeasonSummaryModel(club, summary, history)
+
+	m.mode = SeasonSummaryMode
+	// Send WindowSizeMsg to newly activated model
+	m.seasonSummary.width = m.width
+	m.seasonSummary.height = m.height
+	return m, tick()
+}
+
+// playOffMatch simulates one promotion play-off game. Play-off games sit
+// outside the league season, so they aren't saved.
+func playOffMatch(home, away *domain.ClubWithPlayers) (int, int) {
+	match := domain.NewMatchFromFixture(&domain.Fixture{HomeTeam: home, AwayTeam: away})
+	simulation.NewEngine(match).SimulateMatch()
+	return match.GetScore()
+}
+
+// deciderMatch simulates a one-off play-off on the title or relegation line,
+// going to extra time and penalties if need be. Like the promotion play-offs
+// it isn't saved.
+func deciderMatch(home, away *domain.ClubWithPlayers) domain.PlayOffMatch {
+	match := domain.NewMatchFromFixture(&domain.Fixture{HomeTeam: home, AwayTeam: away})
+	match.Knockout = true
+	simulation.NewEngine(match).SimulateMatch()
+	homeGoals, awayGoals := match.GetScore()
+	return domain.PlayOffMatch{
+		Home:      home.Club,
+		Away:      away.Club,
+		HomeGoals: homeGoals,
+		AwayGoals: awayGoals,
+		Winner:    match.GetWinner(),
+	}
+}
+
+// calculateLeagueTables computes a division's overall, home and away standings
+func (m *AppModel) calculateLeagueTables(division *domain.Division) (map[domain.Venue]*domain.LeagueTable, error) {
+	tables := make(map[domain.Venue]*domain.LeagueTable, len(domain.Venues))
+	for _, venue := range domain.Venues {
+		table, err := m.calculateLeagueTable(division, venue)
+		if err != nil {
+			return nil, err
+		}
+		tables[venue] = table
+	}
+	return tables, nil
+}
+
+// calculateLeagueTable computes a division's standings from its own clubs and fixtures
+func (m *AppModel) calculateLeagueTable(division *domain.Division, venue domain.Venue) (*domain.LeagueTable, error) {
+	var fixtures []*domain.Fixture
+	for _, fixture := range m.fixtures {
+		if fixture.DivisionID == division.ID {
+			fixtures = append(fixtures, fixture)
+		}
+	}
+	return m.matchRepo.CalculateLeagueTable(division.Rules, domain.ClubsInDivision(m.clubs, division.ID), fixtures, venue)
+}
+
+// divisionByID finds a division of the pyramid, falling back to the top flight
+func (m *AppModel) divisionByID(divisionID int64) *domain.Division {
+	for _, division := range m.divisions {
+		if division.ID == divisionID {
+			return division
+		}
+	}
+	return m.divisions[0]
+}
+
+// report shows what went wrong doing something in a status line under the
+// current screen, rather than printing over it
+func (m *AppModel) report(doing string, err error) {
+	m.status = fmt.Sprintf("Error %s: %v", doing, err)
+}
+
 func (m *AppModel) View() string {
+	view := "No mode"
 	switch m.mode {
 	case MenuMode:
-		return m.menu.View()
+		view = m.menu.View()
 	case OnboardingMode:
-		return m.onboarding.View()
+		view = m.onboarding.View()
 	case ManagerHubMode:
-		return m.managerHub.View()
+		view = m.managerHub.View()
 	case PreMatchMode:
-		return m.prematch.View()
+		view = m.prematch.View()
 	case MatchMode:
-		return m.match.View()
+		view = m.match.View()
+	case SeasonSummaryMode:
+		view = m.seasonSummary.View()
+	case TransferMarketMode:
+		view = m.market.View()
+	case VacanciesMode:
+		view = m.vacancies.View()
+	}
+
+	if m.status != "" {
+		errorStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("196"))
+		view = lipgloss.JoinVertical(lipgloss.Left, view, errorStyle.Render(m.status))
 	}
-	return "No mode"
+	return view
 }
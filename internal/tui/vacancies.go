@@ -0,0 +1,103 @@
+package tui
+
+import (
+	"github.com/cameronjpr/gaffer/internal/components"
+	"github.com/cameronjpr/gaffer/internal/domain"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// VacanciesModel lists the jobs open to the user after the board sacks them,
+// letting them apply for one or go back to the menu to take over another club
+type VacanciesModel struct {
+	width     int
+	height    int
+	former    *domain.Club
+	vacancies []*domain.ClubWithPlayers
+	divisions []*domain.Division
+	cursor    int
+	notice    string // Why the last application was turned down
+}
+
+// NewVacanciesModel creates a new vacancies model for a manager just sacked
+// by the former club
+func NewVacanciesModel(former *domain.Club, vacancies []*domain.ClubWithPlayers, divisions []*domain.Division) *VacanciesModel {
+	return &VacanciesModel{
+		former:    former,
+		vacancies: vacancies,
+		divisions: divisions,
+	}
+}
+
+func (m *VacanciesModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m *VacanciesModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		return m, nil
+
+	case tea.KeyMsg:
+		if msg.String() == "esc" {
+			return m, func() tea.Msg {
+				return goToMenuMsg{}
+			}
+		}
+		if len(m.vacancies) == 0 {
+			return m, nil
+		}
+
+		switch msg.String() {
+		case "up", "k":
+			m.cursor = max(0, m.cursor-1)
+		case "down", "j":
+			m.cursor = min(len(m.vacancies)-1, m.cursor+1)
+		case "enter":
+			club := m.vacancies[m.cursor]
+			return m, func() tea.Msg {
+				return applyForJobMsg{club: club}
+			}
+		}
+	}
+
+	return m, nil
+}
+
+func (m *VacanciesModel) View() string {
+	title := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("170")).
+		Render("Sacked by " + m.former.Name)
+
+	intro := "The board have relieved you of your duties. These clubs are looking for a manager:"
+	keys := "↑/↓: navigate • enter: apply • esc: back to the menu"
+	if len(m.vacancies) == 0 {
+		intro = "The board have relieved you of your duties, and no club is looking for a manager."
+		keys = "esc: back to the menu"
+	}
+
+	notice := ""
+	if m.notice != "" {
+		notice = lipgloss.NewStyle().Foreground(lipgloss.Color("214")).Render(m.notice)
+	}
+
+	help := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("241")).
+		Render(keys)
+
+	content := lipgloss.JoinVertical(
+		lipgloss.Left,
+		title,
+		"",
+		intro,
+		"",
+		components.Vacancies(m.vacancies, m.divisions, m.cursor),
+		"",
+		notice,
+		help,
+	)
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, content)
+}
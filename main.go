@@ -10,6 +10,16 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 )
 
+// divisions is the league pyramid, top flight first
+var divisions = []db.DivisionSeed{
+	{Name: "Premier League", Tier: 1, ClubsJSONPath: "clubs.json", FixturesJSONPath: "fixtures.json"},
+	{Name: "Championship", Tier: 2, ClubsJSONPath: "championship.json"},
+}
+
+// foreignClubsJSONPath lists the clubs from outside the pyramid who make up
+// the rest of the continental cup
+const foreignClubsJSONPath = "continental.json"
+
 func main() {
 	// Initialize database
 	homeDir, err := os.UserHomeDir()
@@ -26,8 +36,12 @@ func main() {
 	}
 	defer database.Close()
 
-	// Seed database with clubs from JSON
-	if err := db.SeedDatabase(database, "clubs.json", "fixtures.json"); err != nil {
+	// Seed database with each division's clubs from JSON
+	if err := db.SeedDatabase(database, divisions); err != nil {
+		fmt.Println("Error seeding database:", err)
+		os.Exit(1)
+	}
+	if err := db.SeedForeignClubs(database, foreignClubsJSONPath); err != nil {
 		fmt.Println("Error seeding database:", err)
 		os.Exit(1)
 	}
@@ -35,6 +49,27 @@ func main() {
 	// Create queries
 	queries := db.New(database)
 
+	if len(os.Args) > 1 && os.Args[1] == "import" {
+		if err := runImport(queries, os.Args[2:]); err != nil {
+			fmt.Println("Error importing fixtures:", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "deduct" {
+		if err := runDeduct(queries, os.Args[2:]); err != nil {
+			fmt.Println("Error deducting points:", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if err := importDefaultFixtures(queries); err != nil {
+		fmt.Println("Error importing fixtures:", err)
+		os.Exit(1)
+	}
+
 	model := tui.NewModel(queries)
 
 	p := tea.NewProgram(model, tea.WithAltScreen())